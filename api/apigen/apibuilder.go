@@ -0,0 +1,111 @@
+package apigen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/zeromicro/goctl/api/spec"
+	"github.com/zeromicro/goctl/util"
+)
+
+var infoKeys = []string{"title", "desc", "author", "email", "version"}
+
+// buildApi writes api into the content of an api file, the values of api are written as they are,
+// such as the quoted info values, the member tags with backquotes and the comments starting with //
+func buildApi(api *spec.ApiSpec) string {
+	var builder strings.Builder
+	if len(api.Syntax.Version) > 0 {
+		fmt.Fprintf(&builder, "syntax = %s%s%s", api.Syntax.Version, util.NL, util.NL)
+	}
+
+	if len(api.Info.Properties) > 0 {
+		builder.WriteString("info(" + util.NL)
+		writeProperties(&builder, api.Info.Properties, infoKeys)
+		builder.WriteString(")" + util.NL + util.NL)
+	}
+
+	for _, tp := range api.Types {
+		v, ok := tp.(spec.DefineStruct)
+		if !ok {
+			continue
+		}
+
+		writeDocs(&builder, v.Docs, 0)
+		fmt.Fprintf(&builder, "type %s {%s", v.Name(), util.NL)
+		for _, member := range v.Members {
+			writeDocs(&builder, member.Docs, 1)
+			if member.IsInline {
+				fmt.Fprintf(&builder, "\t%s%s", member.Type.Name(), util.NL)
+				continue
+			}
+
+			line := fmt.Sprintf("\t%s %s %s", member.Name, member.Type.Name(), member.Tag)
+			if len(member.Comment) > 0 {
+				line += " " + member.Comment
+			}
+			builder.WriteString(line + util.NL)
+		}
+		builder.WriteString("}" + util.NL + util.NL)
+	}
+
+	for _, group := range api.Service.Groups {
+		if len(group.Annotation.Properties) > 0 {
+			builder.WriteString("@server(" + util.NL)
+			writeProperties(&builder, group.Annotation.Properties, nil)
+			builder.WriteString(")" + util.NL)
+		}
+
+		fmt.Fprintf(&builder, "service %s {%s", api.Service.Name, util.NL)
+		for i, route := range group.Routes {
+			if i > 0 {
+				builder.WriteString(util.NL)
+			}
+
+			if len(route.AtDoc.Text) > 0 {
+				fmt.Fprintf(&builder, "\t@doc %s%s", route.AtDoc.Text, util.NL)
+			}
+			fmt.Fprintf(&builder, "\t@handler %s%s", route.Handler, util.NL)
+			fmt.Fprintf(&builder, "\t%s %s", route.Method, route.Path)
+			if route.RequestType != nil {
+				fmt.Fprintf(&builder, " (%s)", route.RequestType.Name())
+			}
+			if route.ResponseType != nil {
+				fmt.Fprintf(&builder, " returns (%s)", route.ResponseType.Name())
+			}
+			builder.WriteString(util.NL)
+		}
+		builder.WriteString("}" + util.NL + util.NL)
+	}
+
+	return builder.String()
+}
+
+// writeProperties writes the properties in the order of keys, the others are written in alphabetical order
+func writeProperties(builder *strings.Builder, properties map[string]string, keys []string) {
+	written := make(map[string]bool)
+	for _, key := range keys {
+		if value, ok := properties[key]; ok {
+			fmt.Fprintf(builder, "\t%s: %s%s", key, value, util.NL)
+			written[key] = true
+		}
+	}
+
+	var others []string
+	for key := range properties {
+		if !written[key] {
+			others = append(others, key)
+		}
+	}
+	sort.Strings(others)
+
+	for _, key := range others {
+		fmt.Fprintf(builder, "\t%s: %s%s", key, properties[key], util.NL)
+	}
+}
+
+func writeDocs(builder *strings.Builder, docs spec.Doc, indent int) {
+	for _, doc := range docs {
+		builder.WriteString(strings.Repeat("\t", indent) + doc + util.NL)
+	}
+}
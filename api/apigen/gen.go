@@ -1,6 +1,7 @@
 package apigen
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"path/filepath"
@@ -46,27 +47,31 @@ func ApiCommand(c *cli.Context) error {
 		return errors.New("missing -o")
 	}
 
-	fp, err := util.CreateIfNotExist(apiFile)
-	if err != nil {
+	t := template.Must(template.New("etcTemplate").Parse(apiTemplate))
+	var buffer bytes.Buffer
+	if err := t.Execute(&buffer, map[string]string{
+		"gitUser":     getGitName(),
+		"gitEmail":    getGitEmail(),
+		"serviceName": serviceName(apiFile),
+	}); err != nil {
+		return err
+	}
+
+	if err := util.CreateFile(apiFile, buffer.Bytes()); err != nil {
 		return err
 	}
-	defer fp.Close()
 
+	fmt.Println(aurora.Green("Done."))
+	return nil
+}
+
+func serviceName(apiFile string) string {
 	baseName := util.FileNameWithoutExt(filepath.Base(apiFile))
 	if strings.HasSuffix(strings.ToLower(baseName), "-api") {
 		baseName = baseName[:len(baseName)-4]
 	} else if strings.HasSuffix(strings.ToLower(baseName), "api") {
 		baseName = baseName[:len(baseName)-3]
 	}
-	t := template.Must(template.New("etcTemplate").Parse(apiTemplate))
-	if err := t.Execute(fp, map[string]string{
-		"gitUser":     getGitName(),
-		"gitEmail":    getGitEmail(),
-		"serviceName": baseName + "-api",
-	}); err != nil {
-		return err
-	}
 
-	fmt.Println(aurora.Green("Done."))
-	return nil
+	return baseName + "-api"
 }
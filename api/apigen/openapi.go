@@ -0,0 +1,389 @@
+package apigen
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/urfave/cli"
+	"github.com/zeromicro/goctl/api/format"
+	"github.com/zeromicro/goctl/util"
+	"gopkg.in/yaml.v2"
+)
+
+type (
+	openAPIDocument struct {
+		Swagger             string                            `json:"swagger"`
+		OpenAPI             string                            `json:"openapi"`
+		Info                openAPIInfo                       `json:"info"`
+		BasePath            string                            `json:"basePath"`
+		Servers             []openAPIServer                   `json:"servers"`
+		Paths               openAPIPaths                      `json:"paths"`
+		Definitions         openAPISchemas                    `json:"definitions"`
+		Parameters          map[string]*openAPIParameter      `json:"parameters"`
+		Responses           map[string]*openAPIResponse       `json:"responses"`
+		SecurityDefinitions map[string]*openAPISecurityScheme `json:"securityDefinitions"`
+		Components          openAPIComponents                 `json:"components"`
+		Security            []map[string][]string             `json:"security"`
+	}
+
+	openAPIInfo struct {
+		Title       string `json:"title"`
+		Description string `json:"description"`
+		Version     string `json:"version"`
+	}
+
+	openAPIServer struct {
+		URL string `json:"url"`
+	}
+
+	openAPIComponents struct {
+		Schemas         openAPISchemas                    `json:"schemas"`
+		Parameters      map[string]*openAPIParameter      `json:"parameters"`
+		RequestBodies   map[string]*openAPIRequestBody    `json:"requestBodies"`
+		Responses       map[string]*openAPIResponse       `json:"responses"`
+		SecuritySchemes map[string]*openAPISecurityScheme `json:"securitySchemes"`
+	}
+
+	openAPIPathItem struct {
+		Parameters []*openAPIParameter `json:"parameters"`
+		Get        *openAPIOperation   `json:"get"`
+		Put        *openAPIOperation   `json:"put"`
+		Post       *openAPIOperation   `json:"post"`
+		Delete     *openAPIOperation   `json:"delete"`
+		Options    *openAPIOperation   `json:"options"`
+		Head       *openAPIOperation   `json:"head"`
+		Patch      *openAPIOperation   `json:"patch"`
+	}
+
+	openAPIOperation struct {
+		Tags        []string                    `json:"tags"`
+		Summary     string                      `json:"summary"`
+		Description string                      `json:"description"`
+		OperationID string                      `json:"operationId"`
+		Parameters  []*openAPIParameter         `json:"parameters"`
+		RequestBody *openAPIRequestBody         `json:"requestBody"`
+		Responses   map[string]*openAPIResponse `json:"responses"`
+		// Security is nil if the operation inherits the global security
+		Security *[]map[string][]string `json:"security"`
+	}
+
+	// openAPIParameter describes a parameter of both swagger 2 and openapi 3,
+	// the schema of a swagger 2 non-body parameter is declared inline
+	openAPIParameter struct {
+		openAPISchema
+		Ref         string         `json:"$ref"`
+		Name        string         `json:"name"`
+		In          string         `json:"in"`
+		Description string         `json:"description"`
+		Required    bool           `json:"required"`
+		Schema      *openAPISchema `json:"schema"`
+	}
+
+	openAPIRequestBody struct {
+		Ref         string                       `json:"$ref"`
+		Description string                       `json:"description"`
+		Required    bool                         `json:"required"`
+		Content     map[string]*openAPIMediaType `json:"content"`
+	}
+
+	openAPIResponse struct {
+		Ref         string                       `json:"$ref"`
+		Description string                       `json:"description"`
+		Schema      *openAPISchema               `json:"schema"`
+		Content     map[string]*openAPIMediaType `json:"content"`
+	}
+
+	openAPIMediaType struct {
+		Schema *openAPISchema `json:"schema"`
+	}
+
+	openAPISecurityScheme struct {
+		Type   string `json:"type"`
+		Scheme string `json:"scheme"`
+		Name   string `json:"name"`
+		In     string `json:"in"`
+	}
+
+	openAPISchema struct {
+		Ref                  string           `json:"$ref"`
+		Type                 openAPIType      `json:"type"`
+		Format               string           `json:"format"`
+		Description          string           `json:"description"`
+		Enum                 []interface{}    `json:"enum"`
+		Default              interface{}      `json:"default"`
+		Minimum              *float64         `json:"minimum"`
+		Maximum              *float64         `json:"maximum"`
+		ExclusiveMinimum     interface{}      `json:"exclusiveMinimum"`
+		ExclusiveMaximum     interface{}      `json:"exclusiveMaximum"`
+		Items                *openAPISchema   `json:"items"`
+		Properties           openAPISchemas   `json:"properties"`
+		AdditionalProperties json.RawMessage  `json:"additionalProperties"`
+		Required             []string         `json:"required"`
+		AllOf                []*openAPISchema `json:"allOf"`
+	}
+
+	// openAPIType is the type of a schema, the type list of openapi 3.1 such as ["string", "null"]
+	// is converted into the first type which is not null
+	openAPIType string
+
+	// openAPISchemas keeps the declaration order of the schemas
+	openAPISchemas struct {
+		names   []string
+		schemas map[string]*openAPISchema
+	}
+
+	// openAPIPaths keeps the declaration order of the paths
+	openAPIPaths struct {
+		paths []string
+		items map[string]*openAPIPathItem
+	}
+)
+
+// ImportOpenAPICommand converts a swagger 2 or openapi 3 document into an api file
+func ImportOpenAPICommand(c *cli.Context) error {
+	src := c.String("src")
+	apiFile := c.String("o")
+	if len(src) == 0 {
+		return errors.New("missing -src")
+	}
+
+	if len(apiFile) == 0 {
+		return errors.New("missing -o")
+	}
+
+	if util.FileExists(apiFile) {
+		return fmt.Errorf("%s already exists", apiFile)
+	}
+
+	data, err := ioutil.ReadFile(src)
+	if err != nil {
+		return err
+	}
+
+	doc, err := parseOpenAPI(data)
+	if err != nil {
+		return fmt.Errorf("%s: %v", src, err)
+	}
+
+	api, err := convertOpenAPI(doc, serviceName(apiFile))
+	if err != nil {
+		return fmt.Errorf("%s: %v", src, err)
+	}
+
+	content, err := format.ApiFormatByContent(buildApi(api), filepath.Dir(apiFile))
+	if err != nil {
+		return err
+	}
+
+	err = util.MkdirIfNotExist(filepath.Dir(apiFile))
+	if err != nil {
+		return err
+	}
+
+	err = util.WriteFile(apiFile, []byte(content), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(aurora.Green("Done."))
+	return nil
+}
+
+// parseOpenAPI parses a swagger 2 or openapi 3 document in json or yaml
+func parseOpenAPI(data []byte) (*openAPIDocument, error) {
+	data = bytes.TrimSpace(data)
+	if !bytes.HasPrefix(data, []byte("{")) {
+		var v yaml.MapSlice
+		err := yaml.Unmarshal(data, &v)
+		if err != nil {
+			return nil, err
+		}
+
+		var buffer bytes.Buffer
+		err = yamlToJSON(&buffer, v)
+		if err != nil {
+			return nil, err
+		}
+
+		data = buffer.Bytes()
+	}
+
+	var doc openAPIDocument
+	err := json.Unmarshal(data, &doc)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.HasPrefix(doc.Swagger, "2.") && !strings.HasPrefix(doc.OpenAPI, "3.") {
+		return nil, errors.New("neither a swagger 2 nor an openapi 3 document")
+	}
+
+	return &doc, nil
+}
+
+// yamlToJSON converts the value decoded by yaml into json, the order of the keys is kept
+func yamlToJSON(buffer *bytes.Buffer, v interface{}) error {
+	switch val := v.(type) {
+	case yaml.MapSlice:
+		buffer.WriteString("{")
+		for i, item := range val {
+			if i > 0 {
+				buffer.WriteString(",")
+			}
+
+			key, err := json.Marshal(fmt.Sprint(item.Key))
+			if err != nil {
+				return err
+			}
+
+			buffer.Write(key)
+			buffer.WriteString(":")
+			err = yamlToJSON(buffer, item.Value)
+			if err != nil {
+				return err
+			}
+		}
+		buffer.WriteString("}")
+	case []interface{}:
+		buffer.WriteString("[")
+		for i, item := range val {
+			if i > 0 {
+				buffer.WriteString(",")
+			}
+
+			err := yamlToJSON(buffer, item)
+			if err != nil {
+				return err
+			}
+		}
+		buffer.WriteString("]")
+	default:
+		data, err := json.Marshal(val)
+		if err != nil {
+			return err
+		}
+
+		buffer.Write(data)
+	}
+
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (t *openAPIType) UnmarshalJSON(data []byte) error {
+	var tp string
+	if err := json.Unmarshal(data, &tp); err == nil {
+		*t = openAPIType(tp)
+		return nil
+	}
+
+	var list []string
+	err := json.Unmarshal(data, &list)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range list {
+		if item != "null" {
+			*t = openAPIType(item)
+			break
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (s *openAPISchemas) UnmarshalJSON(data []byte) error {
+	names, err := objectKeys(data)
+	if err != nil {
+		return err
+	}
+
+	s.names = names
+	return json.Unmarshal(data, &s.schemas)
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (p *openAPIPaths) UnmarshalJSON(data []byte) error {
+	paths, err := objectKeys(data)
+	if err != nil {
+		return err
+	}
+
+	p.paths = paths
+	return json.Unmarshal(data, &p.items)
+}
+
+// objectKeys returns the keys of a json object in order
+func objectKeys(data []byte) ([]string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	if token == nil {
+		return nil, nil
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return nil, fmt.Errorf("expecting an object, but found %v", token)
+	}
+
+	var keys []string
+	for decoder.More() {
+		token, err = decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, token.(string))
+
+		var value json.RawMessage
+		err = decoder.Decode(&value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return keys, nil
+}
+
+func (p *openAPIParameter) schema() *openAPISchema {
+	if p.Schema != nil {
+		return p.Schema
+	}
+
+	return &p.openAPISchema
+}
+
+func (s *openAPISchema) isObject() bool {
+	return s.Type == "object" || len(s.Properties.names) > 0 || len(s.AllOf) > 0
+}
+
+// additionalProperties returns the schema of the map values, it returns false if s is not a map
+func (s *openAPISchema) additionalProperties() (*openAPISchema, bool) {
+	if len(s.AdditionalProperties) == 0 {
+		return nil, false
+	}
+
+	var allowed bool
+	if err := json.Unmarshal(s.AdditionalProperties, &allowed); err == nil {
+		return &openAPISchema{}, allowed
+	}
+
+	var schema openAPISchema
+	if err := json.Unmarshal(s.AdditionalProperties, &schema); err != nil {
+		return nil, false
+	}
+
+	return &schema, true
+}
@@ -0,0 +1,210 @@
+package apigen
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zeromicro/goctl/api/format"
+	"github.com/zeromicro/goctl/api/parser"
+	"github.com/zeromicro/goctl/api/spec"
+)
+
+func TestImportOpenAPI(t *testing.T) {
+	api := importOpenAPI(t, "testdata/petstore.yaml", "pet.api")
+	assert.Equal(t, "pet-api", api.Service.Name)
+	assert.Equal(t, `"Swagger Petstore"`, api.Info.Properties["title"])
+
+	pet := findType(t, api, "Pet")
+	assert.True(t, pet.Members[0].IsInline)
+	assert.Equal(t, "Base", pet.Members[0].Type.Name())
+	assert.Equal(t, []string{
+		"`json:\"id\"`",
+		"`json:\"name\"`",
+		"`json:\"status,optional,options=available|pending|sold,default=available\"`",
+		"`json:\"age,optional,range=[0:30)\"`",
+		"`json:\"tags,optional\"`",
+		"`json:\"attrs,optional\"`",
+		"`json:\"owner,optional\"`",
+	}, memberTags(pet.Members[1:]))
+	assert.Equal(t, "// the name of pet", pet.Members[2].Comment)
+	assert.Equal(t, "[]PetTagsItem", pet.Members[5].Type.Name())
+	assert.Equal(t, "map[string]string", pet.Members[6].Type.Name())
+	findType(t, api, "PetTagsItem")
+
+	routes := api.Service.Routes()
+	assert.Len(t, routes, 4)
+	assert.Equal(t, "/v1/pets", routes[0].Path)
+	assert.Equal(t, "ListPets", routes[0].Handler)
+	assert.Equal(t, `"List all pets"`, routes[0].AtDoc.Text)
+	assert.Equal(t, "ListPetsReq", routes[0].RequestTypeName())
+	assert.Equal(t, "[]Pet", routes[0].ResponseTypeName())
+	assert.Equal(t, "/v1/pets/:petId", routes[1].Path)
+	assert.Equal(t, "Pet", routes[1].ResponseTypeName())
+	assert.Equal(t, "Pet", routes[2].RequestTypeName())
+	assert.Equal(t, "", routes[2].ResponseTypeName())
+	assert.Equal(t, "UpdatePetReq", routes[3].RequestTypeName())
+	assert.Equal(t, "UpdatePetReply", routes[3].ResponseTypeName())
+
+	assert.Len(t, api.Service.Groups, 2)
+	assert.Equal(t, "pets", api.Service.Groups[0].GetAnnotation("group"))
+	assert.Equal(t, "", api.Service.Groups[0].GetAnnotation("jwt"))
+	assert.Equal(t, "BearerAuth", api.Service.Groups[1].GetAnnotation("jwt"))
+
+	update := findType(t, api, "UpdatePetReq")
+	assert.Equal(t, []string{"`path:\"petId\"`", "`json:\"name,optional\"`"}, memberTags(update.Members))
+}
+
+func TestImportSwagger(t *testing.T) {
+	api := importOpenAPI(t, "testdata/user.json", "user-api.api")
+	assert.Equal(t, "user-api", api.Service.Name)
+
+	routes := api.Service.Routes()
+	assert.Len(t, routes, 3)
+	assert.Equal(t, "GetUser", routes[0].Handler)
+	assert.Equal(t, "/api/users/:id", routes[0].Path)
+	assert.Equal(t, "User", routes[0].ResponseTypeName())
+	assert.Equal(t, "Jwt", api.Service.Groups[0].GetAnnotation("jwt"))
+	assert.Equal(t, "PostUsersId", routes[1].Handler)
+	assert.Equal(t, "CreateUser", routes[2].Handler)
+	// the string response is not supported
+	assert.Equal(t, "", routes[2].ResponseTypeName())
+
+	// the header parameter is ignored
+	assert.Equal(t, []string{"`path:\"id\"`"}, memberTags(findType(t, api, "GetUserReq").Members))
+	assert.Equal(t, []string{
+		"`path:\"id\"`",
+		"`form:\"nick\"`",
+		"`form:\"level,optional,options=1|2|3\"`",
+	}, memberTags(findType(t, api, "PostUsersIdReq").Members))
+
+	create := findType(t, api, "CreateUserReq")
+	assert.Equal(t, "`form:\"dry,optional\"`", create.Members[0].Tag)
+	assert.True(t, create.Members[1].IsInline)
+	assert.Equal(t, "User", create.Members[1].Type.Name())
+}
+
+func TestImportOpenAPIError(t *testing.T) {
+	_, err := parseOpenAPI([]byte(`{"info": {"title": "foo"}}`))
+	assert.NotNil(t, err)
+
+	for _, item := range []string{
+		`{"openapi": "3.0.0", "paths": {"/": {"get": {}}}}`,
+		`{"openapi": "3.0.0", "paths": {"/users/{id}.json": {"get": {}}}}`,
+		`{"openapi": "3.0.0", "paths": {"/users": {"post": {"requestBody": {"content": {"application/json": {"schema": {"type": "array"}}}}}}}}`,
+		`{"openapi": "3.0.0", "paths": {"/users": {"get": {"parameters": [{"$ref": "#/components/parameters/Foo"}]}}}}`,
+		`{"swagger": "2.0", "paths": {"/users": {"get": {"responses": {"200": {"schema": {"$ref": "other.json#/User"}}}}}}}`,
+	} {
+		doc, err := parseOpenAPI([]byte(item))
+		assert.Nil(t, err)
+
+		_, err = convertOpenAPI(doc, "foo-api")
+		assert.NotNil(t, err, item)
+	}
+}
+
+func TestToIdentifier(t *testing.T) {
+	assert.Equal(t, "UserName", toIdentifier("user_name"))
+	assert.Equal(t, "GetUsersId", toIdentifier("get /users/{id}"))
+	assert.Equal(t, "X2fa", toIdentifier("2fa"))
+	assert.Equal(t, "X", toIdentifier("-"))
+	assert.Equal(t, "user-_1-api", toServiceName("user.1-api"))
+}
+
+func importOpenAPI(t *testing.T, src, filename string) *spec.ApiSpec {
+	data, err := ioutil.ReadFile(src)
+	assert.Nil(t, err)
+
+	doc, err := parseOpenAPI(data)
+	assert.Nil(t, err)
+
+	api, err := convertOpenAPI(doc, serviceName(filename))
+	assert.Nil(t, err)
+
+	apiFile := filepath.Join(t.TempDir(), filename)
+	content := buildApi(api)
+	err = ioutil.WriteFile(apiFile, []byte(content), 0644)
+	assert.Nil(t, err)
+
+	err = format.ApiFormatByPath(apiFile)
+	assert.Nil(t, err)
+
+	result, err := parser.Parse(apiFile)
+	assert.Nil(t, err)
+	return result
+}
+
+func findType(t *testing.T, api *spec.ApiSpec, name string) spec.DefineStruct {
+	for _, item := range api.Types {
+		if item.Name() == name {
+			return item.(spec.DefineStruct)
+		}
+	}
+
+	t.Fatalf("type %s not found", name)
+	return spec.DefineStruct{}
+}
+
+func memberTags(members []spec.Member) []string {
+	var tags []string
+	for _, item := range members {
+		tags = append(tags, item.Tag)
+	}
+	return tags
+}
+
+func TestBuildApi(t *testing.T) {
+	content := buildApi(&spec.ApiSpec{
+		Syntax: spec.ApiSyntax{Version: `"v1"`},
+		Info:   spec.Info{Properties: map[string]string{"version": `"1.0"`, "title": `"foo"`}},
+		Types: []spec.Type{
+			spec.DefineStruct{
+				RawName: "Foo",
+				Docs:    spec.Doc{"// foo"},
+				Members: []spec.Member{
+					{Name: "Bar", Type: spec.PrimitiveType{RawName: "string"}, Tag: "`json:\"bar\"`", Comment: "// bar"},
+				},
+			},
+		},
+		Service: spec.Service{
+			Name: "foo-api",
+			Groups: []spec.Group{
+				{
+					Annotation: spec.Annotation{Properties: map[string]string{"jwt": "Auth"}},
+					Routes: []spec.Route{
+						{
+							Method:      "get",
+							Path:        "/foo",
+							Handler:     "GetFoo",
+							AtDoc:       spec.AtDoc{Text: `"get foo"`},
+							RequestType: spec.DefineStruct{RawName: "Foo"},
+						},
+					},
+				},
+			},
+		},
+	})
+	assert.Equal(t, `syntax = "v1"
+
+info(
+	title: "foo"
+	version: "1.0"
+)
+
+// foo
+type Foo {
+	Bar string `+"`json:\"bar\"`"+` // bar
+}
+
+@server(
+	jwt: Auth
+)
+service foo-api {
+	@doc "get foo"
+	@handler GetFoo
+	get /foo (Foo)
+}
+
+`, content)
+}
@@ -0,0 +1,967 @@
+package apigen
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/zeromicro/goctl/api/spec"
+)
+
+const (
+	swaggerDefinitionPrefix = "#/definitions/"
+	swaggerParameterPrefix  = "#/parameters/"
+	swaggerResponsePrefix   = "#/responses/"
+	schemaPrefix            = "#/components/schemas/"
+	parameterPrefix         = "#/components/parameters/"
+	responsePrefix          = "#/components/responses/"
+	requestBodyPrefix       = "#/components/requestBodies/"
+
+	bodyTagKey = "json"
+	formTagKey = "form"
+	pathTagKey = "path"
+
+	interfaceType = "interface{}"
+)
+
+var (
+	pathSegmentRegex   = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*(-[A-Za-z_$][A-Za-z0-9_$]*)*$`)
+	pathParameterRegex = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*(-[A-Za-z_$][A-Za-z0-9_$]*)?$`)
+)
+
+type (
+	openAPIConverter struct {
+		doc *openAPIDocument
+		api *spec.ApiSpec
+		// typeNames contains the names of the generated types
+		typeNames map[string]bool
+		// schemaTypes maps the names of the object schemas in definitions or components to the type names
+		schemaTypes map[string]string
+		handlers    map[string]bool
+		resolving   map[string]bool
+	}
+
+	groupKey struct {
+		group string
+		jwt   string
+	}
+
+	openAPIMethod struct {
+		method    string
+		operation *openAPIOperation
+	}
+)
+
+// convertOpenAPI converts doc into an api spec, the object schemas become types,
+// the operations become routes and are grouped by their first tag and jwt
+func convertOpenAPI(doc *openAPIDocument, service string) (*spec.ApiSpec, error) {
+	c := &openAPIConverter{
+		doc:         doc,
+		api:         &spec.ApiSpec{Syntax: spec.ApiSyntax{Version: strconv.Quote("v1")}},
+		typeNames:   make(map[string]bool),
+		schemaTypes: make(map[string]string),
+		handlers:    make(map[string]bool),
+		resolving:   make(map[string]bool),
+	}
+
+	c.api.Info.Properties = make(map[string]string)
+	for key, value := range map[string]string{
+		"title":   doc.Info.Title,
+		"desc":    doc.Info.Description,
+		"version": doc.Info.Version,
+	} {
+		if len(value) > 0 {
+			c.api.Info.Properties[key] = strconv.Quote(value)
+		}
+	}
+
+	schemas := c.schemas()
+	for _, name := range schemas.names {
+		if schemas.schemas[name].isObject() {
+			c.schemaTypes[name] = c.newTypeName(name)
+		}
+	}
+
+	for _, name := range schemas.names {
+		tp, ok := c.schemaTypes[name]
+		if !ok {
+			continue
+		}
+
+		err := c.buildStruct(tp, schemas.schemas[name])
+		if err != nil {
+			return nil, fmt.Errorf("schema %s: %v", name, err)
+		}
+	}
+
+	err := c.convertPaths()
+	if err != nil {
+		return nil, err
+	}
+
+	c.api.Service.Name = toServiceName(service)
+	return c.api, nil
+}
+
+func (c *openAPIConverter) schemas() openAPISchemas {
+	if len(c.doc.Swagger) > 0 {
+		return c.doc.Definitions
+	}
+
+	return c.doc.Components.Schemas
+}
+
+func (c *openAPIConverter) convertPaths() error {
+	var keys []groupKey
+	groups := make(map[groupKey]*spec.Group)
+	for _, path := range c.doc.Paths.paths {
+		item := c.doc.Paths.items[path]
+		if item == nil {
+			continue
+		}
+
+		for _, v := range []openAPIMethod{
+			{method: "get", operation: item.Get},
+			{method: "put", operation: item.Put},
+			{method: "post", operation: item.Post},
+			{method: "delete", operation: item.Delete},
+			{method: "options", operation: item.Options},
+			{method: "head", operation: item.Head},
+			{method: "patch", operation: item.Patch},
+		} {
+			if v.operation == nil {
+				continue
+			}
+
+			route, err := c.convertRoute(path, v.method, item, v.operation)
+			if err != nil {
+				return fmt.Errorf("%s %s: %v", v.method, path, err)
+			}
+
+			key := c.groupKey(v.operation)
+			group, ok := groups[key]
+			if !ok {
+				group = &spec.Group{Annotation: spec.Annotation{Properties: make(map[string]string)}}
+				if len(key.group) > 0 {
+					group.Annotation.Properties["group"] = key.group
+				}
+				if len(key.jwt) > 0 {
+					group.Annotation.Properties["jwt"] = key.jwt
+				}
+
+				groups[key] = group
+				keys = append(keys, key)
+			}
+
+			group.Routes = append(group.Routes, route)
+		}
+	}
+
+	for _, key := range keys {
+		c.api.Service.Groups = append(c.api.Service.Groups, *groups[key])
+	}
+
+	return nil
+}
+
+func (c *openAPIConverter) convertRoute(path, method string, item *openAPIPathItem,
+	operation *openAPIOperation) (spec.Route, error) {
+	route := spec.Route{
+		Method:  method,
+		Handler: c.newHandlerName(method, path, operation.OperationID),
+	}
+
+	routePath, err := convertRoutePath(c.basePath() + path)
+	if err != nil {
+		return route, err
+	}
+	route.Path = routePath
+
+	summary := operation.Summary
+	if len(summary) == 0 {
+		summary = strings.TrimSpace(strings.SplitN(operation.Description, "\n", 2)[0])
+	}
+	if len(summary) > 0 {
+		route.AtDoc.Text = strconv.Quote(summary)
+	}
+
+	req, err := c.convertRequest(route, item, operation)
+	if err != nil {
+		return route, err
+	}
+	if req != nil {
+		route.RequestType = req
+	}
+
+	resp, err := c.convertResponse(route, operation)
+	if err != nil {
+		return route, err
+	}
+	if resp != nil {
+		route.ResponseType = resp
+	}
+
+	return route, nil
+}
+
+// convertRequest converts the path, query and form parameters and the request body into the request type,
+// the header and cookie parameters are ignored
+func (c *openAPIConverter) convertRequest(route spec.Route, item *openAPIPathItem,
+	operation *openAPIOperation) (spec.Type, error) {
+	parameters, err := c.parameters(item.Parameters, operation.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	// reserves the position to keep the request type in front of its nested types
+	index := len(c.api.Types)
+	name := c.newTypeName(route.Handler + "Req")
+	names := make(map[string]bool)
+	pathNames := make(map[string]bool)
+	var members []spec.Member
+	var body *openAPISchema
+	for _, parameter := range parameters {
+		switch parameter.In {
+		case "path":
+			pathNames[parameter.Name] = true
+			member, err := c.newMember(name, parameter.Name, parameter.schema(), parameter.Description,
+				pathTagKey, false, names)
+			if err != nil {
+				return nil, err
+			}
+
+			members = append(members, member)
+		case "query", "formData":
+			member, err := c.newMember(name, parameter.Name, parameter.schema(), parameter.Description,
+				formTagKey, !parameter.Required, names)
+			if err != nil {
+				return nil, err
+			}
+
+			members = append(members, member)
+		case "body":
+			body = parameter.schema()
+		}
+	}
+
+	for _, segment := range strings.Split(route.Path, "/") {
+		if !strings.HasPrefix(segment, ":") || pathNames[segment[1:]] {
+			continue
+		}
+
+		member, err := c.newMember(name, segment[1:], &openAPISchema{Type: "string"}, "", pathTagKey, false, names)
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, member)
+	}
+
+	if operation.RequestBody != nil {
+		requestBody, err := c.requestBody(operation.RequestBody)
+		if err != nil {
+			return nil, err
+		}
+
+		contentType, media := selectContent(requestBody.Content)
+		if media != nil && media.Schema != nil {
+			if strings.Contains(contentType, "json") {
+				body = media.Schema
+			} else {
+				schema, err := c.resolveSchema(media.Schema)
+				if err != nil {
+					return nil, err
+				}
+
+				list, err := c.structMembers(name, schema, formTagKey, names)
+				if err != nil {
+					return nil, err
+				}
+
+				members = append(members, list...)
+			}
+		}
+	}
+
+	if body != nil {
+		if len(members) == 0 && len(body.Ref) > 0 {
+			if tp, ok := c.schemaTypes[c.schemaName(body.Ref)]; ok {
+				delete(c.typeNames, name)
+				return spec.DefineStruct{RawName: tp, TypeName: tp}, nil
+			}
+		}
+
+		schema, err := c.resolveSchema(body)
+		if err != nil {
+			return nil, err
+		}
+
+		if !schema.isObject() {
+			return nil, errors.New("only the object request body is supported")
+		}
+
+		list, err := c.structMembers(name, &openAPISchema{AllOf: []*openAPISchema{body}}, bodyTagKey, names)
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, list...)
+	}
+
+	if len(members) == 0 {
+		delete(c.typeNames, name)
+		return nil, nil
+	}
+
+	req := spec.DefineStruct{RawName: name, TypeName: name, Members: members}
+	c.api.Types = append(c.api.Types[:index], append([]spec.Type{req}, c.api.Types[index:]...)...)
+	return spec.DefineStruct{RawName: name, TypeName: name}, nil
+}
+
+// convertResponse converts the schema of the first successful response into the response type,
+// only the objects and the arrays of objects are supported, the others are ignored
+func (c *openAPIConverter) convertResponse(route spec.Route, operation *openAPIOperation) (spec.Type, error) {
+	var codes []string
+	for code := range operation.Responses {
+		if strings.HasPrefix(code, "2") {
+			codes = append(codes, code)
+		}
+	}
+	sort.Strings(codes)
+	codes = append(codes, "default")
+
+	var response *openAPIResponse
+	for _, code := range codes {
+		if v, ok := operation.Responses[code]; ok {
+			response = v
+			break
+		}
+	}
+	if response == nil {
+		return nil, nil
+	}
+
+	response, err := c.response(response)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := response.Schema
+	if _, media := selectContent(response.Content); media != nil {
+		schema = media.Schema
+	}
+	if schema == nil {
+		return nil, nil
+	}
+
+	resolved, err := c.resolveSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	isObject := resolved.isObject()
+	if _, ok := resolved.additionalProperties(); ok && len(resolved.Properties.names) == 0 {
+		isObject = false
+	}
+	if !isObject && resolved.Type != "array" {
+		return nil, nil
+	}
+
+	tp, err := c.convertType(schema, route.Handler+"Reply")
+	if err != nil {
+		return nil, err
+	}
+
+	switch v := tp.(type) {
+	case spec.DefineStruct:
+		return v, nil
+	case spec.ArrayType:
+		if _, ok := v.Value.(spec.DefineStruct); ok {
+			return v, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (c *openAPIConverter) buildStruct(name string, schema *openAPISchema) error {
+	// reserves the position to keep the type in front of its nested types
+	index := len(c.api.Types)
+	c.api.Types = append(c.api.Types, spec.DefineStruct{})
+	members, err := c.structMembers(name, schema, bodyTagKey, make(map[string]bool))
+	if err != nil {
+		return err
+	}
+
+	c.api.Types[index] = spec.DefineStruct{
+		RawName:  name,
+		TypeName: name,
+		Members:  members,
+		Docs:     toDocs(schema.Description),
+	}
+	return nil
+}
+
+// structMembers converts the properties of schema into members with the tagKey, the schemas referenced
+// by allOf are embedded if they are json objects, otherwise their properties are merged
+func (c *openAPIConverter) structMembers(owner string, schema *openAPISchema, tagKey string,
+	names map[string]bool) ([]spec.Member, error) {
+	var members []spec.Member
+	for _, item := range schema.AllOf {
+		if len(item.Ref) > 0 && tagKey == bodyTagKey {
+			if tp, ok := c.schemaTypes[c.schemaName(item.Ref)]; ok {
+				names[tp] = true
+				members = append(members, spec.Member{
+					Type:     spec.DefineStruct{RawName: tp, TypeName: tp},
+					IsInline: true,
+				})
+				continue
+			}
+		}
+
+		resolved, err := c.resolveSchema(item)
+		if err != nil {
+			return nil, err
+		}
+
+		list, err := c.structMembers(owner, resolved, tagKey, names)
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, list...)
+	}
+
+	required := make(map[string]bool)
+	for _, item := range schema.Required {
+		required[item] = true
+	}
+
+	for _, property := range schema.Properties.names {
+		member, err := c.newMember(owner, property, schema.Properties.schemas[property], "",
+			tagKey, !required[property], names)
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, member)
+	}
+
+	return members, nil
+}
+
+func (c *openAPIConverter) newMember(owner, property string, schema *openAPISchema, description, tagKey string,
+	optional bool, names map[string]bool) (spec.Member, error) {
+	name := uniqueName(toIdentifier(property), names)
+	tp, err := c.convertType(schema, owner+name)
+	if err != nil {
+		return spec.Member{}, fmt.Errorf("property %s: %v", property, err)
+	}
+
+	resolved, err := c.resolveSchema(schema)
+	if err != nil {
+		return spec.Member{}, err
+	}
+
+	if len(description) == 0 && schema != nil {
+		description = schema.Description
+	}
+
+	var comment string
+	if docs := toDocs(description); len(docs) > 0 {
+		for i, item := range docs {
+			docs[i] = strings.TrimPrefix(item, "// ")
+		}
+		comment = "// " + strings.Join(docs, " ")
+	}
+
+	return spec.Member{
+		Name:    name,
+		Type:    tp,
+		Tag:     buildTag(tagKey, property, optional, resolved),
+		Comment: comment,
+	}, nil
+}
+
+// convertType converts schema into the api type, the nested objects become new types named with hint
+func (c *openAPIConverter) convertType(schema *openAPISchema, hint string) (spec.Type, error) {
+	if schema == nil {
+		return spec.InterfaceType{RawName: interfaceType}, nil
+	}
+
+	if len(schema.Ref) > 0 {
+		name := c.schemaName(schema.Ref)
+		if tp, ok := c.schemaTypes[name]; ok {
+			return spec.DefineStruct{RawName: tp, TypeName: tp}, nil
+		}
+
+		if c.resolving[name] {
+			return nil, fmt.Errorf("circular reference %s", schema.Ref)
+		}
+
+		c.resolving[name] = true
+		defer delete(c.resolving, name)
+		resolved, err := c.resolveSchema(schema)
+		if err != nil {
+			return nil, err
+		}
+
+		return c.convertType(resolved, hint)
+	}
+
+	if schema.isObject() {
+		value, ok := schema.additionalProperties()
+		if ok && len(schema.Properties.names) == 0 && len(schema.AllOf) == 0 {
+			tp, err := c.convertType(value, hint+"Value")
+			if err != nil {
+				return nil, err
+			}
+
+			return spec.MapType{RawName: "map[string]" + tp.Name(), Key: "string", Value: tp}, nil
+		}
+
+		if len(schema.Properties.names) == 0 && len(schema.AllOf) == 0 {
+			return spec.InterfaceType{RawName: interfaceType}, nil
+		}
+
+		name := c.newTypeName(hint)
+		err := c.buildStruct(name, schema)
+		if err != nil {
+			return nil, err
+		}
+
+		return spec.DefineStruct{RawName: name, TypeName: name}, nil
+	}
+
+	switch schema.Type {
+	case "array":
+		tp, err := c.convertType(schema.Items, hint+"Item")
+		if err != nil {
+			return nil, err
+		}
+
+		return spec.ArrayType{RawName: "[]" + tp.Name(), Value: tp}, nil
+	case "string":
+		return spec.PrimitiveType{RawName: "string"}, nil
+	case "integer":
+		if schema.Format == "int32" {
+			return spec.PrimitiveType{RawName: "int32"}, nil
+		}
+
+		return spec.PrimitiveType{RawName: "int64"}, nil
+	case "number":
+		if schema.Format == "float" {
+			return spec.PrimitiveType{RawName: "float32"}, nil
+		}
+
+		return spec.PrimitiveType{RawName: "float64"}, nil
+	case "boolean":
+		return spec.PrimitiveType{RawName: "bool"}, nil
+	}
+
+	return spec.InterfaceType{RawName: interfaceType}, nil
+}
+
+// resolveSchema follows the references of schema until it's not a reference
+func (c *openAPIConverter) resolveSchema(schema *openAPISchema) (*openAPISchema, error) {
+	for i := 0; schema != nil && len(schema.Ref) > 0; i++ {
+		if i > len(c.schemas().names) {
+			return nil, fmt.Errorf("circular reference %s", schema.Ref)
+		}
+
+		if !strings.HasPrefix(schema.Ref, swaggerDefinitionPrefix) && !strings.HasPrefix(schema.Ref, schemaPrefix) {
+			return nil, fmt.Errorf("unsupported reference %s", schema.Ref)
+		}
+
+		target, ok := c.schemas().schemas[c.schemaName(schema.Ref)]
+		if !ok {
+			return nil, fmt.Errorf("%s not found", schema.Ref)
+		}
+
+		schema = target
+	}
+
+	if schema == nil {
+		return &openAPISchema{}, nil
+	}
+
+	return schema, nil
+}
+
+// parameters merges the parameters of the path item and the operation,
+// the operation parameters override the path item parameters with the same name and location
+func (c *openAPIConverter) parameters(lists ...[]*openAPIParameter) ([]*openAPIParameter, error) {
+	var result []*openAPIParameter
+	indexes := make(map[string]int)
+	for _, list := range lists {
+		for _, item := range list {
+			if len(item.Ref) > 0 {
+				var ok bool
+				var parameters map[string]*openAPIParameter
+				name := unescapeRef(strings.TrimPrefix(strings.TrimPrefix(item.Ref, swaggerParameterPrefix),
+					parameterPrefix))
+				switch {
+				case strings.HasPrefix(item.Ref, swaggerParameterPrefix):
+					parameters = c.doc.Parameters
+				case strings.HasPrefix(item.Ref, parameterPrefix):
+					parameters = c.doc.Components.Parameters
+				default:
+					return nil, fmt.Errorf("unsupported reference %s", item.Ref)
+				}
+
+				item, ok = parameters[name]
+				if !ok {
+					return nil, fmt.Errorf("%s not found", name)
+				}
+			}
+
+			key := item.In + ":" + item.Name
+			if index, ok := indexes[key]; ok {
+				result[index] = item
+				continue
+			}
+
+			indexes[key] = len(result)
+			result = append(result, item)
+		}
+	}
+
+	return result, nil
+}
+
+func (c *openAPIConverter) requestBody(body *openAPIRequestBody) (*openAPIRequestBody, error) {
+	if len(body.Ref) == 0 {
+		return body, nil
+	}
+
+	if !strings.HasPrefix(body.Ref, requestBodyPrefix) {
+		return nil, fmt.Errorf("unsupported reference %s", body.Ref)
+	}
+
+	target, ok := c.doc.Components.RequestBodies[unescapeRef(strings.TrimPrefix(body.Ref, requestBodyPrefix))]
+	if !ok {
+		return nil, fmt.Errorf("%s not found", body.Ref)
+	}
+
+	return target, nil
+}
+
+func (c *openAPIConverter) response(response *openAPIResponse) (*openAPIResponse, error) {
+	if len(response.Ref) == 0 {
+		return response, nil
+	}
+
+	var responses map[string]*openAPIResponse
+	var name string
+	switch {
+	case strings.HasPrefix(response.Ref, swaggerResponsePrefix):
+		responses = c.doc.Responses
+		name = strings.TrimPrefix(response.Ref, swaggerResponsePrefix)
+	case strings.HasPrefix(response.Ref, responsePrefix):
+		responses = c.doc.Components.Responses
+		name = strings.TrimPrefix(response.Ref, responsePrefix)
+	default:
+		return nil, fmt.Errorf("unsupported reference %s", response.Ref)
+	}
+
+	target, ok := responses[unescapeRef(name)]
+	if !ok {
+		return nil, fmt.Errorf("%s not found", response.Ref)
+	}
+
+	return target, nil
+}
+
+// groupKey groups the operation by its first tag and the security scheme which is used as the jwt
+func (c *openAPIConverter) groupKey(operation *openAPIOperation) groupKey {
+	var key groupKey
+	if len(operation.Tags) > 0 {
+		key.group = strings.ToLower(toIdentifier(operation.Tags[0]))
+	}
+
+	security := c.doc.Security
+	if operation.Security != nil {
+		security = *operation.Security
+	}
+
+	schemes := c.doc.SecurityDefinitions
+	if len(c.doc.Swagger) == 0 {
+		schemes = c.doc.Components.SecuritySchemes
+	}
+
+	for _, requirement := range security {
+		var names []string
+		for name := range requirement {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			scheme, ok := schemes[name]
+			if !ok {
+				continue
+			}
+
+			if (scheme.Type == "http" && !strings.EqualFold(scheme.Scheme, "bearer")) || scheme.Type == "basic" {
+				continue
+			}
+
+			key.jwt = toIdentifier(name)
+			return key
+		}
+	}
+
+	return key
+}
+
+func (c *openAPIConverter) basePath() string {
+	if len(c.doc.Swagger) > 0 {
+		return strings.TrimSuffix(c.doc.BasePath, "/")
+	}
+
+	if len(c.doc.Servers) == 0 {
+		return ""
+	}
+
+	u, err := url.Parse(c.doc.Servers[0].URL)
+	if err != nil || strings.Contains(u.Path, "{") {
+		return ""
+	}
+
+	return strings.TrimSuffix(u.Path, "/")
+}
+
+func (c *openAPIConverter) schemaName(ref string) string {
+	ref = strings.TrimPrefix(ref, swaggerDefinitionPrefix)
+	return unescapeRef(strings.TrimPrefix(ref, schemaPrefix))
+}
+
+func (c *openAPIConverter) newTypeName(name string) string {
+	return uniqueName(toIdentifier(name), c.typeNames)
+}
+
+func (c *openAPIConverter) newHandlerName(method, path, operationID string) string {
+	name := operationID
+	if len(name) == 0 {
+		name = method + " " + path
+	}
+
+	return uniqueName(toIdentifier(name), c.handlers)
+}
+
+// selectContent returns the json content in front of the others
+func selectContent(content map[string]*openAPIMediaType) (string, *openAPIMediaType) {
+	var types []string
+	for tp := range content {
+		types = append(types, tp)
+	}
+	sort.Strings(types)
+
+	for _, tp := range types {
+		if strings.Contains(tp, "json") {
+			return tp, content[tp]
+		}
+	}
+
+	for _, tp := range types {
+		if strings.HasPrefix(tp, "application/x-www-form-urlencoded") || strings.HasPrefix(tp, "multipart/form-data") {
+			return tp, content[tp]
+		}
+	}
+
+	return "", nil
+}
+
+// buildTag builds the tag of the member with the options such as optional, options, default and range
+func buildTag(key, name string, optional bool, schema *openAPISchema) string {
+	options := []string{name}
+	if optional && key != pathTagKey {
+		options = append(options, "optional")
+	}
+
+	if schema != nil {
+		var values []string
+		for _, item := range schema.Enum {
+			value, ok := tagValue(item)
+			if !ok {
+				values = nil
+				break
+			}
+
+			values = append(values, value)
+		}
+		if len(values) > 0 {
+			options = append(options, "options="+strings.Join(values, "|"))
+		}
+
+		if value, ok := tagValue(schema.Default); ok && schema.Default != nil {
+			options = append(options, "default="+value)
+		}
+
+		if value, ok := tagRange(schema); ok {
+			options = append(options, "range="+value)
+		}
+	}
+
+	return fmt.Sprintf("`%s:\"%s\"`", key, strings.Join(options, ","))
+}
+
+func tagValue(v interface{}) (string, bool) {
+	var value string
+	switch val := v.(type) {
+	case string:
+		value = val
+	case float64:
+		value = strconv.FormatFloat(val, 'f', -1, 64)
+	case bool:
+		value = strconv.FormatBool(val)
+	default:
+		return "", false
+	}
+
+	if len(value) == 0 || strings.ContainsAny(value, ",|\"` \t\r\n") {
+		return "", false
+	}
+
+	return value, true
+}
+
+func tagRange(schema *openAPISchema) (string, bool) {
+	min, max := schema.Minimum, schema.Maximum
+	minExclusive, maxExclusive := schema.ExclusiveMinimum == true, schema.ExclusiveMaximum == true
+	// the exclusive bounds are numbers since openapi 3.1
+	if v, ok := schema.ExclusiveMinimum.(float64); ok {
+		min, minExclusive = &v, true
+	}
+	if v, ok := schema.ExclusiveMaximum.(float64); ok {
+		max, maxExclusive = &v, true
+	}
+
+	if min == nil && max == nil {
+		return "", false
+	}
+
+	var builder strings.Builder
+	if minExclusive {
+		builder.WriteString("(")
+	} else {
+		builder.WriteString("[")
+	}
+	if min != nil {
+		builder.WriteString(strconv.FormatFloat(*min, 'f', -1, 64))
+	}
+	builder.WriteString(":")
+	if max != nil {
+		builder.WriteString(strconv.FormatFloat(*max, 'f', -1, 64))
+	}
+	if maxExclusive {
+		builder.WriteString(")")
+	} else {
+		builder.WriteString("]")
+	}
+
+	return builder.String(), true
+}
+
+// convertRoutePath converts the path parameters such as {id} into :id
+func convertRoutePath(path string) (string, error) {
+	path = strings.Trim(path, "/")
+	if len(path) == 0 {
+		return "", errors.New("the root path is not supported")
+	}
+
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			segment = segment[1 : len(segment)-1]
+			if !pathParameterRegex.MatchString(segment) {
+				return "", fmt.Errorf("unsupported path parameter %q", segment)
+			}
+
+			segments[i] = ":" + segment
+			continue
+		}
+
+		if !pathSegmentRegex.MatchString(segment) {
+			return "", fmt.Errorf("unsupported path segment %q", segment)
+		}
+	}
+
+	return "/" + strings.Join(segments, "/"), nil
+}
+
+// toIdentifier converts s into an exported identifier which only contains letters and digits,
+// such as user_name into UserName
+func toIdentifier(s string) string {
+	var builder strings.Builder
+	upper := true
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		builder.WriteRune(r)
+	}
+
+	identifier := builder.String()
+	if len(identifier) == 0 {
+		return "X"
+	}
+
+	if unicode.IsDigit(rune(identifier[0])) {
+		return "X" + identifier
+	}
+
+	return identifier
+}
+
+func toServiceName(s string) string {
+	var parts []string
+	for _, item := range strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	}) {
+		if unicode.IsDigit(rune(item[0])) {
+			item = "_" + item
+		}
+		parts = append(parts, item)
+	}
+
+	return strings.Join(parts, "-")
+}
+
+func toDocs(description string) []string {
+	var docs []string
+	for _, line := range strings.Split(description, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) > 0 {
+			docs = append(docs, "// "+line)
+		}
+	}
+
+	return docs
+}
+
+func uniqueName(name string, names map[string]bool) string {
+	result := name
+	for i := 1; names[result]; i++ {
+		result = fmt.Sprintf("%s%d", name, i)
+	}
+
+	names[result] = true
+	return result
+}
+
+func unescapeRef(ref string) string {
+	ref = strings.ReplaceAll(ref, "~1", "/")
+	return strings.ReplaceAll(ref, "~0", "~")
+}
@@ -0,0 +1,578 @@
+package apigen
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/emicklei/proto"
+	"github.com/logrusorgru/aurora"
+	"github.com/urfave/cli"
+	"github.com/zeromicro/goctl/api/format"
+	"github.com/zeromicro/goctl/api/gogen"
+	"github.com/zeromicro/goctl/api/spec"
+	"github.com/zeromicro/goctl/rpc/parser"
+	"github.com/zeromicro/goctl/util"
+	"github.com/zeromicro/goctl/util/console"
+)
+
+const (
+	httpOption  = "(google.api.http)"
+	httpBodyAll = "*"
+)
+
+// the kinds of the proto fields
+const (
+	scalarKind = iota
+	enumKind
+	messageKind
+)
+
+var protoScalarTypes = map[string]string{
+	"double":   "float64",
+	"float":    "float32",
+	"int32":    "int32",
+	"sint32":   "int32",
+	"sfixed32": "int32",
+	"int64":    "int64",
+	"sint64":   "int64",
+	"sfixed64": "int64",
+	"uint32":   "uint32",
+	"fixed32":  "uint32",
+	"uint64":   "uint64",
+	"fixed64":  "uint64",
+	"bool":     "bool",
+	"string":   "string",
+	"bytes":    "[]byte",
+}
+
+type (
+	protoConverter struct {
+		proto   parser.Proto
+		api     *spec.ApiSpec
+		console console.Console
+		// messages and enums are keyed by their full names without the package, such as User.Address
+		messages map[string]*protoMessage
+		enums    map[string]string
+		// types contains the api types converted from the messages or built for the requests in order
+		types     []*protoType
+		typeNames map[string]bool
+		handlers  map[string]bool
+		routes    []protoRoute
+	}
+
+	protoMessage struct {
+		*proto.Message
+		fullName string
+		goName   string
+		fields   []*protoField
+	}
+
+	protoField struct {
+		name    string
+		goName  string
+		kind    int
+		repeat  bool
+		pointer bool
+		// oneof is the go name of the oneof which the field belongs to
+		oneof string
+		// goType is the go type of the element or the map value in the pb package
+		goType string
+		// key is the type of the map key, it's empty if the field is not a map
+		key string
+		tp  spec.Type
+		tag string
+		doc []string
+	}
+
+	// protoType is an api type which is converted from or mapped to a message
+	protoType struct {
+		name    string
+		message *protoMessage
+		fields  []*protoField
+	}
+
+	protoRoute struct {
+		service string
+		rpc     *parser.RPC
+		handler string
+		request *protoType
+		reply   *protoMessage
+	}
+
+	httpRule struct {
+		method string
+		path   string
+		body   string
+	}
+)
+
+// FromProtoCommand converts the services of a proto file into an api file, the messages become types and the
+// rpcs become routes, the logic forwarding the requests to the rpc clients is generated if -dir is set
+func FromProtoCommand(c *cli.Context) error {
+	src := c.String("src")
+	apiFile := c.String("o")
+	dir := c.String("dir")
+	rpcPkg := c.String("rpc")
+	style := c.String("style")
+	if len(src) == 0 {
+		return errors.New("missing -src")
+	}
+
+	if len(apiFile) == 0 {
+		return errors.New("missing -o")
+	}
+
+	if len(dir) > 0 && len(rpcPkg) == 0 {
+		return errors.New("missing -rpc")
+	}
+
+	if util.FileExists(apiFile) {
+		return fmt.Errorf("%s already exists", apiFile)
+	}
+
+	p, err := parser.NewDefaultProtoParser().Parse(src)
+	if err != nil {
+		return err
+	}
+
+	pc, err := convertProto(p, serviceName(apiFile))
+	if err != nil {
+		return fmt.Errorf("%s: %v", src, err)
+	}
+
+	content, err := format.ApiFormatByContent(buildApi(pc.api), filepath.Dir(apiFile))
+	if err != nil {
+		return err
+	}
+
+	err = util.MkdirIfNotExist(filepath.Dir(apiFile))
+	if err != nil {
+		return err
+	}
+
+	err = util.WriteFile(apiFile, []byte(content), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	if len(dir) == 0 {
+		fmt.Println(aurora.Green("Done."))
+		return nil
+	}
+
+	// the api file is not written in the dry run mode, so the gateway is generated from a temporary copy
+	apiPath := apiFile
+	if util.IsDryRun() {
+		tmpDir, err := ioutil.TempDir("", "goctl-from-proto")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(tmpDir)
+
+		apiPath = filepath.Join(tmpDir, filepath.Base(apiFile))
+		err = ioutil.WriteFile(apiPath, []byte(content), os.ModePerm)
+		if err != nil {
+			return err
+		}
+	}
+
+	return gogen.DoGenGatewayProject(apiPath, dir, style, pc.gateway(rpcPkg))
+}
+
+// convertProto converts the messages into types and the unary rpcs into routes,
+// the routes of every proto service are in a group
+func convertProto(p parser.Proto, service string) (*protoConverter, error) {
+	c := &protoConverter{
+		proto: p,
+		api: &spec.ApiSpec{
+			Syntax: spec.ApiSyntax{Version: strconv.Quote("v1")},
+			Info: spec.Info{Properties: map[string]string{
+				"title": strconv.Quote(fmt.Sprintf("generated from %s", p.Name)),
+			}},
+		},
+		console:   console.NewColorConsole(),
+		messages:  make(map[string]*protoMessage),
+		enums:     make(map[string]string),
+		typeNames: make(map[string]bool),
+		handlers:  make(map[string]bool),
+	}
+
+	var messages []*protoMessage
+	for _, item := range p.Message {
+		if item.IsExtend {
+			continue
+		}
+
+		names := scopeNames(item.Message)
+		message := &protoMessage{
+			Message:  item.Message,
+			fullName: strings.Join(names, "."),
+			goName:   protoGoName(names),
+		}
+		c.messages[message.fullName] = message
+		messages = append(messages, message)
+	}
+
+	for _, item := range p.Enum {
+		names := scopeNames(item.Enum)
+		c.enums[strings.Join(names, ".")] = protoGoName(names)
+	}
+
+	for _, message := range messages {
+		c.convertFields(message)
+		c.typeNames[message.goName] = true
+		c.types = append(c.types, &protoType{
+			name:    message.goName,
+			message: message,
+			fields:  message.fields,
+		})
+	}
+
+	for _, item := range p.Service {
+		group := spec.Group{}
+		for _, rpc := range item.RPC {
+			if !rpc.IsUnary() {
+				c.console.Warning("%s.%s: the streaming rpc is ignored", item.Name, rpc.Name)
+				continue
+			}
+
+			route, err := c.convertRoute(item, rpc)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %v", item.Name, rpc.Name, err)
+			}
+
+			group.Routes = append(group.Routes, route)
+		}
+
+		if len(group.Routes) > 0 {
+			c.api.Service.Groups = append(c.api.Service.Groups, group)
+		}
+	}
+
+	if len(c.api.Service.Groups) == 0 {
+		return nil, errors.New("no unary rpc found")
+	}
+
+	for _, item := range c.types {
+		c.api.Types = append(c.api.Types, item.define())
+	}
+	c.api.Service.Name = toServiceName(service)
+
+	return c, nil
+}
+
+func (c *protoConverter) convertFields(message *protoMessage) {
+	for _, element := range message.Elements {
+		switch v := element.(type) {
+		case *proto.NormalField:
+			c.addField(message, v.Field, v.Repeated, v.Optional || v.Required, "", "")
+		case *proto.MapField:
+			c.addField(message, v.Field, false, false, "", v.KeyType)
+		case *proto.Oneof:
+			for _, item := range v.Elements {
+				if field, ok := item.(*proto.OneOfField); ok {
+					c.addField(message, field.Field, false, false, parser.CamelCase(v.Name), "")
+				}
+			}
+		}
+	}
+}
+
+func (c *protoConverter) addField(message *protoMessage, field *proto.Field, repeat, pointer bool, oneof, key string) {
+	f := &protoField{
+		name:    field.Name,
+		goName:  parser.CamelCase(field.Name),
+		repeat:  repeat,
+		pointer: pointer,
+		oneof:   oneof,
+		key:     key,
+		tag:     fmt.Sprintf("`json:\"%s,optional\"`", field.Name),
+	}
+
+	if len(key) > 0 {
+		if _, ok := protoScalarTypes[key]; !ok {
+			c.console.Warning("%s.%s: unsupported map key type %s, the field is ignored", message.fullName, field.Name, key)
+			return
+		}
+	}
+
+	tp, ok := c.resolveField(message, field.Type, f)
+	if !ok {
+		c.console.Warning("%s.%s: unsupported type %s, the field is ignored", message.fullName, field.Name, field.Type)
+		return
+	}
+
+	switch {
+	case len(key) > 0:
+		f.tp = spec.MapType{
+			RawName: fmt.Sprintf("map[%s]%s", protoScalarTypes[key], tp.Name()),
+			Key:     protoScalarTypes[key],
+			Value:   tp,
+		}
+	case repeat:
+		f.tp = spec.ArrayType{RawName: "[]" + tp.Name(), Value: tp}
+	case f.kind == messageKind:
+		f.tp = spec.PointerType{RawName: "*" + tp.Name(), Type: tp}
+	default:
+		f.tp = tp
+	}
+
+	if field.Comment != nil {
+		for _, line := range field.Comment.Lines {
+			if line = strings.TrimSpace(line); len(line) > 0 {
+				f.doc = append(f.doc, "// "+line)
+			}
+		}
+	}
+
+	message.fields = append(message.fields, f)
+}
+
+// resolveField resolves the type of a field in the scope of message, the enums are converted into int32
+func (c *protoConverter) resolveField(message *protoMessage, name string, field *protoField) (spec.Type, bool) {
+	if tp, ok := protoScalarTypes[name]; ok {
+		field.kind = scalarKind
+		field.goType = tp
+		if tp == "[]byte" {
+			return spec.ArrayType{RawName: tp, Value: spec.PrimitiveType{RawName: "byte"}}, true
+		}
+
+		return spec.PrimitiveType{RawName: tp}, true
+	}
+
+	if strings.HasPrefix(name, ".") {
+		name = strings.TrimPrefix(name, ".")
+		if len(c.proto.Package.Name) > 0 && !strings.HasPrefix(name, c.proto.Package.Name+".") {
+			return nil, false
+		}
+	}
+	if len(c.proto.Package.Name) > 0 {
+		name = strings.TrimPrefix(name, c.proto.Package.Name+".")
+	}
+
+	scope := message.fullName
+	for {
+		fullName := name
+		if len(scope) > 0 {
+			fullName = scope + "." + name
+		}
+
+		if m, ok := c.messages[fullName]; ok {
+			field.kind = messageKind
+			field.goType = m.goName
+			return spec.DefineStruct{RawName: m.goName, TypeName: m.goName}, true
+		}
+
+		if e, ok := c.enums[fullName]; ok {
+			field.kind = enumKind
+			field.goType = e
+			return spec.PrimitiveType{RawName: "int32"}, true
+		}
+
+		if len(scope) == 0 {
+			return nil, false
+		}
+
+		if index := strings.LastIndex(scope, "."); index >= 0 {
+			scope = scope[:index]
+		} else {
+			scope = ""
+		}
+	}
+}
+
+func (c *protoConverter) convertRoute(service parser.Service, rpc *parser.RPC) (spec.Route, error) {
+	rule, err := parseHttpRule(service, rpc)
+	if err != nil {
+		return spec.Route{}, err
+	}
+
+	path, err := convertRoutePath(rule.path)
+	if err != nil {
+		return spec.Route{}, err
+	}
+
+	request, ok := c.messages[rpc.RequestType]
+	if !ok {
+		return spec.Route{}, fmt.Errorf("message %s not found", rpc.RequestType)
+	}
+
+	reply, ok := c.messages[rpc.ReturnsType]
+	if !ok {
+		return spec.Route{}, fmt.Errorf("message %s not found", rpc.ReturnsType)
+	}
+
+	handler := uniqueName(parser.CamelCase(rpc.Name), c.handlers)
+	req, err := c.requestType(handler, request, path, rule.body)
+	if err != nil {
+		return spec.Route{}, err
+	}
+
+	route := spec.Route{
+		Method:       rule.method,
+		Path:         path,
+		Handler:      handler,
+		RequestType:  spec.DefineStruct{RawName: req.name, TypeName: req.name},
+		ResponseType: spec.DefineStruct{RawName: reply.goName, TypeName: reply.goName},
+	}
+	if comment := rpc.Comment; comment != nil {
+		route.AtDoc.Text = strconv.Quote(strings.TrimSpace(strings.Join(comment.Lines, " ")))
+	}
+
+	c.routes = append(c.routes, protoRoute{
+		service: service.Name,
+		rpc:     rpc,
+		handler: handler,
+		request: req,
+		reply:   reply,
+	})
+
+	return route, nil
+}
+
+// requestType returns the message itself if all of its fields are in the body, otherwise a request type
+// is built, the path parameters become path members, the body fields become json members and the scalar
+// fields left become form members
+func (c *protoConverter) requestType(handler string, message *protoMessage, path, body string) (*protoType, error) {
+	params := make(map[string]bool)
+	for _, segment := range strings.Split(path, "/") {
+		if strings.HasPrefix(segment, ":") {
+			params[segment[1:]] = true
+		}
+	}
+
+	if len(params) == 0 && body == httpBodyAll {
+		for _, item := range c.types {
+			if item.message == message && item.name == message.goName {
+				return item, nil
+			}
+		}
+	}
+
+	fields := make(map[string]bool)
+	for _, field := range message.fields {
+		fields[field.name] = true
+	}
+	for param := range params {
+		if !fields[param] {
+			return nil, fmt.Errorf("path parameter %s not found in %s", param, message.fullName)
+		}
+	}
+	if len(body) > 0 && body != httpBodyAll && !fields[body] {
+		return nil, fmt.Errorf("body field %s not found in %s", body, message.fullName)
+	}
+
+	req := &protoType{
+		name:    uniqueName(handler+"HttpReq", c.typeNames),
+		message: message,
+	}
+	for _, field := range message.fields {
+		f := *field
+		switch {
+		case params[field.name]:
+			if field.repeat || field.kind == messageKind || len(field.key) > 0 {
+				return nil, fmt.Errorf("path parameter %s must be a scalar", field.name)
+			}
+
+			f.tag = fmt.Sprintf("`path:\"%s\"`", field.name)
+		case body == httpBodyAll || body == field.name:
+		case field.kind == messageKind || len(field.key) > 0 || field.goType == "[]byte":
+			c.console.Warning("%s: %s is neither a scalar nor in the body, the field is ignored", handler, field.name)
+			continue
+		default:
+			f.tag = fmt.Sprintf("`form:\"%s,optional\"`", field.name)
+		}
+
+		req.fields = append(req.fields, &f)
+	}
+	c.types = append(c.types, req)
+
+	return req, nil
+}
+
+func (t *protoType) define() spec.DefineStruct {
+	var members []spec.Member
+	for _, field := range t.fields {
+		members = append(members, spec.Member{
+			Name: field.goName,
+			Type: field.tp,
+			Tag:  field.tag,
+			Docs: field.doc,
+		})
+	}
+
+	return spec.DefineStruct{RawName: t.name, TypeName: t.name, Members: members}
+}
+
+// parseHttpRule parses the google.api.http option of rpc, the rpc is posted to /service/rpc if there's no option
+func parseHttpRule(service parser.Service, rpc *parser.RPC) (httpRule, error) {
+	for _, element := range rpc.Elements {
+		option, ok := element.(*proto.Option)
+		if !ok || option.Name != httpOption {
+			continue
+		}
+
+		var rule httpRule
+		for _, item := range option.Constant.OrderedMap {
+			switch item.Name {
+			case "get", "put", "post", "delete", "patch":
+				rule.method = item.Name
+				rule.path = item.Source
+			case "body":
+				rule.body = item.Source
+			case "custom":
+				return rule, errors.New("the custom http method is not supported")
+			}
+		}
+
+		if len(rule.method) == 0 {
+			return rule, errors.New("missing the http method in google.api.http")
+		}
+
+		return rule, nil
+	}
+
+	return httpRule{
+		method: "post",
+		path:   fmt.Sprintf("/%s/%s", util.Untitle(service.Name), util.Untitle(rpc.Name)),
+		body:   httpBodyAll,
+	}, nil
+}
+
+// scopeNames returns the names of v and its parent messages from the outermost one
+func scopeNames(v proto.Visitee) []string {
+	var names []string
+	for v != nil {
+		switch item := v.(type) {
+		case *proto.Message:
+			names = append([]string{item.Name}, names...)
+			v = item.Parent
+		case *proto.Enum:
+			names = append([]string{item.Name}, names...)
+			v = item.Parent
+		default:
+			v = nil
+		}
+	}
+
+	return names
+}
+
+// protoGoName returns the go name of a nested message or enum generated by protoc-gen-go,
+// such as User_Address for Address in User
+func protoGoName(names []string) string {
+	var builder strings.Builder
+	for i, name := range names {
+		if i > 0 && len(name) > 0 && !(name[0] >= 'a' && name[0] <= 'z') {
+			builder.WriteString("_")
+		}
+		builder.WriteString(parser.CamelCase(name))
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,102 @@
+package apigen
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zeromicro/goctl/api/format"
+	"github.com/zeromicro/goctl/api/parser"
+	rpcparser "github.com/zeromicro/goctl/rpc/parser"
+)
+
+func TestFromProto(t *testing.T) {
+	p, err := rpcparser.NewDefaultProtoParser().Parse("testdata/greet.proto")
+	assert.Nil(t, err)
+
+	c, err := convertProto(p, serviceName("greet.api"))
+	assert.Nil(t, err)
+
+	apiFile := filepath.Join(t.TempDir(), "greet.api")
+	err = ioutil.WriteFile(apiFile, []byte(buildApi(c.api)), 0644)
+	assert.Nil(t, err)
+
+	err = format.ApiFormatByPath(apiFile)
+	assert.Nil(t, err)
+
+	api, err := parser.Parse(apiFile)
+	assert.Nil(t, err)
+	assert.Equal(t, "greet-api", api.Service.Name)
+
+	user := findType(t, api, "User")
+	assert.Equal(t, []string{"// the id of user"}, []string(user.Members[0].Docs))
+	assert.Equal(t, "int32", user.Members[2].Type.Name())
+	assert.Equal(t, "*User_Address", user.Members[3].Type.Name())
+	assert.Equal(t, "[]User_Address", user.Members[4].Type.Name())
+	assert.Equal(t, "map[string]User_Address", user.Members[5].Type.Name())
+	assert.Equal(t, "[]byte", user.Members[7].Type.Name())
+	assert.Equal(t, "`json:\"email,optional\"`", user.Members[8].Tag)
+	findType(t, api, "User_Address")
+
+	routes := api.Service.Routes()
+	// the streaming rpc is ignored
+	assert.Len(t, routes, 4)
+	assert.Equal(t, "get", routes[0].Method)
+	assert.Equal(t, "/v1/users/:id", routes[0].Path)
+	assert.Equal(t, `"get a user"`, routes[0].AtDoc.Text)
+	assert.Equal(t, "GetUserHttpReq", routes[0].RequestTypeName())
+	assert.Equal(t, "User", routes[0].ResponseTypeName())
+	assert.Equal(t, []string{"`path:\"id\"`", "`form:\"lang,optional\"`"},
+		memberTags(findType(t, api, "GetUserHttpReq").Members))
+	assert.Equal(t, []string{"`path:\"id\"`", "`json:\"user,optional\"`"},
+		memberTags(findType(t, api, "UpdateUserHttpReq").Members))
+	assert.Equal(t, "post", routes[2].Method)
+	assert.Equal(t, "/greet/createUser", routes[2].Path)
+	assert.Equal(t, "User", routes[2].RequestTypeName())
+
+	gateway := c.gateway("example.com/greet")
+	assert.Len(t, gateway.Clients, 1)
+	assert.Equal(t, "GreetRpc", gateway.Clients[0].Name)
+	assert.Equal(t, "example.com/greet/greetclient", gateway.Clients[0].Package)
+	assert.Equal(t, "Greet", gateway.Clients[0].Service)
+	assert.Equal(t, "greet.rpc", gateway.Clients[0].Key)
+	assert.Equal(t, []string{"example.com/greet/greet"}, gateway.Converter.Imports)
+	assert.Contains(t, gateway.Logic["GetUser"].Body, "l.svcCtx.GreetRpc.GetUser(l.ctx, toPbGetUserHttpReq(req))")
+	assert.Contains(t, gateway.Converter.Body, "out.Contact = &greet.User_Email{Email: in.Email}")
+	assert.Contains(t, gateway.Converter.Body, "out.Places[k] = toPbUser_Address(v)")
+	assert.Contains(t, gateway.Converter.Body, "out.Gender = int32(in.GetGender())")
+	assert.Contains(t, gateway.Converter.Body, "func toPbUpdateUserHttpReq(in types.UpdateUserHttpReq) *greet.UpdateUserReq {")
+}
+
+func TestFromProtoError(t *testing.T) {
+	for _, item := range []string{
+		`option (google.api.http) = { custom: { kind: "head" path: "/foo" } };`,
+		`option (google.api.http) = { get: "/foo/{bar}" };`,
+		`option (google.api.http) = { get: "/foo/{id=users/*}" };`,
+		`option (google.api.http) = { post: "/foo" body: "bar" };`,
+	} {
+		src := filepath.Join(t.TempDir(), "foo.proto")
+		err := ioutil.WriteFile(src, []byte(`syntax = "proto3";
+package foo;
+message Req { int64 id = 1; }
+service Foo {
+  rpc Get(Req) returns (Req) {
+    `+item+`
+  }
+}
+`), 0644)
+		assert.Nil(t, err)
+
+		p, err := rpcparser.NewDefaultProtoParser().Parse(src)
+		assert.Nil(t, err)
+
+		_, err = convertProto(p, "foo")
+		assert.NotNil(t, err, item)
+	}
+}
+
+func TestProtoGoName(t *testing.T) {
+	assert.Equal(t, "User_Address", protoGoName([]string{"User", "Address"}))
+	assert.Equal(t, "UserInfoAddress", protoGoName([]string{"user_info", "address"}))
+}
@@ -0,0 +1,191 @@
+package apigen
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/zeromicro/goctl/api/gogen"
+	"github.com/zeromicro/goctl/rpc/generator"
+	"github.com/zeromicro/goctl/rpc/parser"
+	"github.com/zeromicro/goctl/util/stringx"
+)
+
+const (
+	typesPackage = "types"
+	rpcKeySuffix = ".rpc"
+)
+
+// gateway describes the rpc clients of the proto services in rpcPkg, which is the import path of the
+// rpc service generated by goctl rpc, the logic of the routes forwards the requests to the clients
+func (c *protoConverter) gateway(rpcPkg string) *gogen.Gateway {
+	gateway := &gogen.Gateway{
+		Logic: make(map[string]gogen.GatewayCode),
+		Converter: gogen.GatewayCode{
+			Imports: []string{path.Join(rpcPkg, c.proto.GoPackage)},
+			Body:    c.converter(),
+		},
+	}
+
+	key := strings.ToLower(stringx.From(path.Base(rpcPkg)).ToCamel()) + rpcKeySuffix
+	for _, item := range c.proto.Service {
+		gateway.Clients = append(gateway.Clients, gogen.GatewayClient{
+			Name:    clientName(item.Name),
+			Package: path.Join(rpcPkg, generator.CallPackageName(c.proto, item)),
+			Service: stringx.From(item.Name).ToCamel(),
+			Key:     key,
+		})
+	}
+
+	for _, route := range c.routes {
+		var builder strings.Builder
+		fmt.Fprintf(&builder, "resp, err := l.svcCtx.%s.%s(l.ctx, toPb%s(req))\n",
+			clientName(route.service), parser.CamelCase(route.rpc.Name), route.request.name)
+		builder.WriteString("if err != nil {\nreturn nil, err\n}\n\n")
+		fmt.Fprintf(&builder, "reply := fromPb%s(resp)\nreturn &reply, nil", route.reply.goName)
+		gateway.Logic[route.handler] = gogen.GatewayCode{Body: builder.String()}
+	}
+
+	return gateway
+}
+
+// converter generates the functions converting the types into the messages and back
+func (c *protoConverter) converter() string {
+	var builder strings.Builder
+	for _, item := range c.types {
+		c.writeToPb(&builder, item)
+		if item.name == item.message.goName {
+			c.writeFromPb(&builder, item)
+		}
+	}
+
+	return builder.String()
+}
+
+func (c *protoConverter) writeToPb(builder *strings.Builder, t *protoType) {
+	pb := c.proto.PbPackage
+	fmt.Fprintf(builder, "func toPb%s(in %s.%s) *%s.%s {\n", t.name, typesPackage, t.name, pb, t.message.goName)
+	fmt.Fprintf(builder, "out := new(%s.%s)\n", pb, t.message.goName)
+	for _, field := range t.fields {
+		in, out := "in."+field.goName, "out."+field.goName
+		switch {
+		case len(field.oneof) > 0:
+			fmt.Fprintf(builder, "if %s {\nout.%s = &%s.%s_%s{%s: %s}\n}\n", field.nonZero(in), field.oneof,
+				pb, t.message.goName, field.goName, field.goName, c.toPbValue(field, field.deref(in)))
+		case len(field.key) > 0 && field.kind != scalarKind:
+			fmt.Fprintf(builder, "if len(%s) > 0 {\n%s = make(map[%s]%s, len(%s))\n", in, out,
+				protoScalarTypes[field.key], c.pbType(field), in)
+			fmt.Fprintf(builder, "for k, v := range %s {\n%s[k] = %s\n}\n}\n", in, out, c.toPbValue(field, "v"))
+		case field.repeat && field.kind != scalarKind:
+			fmt.Fprintf(builder, "for _, item := range %s {\n%s = append(%s, %s)\n}\n", in, out, out,
+				c.toPbValue(field, "item"))
+		case field.kind == messageKind:
+			fmt.Fprintf(builder, "if %s != nil {\n%s = %s\n}\n", in, out, c.toPbValue(field, "*"+in))
+		case field.pointer && field.kind == enumKind:
+			fmt.Fprintf(builder, "%s = %s.Enum()\n", out, c.toPbValue(field, in))
+		case field.pointer:
+			fmt.Fprintf(builder, "%s = &%s\n", out, in)
+		default:
+			fmt.Fprintf(builder, "%s = %s\n", out, c.toPbValue(field, in))
+		}
+	}
+	builder.WriteString("\nreturn out\n}\n\n")
+}
+
+func (c *protoConverter) writeFromPb(builder *strings.Builder, t *protoType) {
+	pb := c.proto.PbPackage
+	fmt.Fprintf(builder, "func fromPb%s(in *%s.%s) %s.%s {\n", t.name, pb, t.message.goName, typesPackage, t.name)
+	fmt.Fprintf(builder, "var out %s.%s\n", typesPackage, t.name)
+	for _, field := range t.fields {
+		in, out := fmt.Sprintf("in.Get%s()", field.goName), "out."+field.goName
+		switch {
+		case len(field.key) > 0 && field.kind != scalarKind:
+			fmt.Fprintf(builder, "if len(%s) > 0 {\n%s = make(map[%s]%s, len(%s))\n", in, out,
+				protoScalarTypes[field.key], c.apiType(field), in)
+			fmt.Fprintf(builder, "for k, v := range %s {\n%s[k] = %s\n}\n}\n", in, out, c.fromPbValue(field, "v"))
+		case field.repeat && field.kind != scalarKind:
+			fmt.Fprintf(builder, "for _, item := range %s {\n%s = append(%s, %s)\n}\n", in, out, out,
+				c.fromPbValue(field, "item"))
+		case field.kind == messageKind:
+			fmt.Fprintf(builder, "if v := %s; v != nil {\nitem := %s\n%s = &item\n}\n", in,
+				c.fromPbValue(field, "v"), out)
+		default:
+			fmt.Fprintf(builder, "%s = %s\n", out, c.fromPbValue(field, in))
+		}
+	}
+	builder.WriteString("\nreturn out\n}\n\n")
+}
+
+// toPbValue converts the element or the map value v of field into the pb type
+func (c *protoConverter) toPbValue(field *protoField, v string) string {
+	switch field.kind {
+	case enumKind:
+		return fmt.Sprintf("%s.%s(%s)", c.proto.PbPackage, field.goType, v)
+	case messageKind:
+		return fmt.Sprintf("toPb%s(%s)", field.goType, v)
+	default:
+		return v
+	}
+}
+
+// fromPbValue converts the element or the map value v of field from the pb type
+func (c *protoConverter) fromPbValue(field *protoField, v string) string {
+	switch field.kind {
+	case enumKind:
+		return fmt.Sprintf("int32(%s)", v)
+	case messageKind:
+		return fmt.Sprintf("fromPb%s(%s)", field.goType, v)
+	default:
+		return v
+	}
+}
+
+func (c *protoConverter) pbType(field *protoField) string {
+	switch field.kind {
+	case enumKind:
+		return fmt.Sprintf("%s.%s", c.proto.PbPackage, field.goType)
+	case messageKind:
+		return fmt.Sprintf("*%s.%s", c.proto.PbPackage, field.goType)
+	default:
+		return field.goType
+	}
+}
+
+func (c *protoConverter) apiType(field *protoField) string {
+	switch field.kind {
+	case enumKind:
+		return "int32"
+	case messageKind:
+		return fmt.Sprintf("%s.%s", typesPackage, field.goType)
+	default:
+		return field.goType
+	}
+}
+
+// nonZero returns the condition that v of field isn't the zero value, which is used to set the oneof
+func (f *protoField) nonZero(v string) string {
+	switch {
+	case f.kind == messageKind:
+		return v + " != nil"
+	case f.goType == "[]byte":
+		return fmt.Sprintf("len(%s) > 0", v)
+	case f.goType == "bool":
+		return v
+	case f.goType == "string":
+		return v + ` != ""`
+	default:
+		return v + " != 0"
+	}
+}
+
+func (f *protoField) deref(v string) string {
+	if f.kind == messageKind {
+		return "*" + v
+	}
+
+	return v
+}
+
+func clientName(service string) string {
+	return stringx.From(service).ToCamel() + "Rpc"
+}
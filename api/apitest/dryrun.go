@@ -0,0 +1,63 @@
+// Package apitest provides the helpers to test the commands of goctl api
+package apitest
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+	"github.com/zeromicro/goctl/util"
+)
+
+// DryRunCase is a generating command run by AssertDryRun
+type DryRunCase struct {
+	Name   string
+	Action func(*cli.Context) error
+	// Flags defines the flags of the command besides -api and -dir, optional
+	Flags func(set *flag.FlagSet)
+}
+
+// AssertDryRun runs the commands of cases with the api under util.DryRun, into a new dir and a dir
+// with an existing file, and asserts that nothing is written
+func AssertDryRun(t *testing.T, api string, cases []DryRunCase) {
+	util.SetWriteMode(util.DryRun)
+	defer util.SetWriteMode(util.WriteToDisk)
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			dir := t.TempDir()
+			filename := filepath.Join(dir, "user.api")
+			err := ioutil.WriteFile(filename, []byte(api), os.ModePerm)
+			assert.Nil(t, err)
+
+			existing := filepath.Join(dir, "existing")
+			err = os.Mkdir(existing, os.ModePerm)
+			assert.Nil(t, err)
+			err = ioutil.WriteFile(filepath.Join(existing, "existing.txt"), []byte("existing"), os.ModePerm)
+			assert.Nil(t, err)
+
+			output := filepath.Join(dir, "output")
+			for _, target := range []string{output, existing} {
+				set := flag.NewFlagSet(c.Name, flag.ContinueOnError)
+				set.String("api", filename, "")
+				set.String("dir", target, "")
+				if c.Flags != nil {
+					c.Flags(set)
+				}
+				assert.Nil(t, c.Action(cli.NewContext(nil, set, nil)))
+			}
+
+			assert.NoDirExists(t, output)
+			files, err := ioutil.ReadDir(existing)
+			assert.Nil(t, err)
+			assert.Len(t, files, 1)
+			data, err := ioutil.ReadFile(filepath.Join(existing, "existing.txt"))
+			assert.Nil(t, err)
+			assert.Equal(t, "existing", string(data))
+		})
+	}
+}
@@ -1,10 +1,11 @@
 package dartgen
 
 import (
-	"os"
+	"bytes"
 	"text/template"
 
 	"github.com/zeromicro/goctl/api/spec"
+	"github.com/zeromicro/goctl/util"
 )
 
 const apiTemplate = `import 'api.dart';
@@ -29,7 +30,7 @@ Future {{pathToFuncName .Path}}( {{if ne .Method "get"}}{{with .RequestType}}{{.
 {{end}}`
 
 func genApi(dir string, api *spec.ApiSpec) error {
-	err := os.MkdirAll(dir, 0755)
+	err := util.MkdirIfNotExist(dir)
 	if err != nil {
 		return err
 	}
@@ -39,33 +40,28 @@ func genApi(dir string, api *spec.ApiSpec) error {
 		return err
 	}
 
-	file, err := os.OpenFile(dir+api.Service.Name+".dart", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	t := template.New("apiTemplate")
+	t = t.Funcs(funcMap)
+	t, err = t.Parse(apiTemplate)
 	if err != nil {
 		return err
 	}
 
-	defer file.Close()
-	t := template.New("apiTemplate")
-	t = t.Funcs(funcMap)
-	t, err = t.Parse(apiTemplate)
+	var buffer bytes.Buffer
+	err = t.Execute(&buffer, api)
 	if err != nil {
 		return err
 	}
 
-	return t.Execute(file, api)
+	return util.WriteFile(dir+api.Service.Name+".dart", buffer.Bytes(), 0644)
 }
 
 func genApiFile(dir string) error {
 	path := dir + "api.dart"
 	if fileExists(path) {
+		util.SkipFile(path)
 		return nil
 	}
-	apiFile, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
-	if err != nil {
-		return err
-	}
 
-	defer apiFile.Close()
-	_, err = apiFile.WriteString(apiFileContent)
-	return err
+	return util.WriteFile(path, []byte(apiFileContent), 0644)
 }
@@ -1,38 +1,44 @@
 package dartgen
 
 import (
-	"os"
+	"bytes"
 	"text/template"
 
 	"github.com/zeromicro/goctl/api/spec"
+	"github.com/zeromicro/goctl/util"
 )
 
 const dataTemplate = `// --{{with .Info}}{{.Title}}{{end}}--
-{{ range .Types}}
+{{ range .Types}}{{if isEnum .}}
+{{range .Docs}}{{.}}
+{{end}}class {{.Name}} {{"{"}}{{range enumValues .}}
+	static const {{.}};{{end}}
+}
+{{else}}
 class {{.Name}}{
 	{{range .Members}}
 	/// {{.Comment}}
-	final {{.Type}} {{lowCamelCase .Name}};
+	final {{dartType .Type}} {{lowCamelCase .Name}};
 	{{end}}
 	{{.Name}}({ {{range .Members}}
 		this.{{lowCamelCase .Name}},{{end}}
 	});
 	factory {{.Name}}.fromJson(Map<String,dynamic> m) {
 		return {{.Name}}({{range .Members}}
-			{{lowCamelCase .Name}}: {{if isDirectType .Type}}m['{{tagGet .Tag "json"}}']{{else if isClassListType .Type}}(m['{{tagGet .Tag "json"}}'] as List<dynamic>).map((i) => {{getCoreType .Type}}.fromJson(i)){{else}}{{.Type}}.fromJson(m['{{tagGet .Tag "json"}}']){{end}},{{end}}
+			{{lowCamelCase .Name}}: {{if isDirectType (dartType .Type)}}m['{{tagGet .Tag "json"}}']{{else if isClassListType (dartType .Type)}}(m['{{tagGet .Tag "json"}}'] as List<dynamic>).map((i) => {{getCoreType (dartType .Type)}}.fromJson(i)){{else}}{{dartType .Type}}.fromJson(m['{{tagGet .Tag "json"}}']){{end}},{{end}}
 		);
 	}
 	Map<String,dynamic> toJson() {
 		return { {{range .Members}}
-			'{{tagGet .Tag "json"}}': {{if isDirectType .Type}}{{lowCamelCase .Name}}{{else if isClassListType .Type}}{{lowCamelCase .Name}}.map((i) => i.toJson()){{else}}{{lowCamelCase .Name}}.toJson(){{end}},{{end}}
+			'{{tagGet .Tag "json"}}': {{if isDirectType (dartType .Type)}}{{lowCamelCase .Name}}{{else if isClassListType (dartType .Type)}}{{lowCamelCase .Name}}.map((i) => i.toJson()){{else}}{{lowCamelCase .Name}}.toJson(){{end}},{{end}}
 		};
 	}
 }
-{{end}}
+{{end}}{{end}}
 `
 
 func genData(dir string, api *spec.ApiSpec) error {
-	err := os.MkdirAll(dir, 0755)
+	err := util.MkdirIfNotExist(dir)
 	if err != nil {
 		return err
 	}
@@ -42,34 +48,28 @@ func genData(dir string, api *spec.ApiSpec) error {
 		return err
 	}
 
-	file, err := os.OpenFile(dir+api.Service.Name+".dart", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	t := template.New("dataTemplate")
+	t = t.Funcs(funcMap)
+	t, err = t.Parse(dataTemplate)
 	if err != nil {
 		return err
 	}
-	defer file.Close()
 
-	t := template.New("dataTemplate")
-	t = t.Funcs(funcMap)
-	t, err = t.Parse(dataTemplate)
+	var buffer bytes.Buffer
+	err = t.Execute(&buffer, api)
 	if err != nil {
 		return err
 	}
 
-	return t.Execute(file, api)
+	return util.WriteFile(dir+api.Service.Name+".dart", buffer.Bytes(), 0644)
 }
 
 func genTokens(dir string) error {
 	path := dir + "tokens.dart"
 	if fileExists(path) {
+		util.SkipFile(path)
 		return nil
 	}
 
-	tokensFile, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
-	if err != nil {
-		return err
-	}
-
-	defer tokensFile.Close()
-	_, err = tokensFile.WriteString(tokensFileContent)
-	return err
+	return util.WriteFile(path, []byte(tokensFileContent), 0644)
 }
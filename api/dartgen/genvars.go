@@ -1,9 +1,6 @@
 package dartgen
 
-import (
-	"io/ioutil"
-	"os"
-)
+import "github.com/zeromicro/goctl/util"
 
 const varTemplate = `import 'dart:convert';
 import 'package:shared_preferences/shared_preferences.dart';
@@ -41,20 +38,24 @@ Future<Tokens> getTokens() async {
 `
 
 func genVars(dir string) error {
-	err := os.MkdirAll(dir, 0755)
+	err := util.MkdirIfNotExist(dir)
 	if err != nil {
 		return err
 	}
 
-	if !fileExists(dir + "vars.dart") {
-		err = ioutil.WriteFile(dir+"vars.dart", []byte(`const serverHost='demo-crm.xiaoheiban.cn';`), 0644)
+	if fileExists(dir + "vars.dart") {
+		util.SkipFile(dir + "vars.dart")
+	} else {
+		err = util.WriteFile(dir+"vars.dart", []byte(`const serverHost='demo-crm.xiaoheiban.cn';`), 0644)
 		if err != nil {
 			return err
 		}
 	}
 
-	if !fileExists(dir + "kv.dart") {
-		err = ioutil.WriteFile(dir+"kv.dart", []byte(varTemplate), 0644)
+	if fileExists(dir + "kv.dart") {
+		util.SkipFile(dir + "kv.dart")
+	} else {
+		err = util.WriteFile(dir+"kv.dart", []byte(varTemplate), 0644)
 		if err != nil {
 			return err
 		}
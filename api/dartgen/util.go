@@ -1,7 +1,9 @@
 package dartgen
 
 import (
+	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/zeromicro/goctl/api/spec"
@@ -82,3 +84,55 @@ func fileExists(path string) bool {
 	_, err := os.Stat(path)
 	return !os.IsNotExist(err)
 }
+
+func dartType(tp spec.Type) string {
+	switch v := tp.(type) {
+	case spec.PrimitiveType:
+		return dartPrimitiveType(v.RawName)
+	case spec.EnumType:
+		// the enums are declared as constants, so the fields are declared as the types of values
+		return dartPrimitiveType(v.Base)
+	case spec.PointerType:
+		return dartType(v.Type)
+	case spec.ArrayType:
+		return "List<" + dartType(v.Value) + ">"
+	case spec.MapType:
+		return "Map<String," + dartType(v.Value) + ">"
+	case spec.InterfaceType:
+		return "dynamic"
+	default:
+		return tp.Name()
+	}
+}
+
+func dartPrimitiveType(tp string) string {
+	switch tp {
+	case "string":
+		return "String"
+	case "bool":
+		return "bool"
+	case "float32", "float64":
+		return "double"
+	default:
+		return "int"
+	}
+}
+
+func isEnum(tp spec.Type) bool {
+	_, ok := tp.(spec.EnumType)
+	return ok
+}
+
+func enumValues(tp spec.EnumType) []string {
+	var values []string
+	for _, item := range tp.Values {
+		literal := item.Value
+		if tp.IsString() {
+			literal = strconv.Quote(literal)
+		}
+
+		values = append(values, fmt.Sprintf("%s %s = %s", dartType(tp), lowCamelCase(item.Name), literal))
+	}
+
+	return values
+}
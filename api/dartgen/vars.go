@@ -9,6 +9,9 @@ var funcMap = template.FuncMap{
 	"getCoreType":     getCoreType,
 	"pathToFuncName":  pathToFuncName,
 	"lowCamelCase":    lowCamelCase,
+	"dartType":        dartType,
+	"isEnum":          isEnum,
+	"enumValues":      enumValues,
 }
 
 const (
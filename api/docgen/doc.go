@@ -34,12 +34,6 @@ const (
 )
 
 func genDoc(api *spec.ApiSpec, dir string, filename string) error {
-	fp, _, err := util.MaybeCreateFile(dir, "", filename)
-	if err != nil {
-		return err
-	}
-	defer fp.Close()
-
 	var builder strings.Builder
 	for index, route := range api.Service.Routes() {
 		routeComment := route.JoinedDoc()
@@ -75,8 +69,7 @@ func genDoc(api *spec.ApiSpec, dir string, filename string) error {
 
 		builder.Write(tmplBytes.Bytes())
 	}
-	_, err = fp.WriteString(strings.Replace(builder.String(), "&#34;", `"`, -1))
-	return err
+	return util.MaybeWriteFile(dir, "", filename, []byte(strings.Replace(builder.String(), "&#34;", `"`, -1)))
 }
 
 func buildDoc(route spec.Type) (string, error) {
@@ -94,7 +87,43 @@ func buildDoc(route spec.Type) (string, error) {
 		return "", err
 	}
 
-	return fmt.Sprintf("\n\n```golang\n%s\n```\n", value), nil
+	rules, err := buildRules(tps)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("\n\n```golang\n%s\n```\n%s", value, rules), nil
+}
+
+func buildRules(tps []spec.Type) (string, error) {
+	var builder strings.Builder
+	for _, tp := range tps {
+		definedType, ok := tp.(spec.DefineStruct)
+		if !ok {
+			continue
+		}
+
+		for _, member := range definedType.Members {
+			rules, err := member.Rules()
+			if err != nil {
+				return "", err
+			}
+			if len(rules) == 0 {
+				continue
+			}
+
+			var list []string
+			for _, rule := range rules {
+				list = append(list, "`"+strings.Replace(rule.String(), "|", "\\|", -1)+"`")
+			}
+			fmt.Fprintf(&builder, "|%s|%s|%s|\n", definedType.Name(), member.Name, strings.Join(list, " "))
+		}
+	}
+	if builder.Len() == 0 {
+		return "", nil
+	}
+
+	return "\n校验规则\n\n|类型|字段|规则|\n|---|---|---|\n" + builder.String(), nil
 }
 
 func associatedTypes(tp spec.DefineStruct, tps *[]spec.Type) {
@@ -113,5 +142,18 @@ func associatedTypes(tp spec.DefineStruct, tps *[]spec.Type) {
 		if definedType, ok := item.Type.(spec.DefineStruct); ok {
 			associatedTypes(definedType, tps)
 		}
+		if enumType, ok := item.Type.(spec.EnumType); ok {
+			associatedEnum(enumType, tps)
+		}
+	}
+}
+
+func associatedEnum(tp spec.EnumType, tps *[]spec.Type) {
+	for _, item := range *tps {
+		if item.Name() == tp.Name() {
+			return
+		}
 	}
+
+	*tps = append(*tps, tp)
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/tal-tech/go-zero/core/errorx"
 	"github.com/urfave/cli"
 	"github.com/zeromicro/goctl/api/parser"
+	"github.com/zeromicro/goctl/api/parser/g4/ast"
 	"github.com/zeromicro/goctl/api/util"
 	ctlutil "github.com/zeromicro/goctl/util"
 )
@@ -91,17 +92,27 @@ func ApiFormatByPath(apiFilePath string) error {
 		return err
 	}
 
-	result, err := apiFormat(string(data), filepath.Dir(apiFilePath))
+	result, err := ApiFormatByContent(string(data), filepath.Dir(apiFilePath))
 	if err != nil {
 		return err
 	}
 
-	_, err = parser.ParseContent(result, filepath.Dir(apiFilePath))
+	return ctlutil.WriteFile(apiFilePath, []byte(result), os.ModePerm)
+}
+
+// ApiFormatByContent formats the content of an api file, workDir is the directory to find the imported api files
+func ApiFormatByContent(content, workDir string) (string, error) {
+	result, err := apiFormat(content, workDir)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	return ioutil.WriteFile(apiFilePath, []byte(result), os.ModePerm)
+	_, err = parser.ParseContent(result, workDir)
+	if err != nil {
+		return "", err
+	}
+
+	return result, nil
 }
 
 func apiFormat(data, workDir string) (string, error) {
@@ -110,8 +121,26 @@ func apiFormat(data, workDir string) (string, error) {
 		return "", err
 	}
 
+	enums, err := ast.ParseEnums(data)
+	if err != nil {
+		return "", err
+	}
+
+	enumM := make(map[int]*ast.TypeEnum)
+	for _, each := range enums {
+		enumM[each.Enum.Line()] = each
+	}
+
 	var builder strings.Builder
 	s := bufio.NewScanner(strings.NewReader(data))
+	var lineNumber = 0
+	s.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+		advance, token, err := bufio.ScanLines(data, atEOF)
+		if token != nil {
+			lineNumber++
+		}
+		return advance, token, err
+	})
 	var tapCount = 0
 	var newLineCount = 0
 	var preLine string
@@ -129,6 +158,21 @@ func apiFormat(data, workDir string) (string, error) {
 			newLineCount = 0
 		}
 
+		if enum, ok := enumM[lineNumber]; ok && tapCount == 0 {
+			err := formatEnumDef(enum, &builder)
+			if err != nil {
+				return "", err
+			}
+
+			for lineNumber < enum.RBrace.Line() {
+				if !s.Scan() {
+					break
+				}
+			}
+			preLine = rightBrace
+			continue
+		}
+
 		if tapCount == 0 {
 			format, err := formatGoTypeDef(line, s, &builder)
 			if err != nil {
@@ -193,6 +237,36 @@ func formatGoTypeDef(line string, scanner *bufio.Scanner, builder *strings.Build
 	return false, nil
 }
 
+// formatEnumDef aligns the values of enum by formatting them as a go const block
+func formatEnumDef(enum *ast.TypeEnum, builder *strings.Builder) error {
+	var constBuilder strings.Builder
+	constBuilder.WriteString("package enum\nconst (\n")
+	for _, each := range enum.Values {
+		for _, doc := range each.Doc() {
+			constBuilder.WriteString(doc.Text() + ctlutil.NL)
+		}
+
+		constBuilder.WriteString(fmt.Sprintf("%s = %s", each.Name.Text(), each.Value.Text()))
+		if each.Comment() != nil {
+			constBuilder.WriteString(" " + each.Comment().Text())
+		}
+		constBuilder.WriteString(ctlutil.NL)
+	}
+	constBuilder.WriteString(")\n")
+
+	cs, err := format.Source([]byte(constBuilder.String()))
+	if err != nil {
+		return errors.New("error format \n" + constBuilder.String())
+	}
+
+	result := strings.TrimPrefix(string(cs), "package enum\n\nconst (")
+	result = strings.TrimRight(strings.TrimSuffix(strings.TrimRight(result, " \t\n"), rightParenthesis), " \t\n")
+	builder.WriteString(fmt.Sprintf("enum %s {", enum.Name.Text()))
+	builder.WriteString(result + ctlutil.NL)
+	builder.WriteString(rightBrace + ctlutil.NL)
+	return nil
+}
+
 func mayInsertStructKeyword(line string, token *int) string {
 	insertStruct := func() string {
 		if strings.Contains(line, " struct") {
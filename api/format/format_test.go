@@ -23,6 +23,35 @@ handler: GreetHandler
 }
 `
 
+	notFormattedEnumStr = `
+// Status is the user status
+enum Status {
+  // normal user
+  Active = 1 // active
+    Disabled = 2; Deleted = -1 }
+enum Gender { Male = "male"; Female = "female" }
+type User {
+  Status Status ` + "`" + `json:"status"` + "`" + `
+}
+`
+
+	formattedEnumStr = `// Status is the user status
+enum Status {
+	// normal user
+	Active   = 1 // active
+	Disabled = 2
+	Deleted  = -1
+}
+
+enum Gender {
+	Male   = "male"
+	Female = "female"
+}
+
+type User {
+	Status Status ` + "`" + `json:"status"` + "`" + `
+}`
+
 	formattedStr = `type Request {
 	Name string ` + "`" + `path:"name,options=you|me"` + "`" + `
 }
@@ -45,3 +74,16 @@ func TestFormat(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, r, formattedStr)
 }
+
+func TestFormatEnum(t *testing.T) {
+	pwd, err := os.Getwd()
+	assert.Nil(t, err)
+
+	r, err := apiFormat(notFormattedEnumStr, pwd)
+	assert.Nil(t, err)
+	assert.Equal(t, formattedEnumStr, r)
+
+	r, err = apiFormat(formattedEnumStr, pwd)
+	assert.Nil(t, err)
+	assert.Equal(t, formattedEnumStr, r)
+}
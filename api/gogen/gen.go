@@ -32,6 +32,7 @@ func GoCommand(c *cli.Context) error {
 	dir := c.String("dir")
 	namingStyle := c.String("style")
 	onlyType := c.Bool("types")
+	merge := c.Bool("merge")
 
 	if len(apiFile) == 0 {
 		return errors.New("missing -api")
@@ -44,6 +45,10 @@ func GoCommand(c *cli.Context) error {
 		return DoGenTypes(apiFile, dir, namingStyle)
 	}
 
+	if merge {
+		return DoMergeProject(apiFile, dir, namingStyle)
+	}
+
 	return DoGenProject(apiFile, dir, namingStyle)
 }
 
@@ -85,6 +90,17 @@ func DoGenTypes(apiFile, dir, style string) error {
 
 // DoGenProject gen go project files with api file
 func DoGenProject(apiParam, dir, style string) error {
+	return doGenProject(apiParam, dir, style, false, nil)
+}
+
+// DoMergeProject gen go project files with api file like DoGenProject, the codes are merged into the
+// existing go files, such as the new fields of the config and the new imports of the handlers,
+// the user codes in the function bodies are kept
+func DoMergeProject(apiParam, dir, style string) error {
+	return doGenProject(apiParam, dir, style, true, nil)
+}
+
+func doGenProject(apiParam, dir, style string, merge bool, gateway *Gateway) error {
 	apiPath, importMap, err := util.ParseApiParam(apiParam)
 	if err != nil {
 		return err
@@ -99,18 +115,28 @@ func DoGenProject(apiParam, dir, style string) error {
 	if err != nil {
 		return err
 	}
+	cfg.Merge = merge
 
 	logx.Must(util.MkdirIfNotExist(dir))
+	if gateway != nil {
+		logx.Must(genGateway(dir, cfg, api, gateway))
+	}
 	logx.Must(genEtc(dir, cfg, api))
 	logx.Must(genConfig(dir, cfg, api))
 	logx.Must(genMain(dir, cfg, api))
 	logx.Must(genServiceContext(dir, cfg, api))
 	logx.Must(genTypes(dir, importMap, cfg, api))
+	logx.Must(genValidate(dir, cfg, api))
 	logx.Must(genRoutes(dir, cfg, api))
 	logx.Must(genHandlers(dir, cfg, api))
 	logx.Must(genLogic(dir, cfg, api))
 	logx.Must(genMiddleware(dir, cfg, api))
 
+	// the api file is neither backed up nor formatted in the dry run mode
+	if util.IsDryRun() {
+		return nil
+	}
+
 	if err := backupAndSweep(apiPath); err != nil {
 		return err
 	}
@@ -12,6 +12,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/tal-tech/go-zero/core/logx"
 	"github.com/zeromicro/goctl/api/parser"
+	"github.com/zeromicro/goctl/api/spec"
+	"github.com/zeromicro/goctl/config"
 	"github.com/zeromicro/goctl/rpc/execx"
 	"github.com/zeromicro/goctl/util"
 )
@@ -321,6 +323,57 @@ service A-api {
 }
 `
 
+const validateRuleApi = `
+type Item {
+  Name string ` + "`" + `json:"name" validate:"required,max=20"` + "`" + `
+}
+
+type Request {
+  Name  string ` + "`" + `path:"name" validate:"required,min=2,regex=^[a-z,]+$"` + "`" + `
+  Email string ` + "`" + `json:"email,optional" validate:"email"` + "`" + `
+  Kind  string ` + "`" + `json:"kind,optional"` + "`" + `
+  Phone string ` + "`" + `json:"phone,optional" validate:"required_if=Kind|admin"` + "`" + `
+  Age   *int   ` + "`" + `json:"age,optional" validate:"min=1,max=150"` + "`" + `
+  Items []*Item ` + "`" + `json:"items,optional" validate:"max=5,dive"` + "`" + `
+}
+
+service A-api {
+  @handler GreetHandler
+  post /greet/from/:name(Request)
+}
+`
+
+const invalidValidateRuleApi = `
+type Request {
+  Name string ` + "`" + `json:"name" validate:"dive"` + "`" + `
+}
+
+service A-api {
+  @handler GreetHandler
+  post /greet(Request)
+}
+`
+
+const enumApi = `
+// Status is the user status
+enum Status {
+  Active = 1 // active
+  Disabled = 2
+}
+
+enum Gender { Male = "male"; Female = "female" }
+
+type Request {
+  Status Status ` + "`" + `json:"status,optional"` + "`" + `
+  Gender *Gender ` + "`" + `json:"gender" validate:"required_if=Status|Disabled"` + "`" + `
+}
+
+service A-api {
+  @handler GreetHandler
+  post /greet(Request)
+}
+`
+
 func TestParser(t *testing.T) {
 	filename := "greet.api"
 	err := ioutil.WriteFile(filename, []byte(testApiTemplate), os.ModePerm)
@@ -535,6 +588,90 @@ func TestCamelStyle(t *testing.T) {
 	validateWithCamel(t, filename, "GoZero")
 }
 
+func TestValidateRuleApi(t *testing.T) {
+	filename := "greet.api"
+	err := ioutil.WriteFile(filename, []byte(validateRuleApi), os.ModePerm)
+	assert.Nil(t, err)
+	defer os.Remove(filename)
+
+	api, err := parser.Parse(filename)
+	assert.Nil(t, err)
+
+	rules, err := api.Types[1].(spec.DefineStruct).Members[0].Rules()
+	assert.Nil(t, err)
+	assert.Equal(t, []spec.Rule{
+		{Name: spec.RuleRequired},
+		{Name: spec.RuleMin, Value: "2"},
+		{Name: spec.RuleRegex, Value: "^[a-z,]+$"},
+	}, rules)
+
+	validate(t, filename)
+}
+
+func TestInvalidValidateRuleApi(t *testing.T) {
+	filename := "greet.api"
+	err := ioutil.WriteFile(filename, []byte(invalidValidateRuleApi), os.ModePerm)
+	assert.Nil(t, err)
+	defer os.Remove(filename)
+
+	api, err := parser.Parse(filename)
+	assert.Nil(t, err)
+
+	dir, err := ioutil.TempDir("", "goctl-validate")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	assert.NotNil(t, genValidate(dir, &config.Config{}, api))
+}
+
+func TestEnumApi(t *testing.T) {
+	filename := "greet.api"
+	err := ioutil.WriteFile(filename, []byte(enumApi), os.ModePerm)
+	assert.Nil(t, err)
+	defer os.Remove(filename)
+
+	api, err := parser.Parse(filename)
+	assert.Nil(t, err)
+
+	status, ok := api.Types[0].(spec.EnumType)
+	assert.True(t, ok)
+	assert.Equal(t, "int64", status.Base)
+	assert.Equal(t, []spec.EnumValue{
+		{Name: "Active", Value: "1", Comment: "// active"},
+		{Name: "Disabled", Value: "2"},
+	}, status.Values)
+
+	types, err := BuildTypes(api.Types)
+	assert.Nil(t, err)
+	assert.Contains(t, types, "type Status int64")
+	assert.Contains(t, types, "StatusActive Status = 1")
+	assert.Contains(t, types, `GenderMale Gender = "male"`)
+
+	validate(t, filename)
+}
+
+func TestEnumValidate(t *testing.T) {
+	filename := "greet.api"
+	err := ioutil.WriteFile(filename, []byte(enumApi), os.ModePerm)
+	assert.Nil(t, err)
+	defer os.Remove(filename)
+
+	api, err := parser.Parse(filename)
+	assert.Nil(t, err)
+
+	dir, err := ioutil.TempDir("", "goctl-validate")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	assert.Nil(t, genValidate(dir, &config.Config{NamingFormat: "gozero"}, api))
+	code, err := ioutil.ReadFile(filepath.Join(dir, typesDir, "validate.go"))
+	assert.Nil(t, err)
+	assert.Nil(t, validateCode(string(code)))
+	assert.Contains(t, string(code), "case StatusActive, StatusDisabled, 0:")
+	assert.Contains(t, string(code), `return errors.New("status must be one of 1, 2")`)
+	assert.Contains(t, string(code), "switch *v.Gender {\n\t\tcase GenderMale, GenderFemale:")
+}
+
 func validate(t *testing.T, api string) {
 	validateWithCamel(t, api, "gozero")
 }
@@ -46,6 +46,7 @@ func genConfig(dir string, cfg *config.Config, api *spec.ApiSpec) error {
 		dir:             dir,
 		subdir:          configDir,
 		filename:        filename + ".go",
+		merge:           cfg.Merge,
 		templateName:    "configTemplate",
 		category:        category,
 		templateFile:    configTemplateFile,
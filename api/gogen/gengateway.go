@@ -0,0 +1,326 @@
+package gogen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/zeromicro/goctl/api/spec"
+	"github.com/zeromicro/goctl/config"
+	ctlutil "github.com/zeromicro/goctl/util"
+	"github.com/zeromicro/goctl/util/format"
+	"github.com/zeromicro/goctl/vars"
+)
+
+const (
+	converterFilename = "converter"
+
+	gatewayConfigTemplate = `package config
+
+import (
+	"{{.url}}/rest"
+	"{{.url}}/zrpc"
+)
+
+type Config struct {
+	rest.RestConf
+	{{.fields}}
+}
+`
+
+	gatewayContextTemplate = `package svc
+
+import (
+	{{.imports}}
+)
+
+type ServiceContext struct {
+	Config config.Config
+	{{.clients}}
+}
+
+func NewServiceContext(c config.Config) *ServiceContext {
+	return &ServiceContext{
+		Config: c,
+		{{.assignments}}
+	}
+}
+`
+
+	gatewayEtcTemplate = `Name: {{.serviceName}}
+Host: {{.host}}
+Port: {{.port}}
+{{.clients}}`
+
+	gatewayEtcClientTemplate = `%s:
+  Etcd:
+    Hosts:
+      - 127.0.0.1:2379
+    Key: %s
+`
+
+	gatewayLogicTemplate = `package logic
+
+import (
+	{{.imports}}
+)
+
+type {{.logic}} struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func New{{.logic}}(ctx context.Context, svcCtx *svc.ServiceContext) {{.logic}} {
+	return {{.logic}}{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *{{.logic}}) {{.function}}({{.request}}) {{.responseType}} {
+	{{.body}}
+}
+`
+
+	gatewayConverterTemplate = `package logic
+
+import (
+	{{.imports}}
+)
+
+{{.body}}
+`
+)
+
+type (
+	// Gateway describes the rpc clients which the logic of the routes forwards the requests to,
+	// the config, service context and logic are generated with the clients
+	Gateway struct {
+		Clients []GatewayClient
+		// Logic maps the handlers of the routes to the code of their logic
+		Logic map[string]GatewayCode
+		// Converter is the code shared by the logic, such as the conversions between the types and the rpc messages
+		Converter GatewayCode
+	}
+
+	// GatewayClient describes a rpc client generated by goctl rpc
+	GatewayClient struct {
+		// Name is the field name of the client in the config and the service context
+		Name string
+		// Package is the import path of the client package
+		Package string
+		// Service is the name of the client interface, which is created by New{{Service}}
+		Service string
+		// Key is the etcd key of the rpc service
+		Key string
+	}
+
+	// GatewayCode describes a piece of code and the packages it imports besides the types
+	GatewayCode struct {
+		Imports []string
+		Body    string
+	}
+)
+
+// DoGenGatewayProject generates the go project of api like DoGenProject, the logic forwards the requests
+// to the rpc clients of gateway, the existing files are not overwritten
+func DoGenGatewayProject(apiParam, dir, style string, gateway *Gateway) error {
+	return doGenProject(apiParam, dir, style, false, gateway)
+}
+
+func genGateway(dir string, cfg *config.Config, api *spec.ApiSpec, gateway *Gateway) error {
+	parentPkg, err := getParentPackage(dir)
+	if err != nil {
+		return err
+	}
+
+	if err = genGatewayEtc(dir, cfg, api, gateway); err != nil {
+		return err
+	}
+
+	if err = genGatewayConfig(dir, cfg, api, gateway); err != nil {
+		return err
+	}
+
+	if err = genGatewayContext(dir, cfg, parentPkg, gateway); err != nil {
+		return err
+	}
+
+	if len(gateway.Converter.Body) > 0 {
+		if err = genGatewayConverter(dir, cfg, parentPkg, gateway); err != nil {
+			return err
+		}
+	}
+
+	for _, g := range api.Service.Groups {
+		for _, r := range g.Routes {
+			code, ok := gateway.Logic[r.Handler]
+			if !ok {
+				continue
+			}
+
+			err = genGatewayLogic(dir, cfg, parentPkg, g, r, code)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func genGatewayEtc(dir string, cfg *config.Config, api *spec.ApiSpec, gateway *Gateway) error {
+	filename, err := format.FileNamingFormat(cfg.NamingFormat, api.Service.Name)
+	if err != nil {
+		return err
+	}
+
+	var clients strings.Builder
+	for _, item := range gateway.Clients {
+		fmt.Fprintf(&clients, gatewayEtcClientTemplate, item.Name, item.Key)
+	}
+
+	return genFile(fileGenConfig{
+		dir:             dir,
+		subdir:          etcDir,
+		filename:        fmt.Sprintf("%s.yaml", filename),
+		templateName:    "gatewayEtcTemplate",
+		builtinTemplate: gatewayEtcTemplate,
+		data: map[string]string{
+			"serviceName": api.Service.Name,
+			"host":        "0.0.0.0",
+			"port":        strconv.Itoa(defaultPort),
+			"clients":     clients.String(),
+		},
+	})
+}
+
+func genGatewayConfig(dir string, cfg *config.Config, api *spec.ApiSpec, gateway *Gateway) error {
+	filename, err := format.FileNamingFormat(cfg.NamingFormat, configFile)
+	if err != nil {
+		return err
+	}
+
+	var fields []string
+	for _, item := range getAuths(api) {
+		fields = append(fields, fmt.Sprintf("%s %s", item, jwtTemplate))
+	}
+	for _, item := range gateway.Clients {
+		fields = append(fields, fmt.Sprintf("%s zrpc.RpcClientConf", item.Name))
+	}
+
+	return genFile(fileGenConfig{
+		dir:             dir,
+		subdir:          configDir,
+		filename:        filename + ".go",
+		merge:           cfg.Merge,
+		templateName:    "gatewayConfigTemplate",
+		builtinTemplate: gatewayConfigTemplate,
+		data: map[string]string{
+			"url":    vars.ProjectOpenSourceURL,
+			"fields": strings.Join(fields, "\n"),
+		},
+	})
+}
+
+func genGatewayContext(dir string, cfg *config.Config, parentPkg string, gateway *Gateway) error {
+	filename, err := format.FileNamingFormat(cfg.NamingFormat, contextFilename)
+	if err != nil {
+		return err
+	}
+
+	imports := []string{fmt.Sprintf("\"%s\"", ctlutil.JoinPackages(parentPkg, configDir))}
+	var clients, assignments []string
+	for _, item := range gateway.Clients {
+		imports = append(imports, fmt.Sprintf("\"%s\"", item.Package))
+		pkg := item.Package[strings.LastIndex(item.Package, "/")+1:]
+		clients = append(clients, fmt.Sprintf("%s %s.%s", item.Name, pkg, item.Service))
+		assignments = append(assignments, fmt.Sprintf("%s: %s.New%s(zrpc.MustNewClient(c.%s)),",
+			item.Name, pkg, item.Service, item.Name))
+	}
+	imports = append(imports, fmt.Sprintf("\n\"%s/zrpc\"", vars.ProjectOpenSourceURL))
+
+	return genFile(fileGenConfig{
+		dir:             dir,
+		subdir:          contextDir,
+		filename:        filename + ".go",
+		merge:           cfg.Merge,
+		templateName:    "gatewayContextTemplate",
+		builtinTemplate: gatewayContextTemplate,
+		data: map[string]string{
+			"imports":     strings.Join(imports, "\n\t"),
+			"clients":     strings.Join(clients, "\n"),
+			"assignments": strings.Join(assignments, "\n"),
+		},
+	})
+}
+
+func genGatewayConverter(dir string, cfg *config.Config, parentPkg string, gateway *Gateway) error {
+	filename, err := format.FileNamingFormat(cfg.NamingFormat, converterFilename)
+	if err != nil {
+		return err
+	}
+
+	imports := []string{fmt.Sprintf("\"%s\"", ctlutil.JoinPackages(parentPkg, typesDir))}
+	for _, item := range gateway.Converter.Imports {
+		imports = append(imports, fmt.Sprintf("\"%s\"", item))
+	}
+
+	return genFile(fileGenConfig{
+		dir:             dir,
+		subdir:          logicDir,
+		filename:        filename + ".go",
+		merge:           cfg.Merge,
+		templateName:    "gatewayConverterTemplate",
+		builtinTemplate: gatewayConverterTemplate,
+		data: map[string]string{
+			"imports": strings.Join(imports, "\n\t"),
+			"body":    gateway.Converter.Body,
+		},
+	})
+}
+
+func genGatewayLogic(dir string, cfg *config.Config, parentPkg string, group spec.Group, route spec.Route,
+	code GatewayCode) error {
+	logic := getLogicName(route)
+	goFile, err := format.FileNamingFormat(cfg.NamingFormat, logic)
+	if err != nil {
+		return err
+	}
+
+	imports := genLogicImports(route, parentPkg)
+	for _, item := range code.Imports {
+		imports += fmt.Sprintf("\n\t\"%s\"", item)
+	}
+
+	var responseString string
+	if len(route.ResponseTypeName()) > 0 {
+		responseString = "(" + responseGoTypeName(route, typesPacket) + ", error)"
+	} else {
+		responseString = "error"
+	}
+
+	var requestString string
+	if len(route.RequestTypeName()) > 0 {
+		requestString = "req " + requestGoTypeName(route, typesPacket)
+	}
+
+	return genFile(fileGenConfig{
+		dir:             dir,
+		subdir:          getLogicFolderPath(group, route),
+		filename:        goFile + ".go",
+		merge:           cfg.Merge,
+		templateName:    "gatewayLogicTemplate",
+		builtinTemplate: gatewayLogicTemplate,
+		data: map[string]string{
+			"imports":      imports,
+			"logic":        strings.Title(logic),
+			"function":     strings.Title(strings.TrimSuffix(logic, "Logic")),
+			"responseType": responseString,
+			"request":      requestString,
+			"body":         code.Body,
+		},
+	})
+}
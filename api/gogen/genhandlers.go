@@ -26,6 +26,11 @@ func {{.HandlerName}}(ctx *svc.ServiceContext) http.HandlerFunc {
 		if err := httpx.Parse(r, &req); err != nil {
 			httpx.Error(w, err)
 			return
+		}
+
+		if err := req.Validate(); err != nil {
+			httpx.Error(w, err)
+			return
 		}{{end}}
 
 		l := logic.New{{.LogicType}}(r.Context(), ctx)
@@ -81,6 +86,7 @@ func doGenToFile(dir, handler string, cfg *config.Config, group spec.Group,
 		dir:             dir,
 		subdir:          getHandlerFolderPath(group, route),
 		filename:        filename + ".go",
+		merge:           cfg.Merge,
 		templateName:    "handlerTemplate",
 		category:        category,
 		templateFile:    handlerTemplateFile,
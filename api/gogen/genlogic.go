@@ -87,6 +87,7 @@ func genLogicByRoute(dir string, cfg *config.Config, group spec.Group, route spe
 		dir:             dir,
 		subdir:          getLogicFolderPath(group, route),
 		filename:        goFile + ".go",
+		merge:           cfg.Merge,
 		templateName:    "logicTemplate",
 		category:        category,
 		templateFile:    logicTemplateFile,
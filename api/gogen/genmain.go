@@ -58,6 +58,7 @@ func genMain(dir string, cfg *config.Config, api *spec.ApiSpec) error {
 		dir:             dir,
 		subdir:          "",
 		filename:        filename + ".go",
+		merge:           cfg.Merge,
 		templateName:    "mainTemplate",
 		category:        category,
 		templateFile:    mainTemplateFile,
@@ -44,6 +44,7 @@ func genMiddleware(dir string, cfg *config.Config, api *spec.ApiSpec) error {
 			dir:             dir,
 			subdir:          middlewareDir,
 			filename:        filename + ".go",
+			merge:           cfg.Merge,
 			templateName:    "contextTemplate",
 			builtinTemplate: middlewareImplementCode,
 			data: map[string]string{
@@ -2,8 +2,6 @@ package gogen
 
 import (
 	"fmt"
-	"os"
-	"path"
 	"sort"
 	"strings"
 	"text/template"
@@ -127,13 +125,11 @@ func genRoutes(dir string, cfg *config.Config, api *spec.ApiSpec) error {
 	}
 	routeFilename = routeFilename + ".go"
 
-	filename := path.Join(dir, handlerDir, routeFilename)
-	os.Remove(filename)
-
 	return genFile(fileGenConfig{
 		dir:             dir,
 		subdir:          handlerDir,
 		filename:        routeFilename,
+		overwrite:       true,
 		templateName:    "routesTemplate",
 		category:        "",
 		templateFile:    "",
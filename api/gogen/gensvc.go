@@ -71,6 +71,7 @@ func genServiceContext(dir string, cfg *config.Config, api *spec.ApiSpec) error
 		dir:             dir,
 		subdir:          contextDir,
 		filename:        filename + ".go",
+		merge:           cfg.Merge,
 		templateName:    "contextTemplate",
 		category:        category,
 		templateFile:    contextTemplateFile,
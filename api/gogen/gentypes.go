@@ -3,9 +3,8 @@ package gogen
 import (
 	"fmt"
 	"io"
-	"os"
-	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/zeromicro/goctl/api/spec"
@@ -84,9 +83,6 @@ func genTypes(dir string, importMap map[string]string, cfg *config.Config, api *
 	}
 
 	typeFilename = typeFilename + ".go"
-	filename := path.Join(dir, typesDir, typeFilename)
-	os.Remove(filename)
-
 	var imports []string
 	for _, item := range api.Imports {
 		if len(item.AsPackage) > 0 {
@@ -103,6 +99,7 @@ func genTypes(dir string, importMap map[string]string, cfg *config.Config, api *
 		dir:             dir,
 		subdir:          typesDir,
 		filename:        typeFilename,
+		overwrite:       true,
 		templateName:    "typesTemplate",
 		category:        "",
 		templateFile:    "",
@@ -116,6 +113,10 @@ func genTypes(dir string, importMap map[string]string, cfg *config.Config, api *
 }
 
 func writeType(writer io.Writer, tp spec.Type) error {
+	if enumType, ok := tp.(spec.EnumType); ok {
+		return writeEnum(writer, enumType)
+	}
+
 	structType, ok := tp.(spec.DefineStruct)
 	if !ok {
 		return fmt.Errorf("unspport struct type: %s", tp.Name())
@@ -138,3 +139,28 @@ func writeType(writer io.Writer, tp spec.Type) error {
 	fmt.Fprintf(writer, "}")
 	return nil
 }
+
+func writeEnum(writer io.Writer, tp spec.EnumType) error {
+	name := util.Title(tp.Name())
+	for _, doc := range tp.Docs {
+		fmt.Fprintf(writer, "%s\n", doc)
+	}
+	fmt.Fprintf(writer, "type %s %s\n\nconst (\n", name, tp.Base)
+	for _, value := range tp.Values {
+		for _, doc := range value.Docs {
+			fmt.Fprintf(writer, "\t%s\n", doc)
+		}
+
+		literal := value.Value
+		if tp.IsString() {
+			literal = strconv.Quote(literal)
+		}
+		fmt.Fprintf(writer, "\t%s%s %s = %s", name, util.Title(value.Name), name, literal)
+		if len(value.Comment) > 0 {
+			fmt.Fprintf(writer, " %s", value.Comment)
+		}
+		fmt.Fprint(writer, "\n")
+	}
+	_, err := fmt.Fprint(writer, ")")
+	return err
+}
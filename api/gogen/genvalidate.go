@@ -0,0 +1,564 @@
+package gogen
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/zeromicro/goctl/api/spec"
+	"github.com/zeromicro/goctl/config"
+	"github.com/zeromicro/goctl/util"
+	"github.com/zeromicro/goctl/util/format"
+)
+
+const (
+	validateFile     = "validate"
+	validateTemplate = `// Code generated by goctl. DO NOT EDIT.
+package types
+
+import (
+	{{.imports}}
+)
+{{if .vars}}
+var (
+	{{.vars}}
+)
+{{end}}
+{{.validators}}
+`
+	emailPattern = `^[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}$`
+)
+
+// validator builds the Validate methods of the types
+type validator struct {
+	types   map[string]spec.DefineStruct
+	imports map[string]struct{}
+	vars    []string
+	hasVar  map[string]struct{}
+}
+
+func genValidate(dir string, cfg *config.Config, api *spec.ApiSpec) error {
+	v := &validator{
+		types:   make(map[string]spec.DefineStruct),
+		imports: make(map[string]struct{}),
+		hasVar:  make(map[string]struct{}),
+	}
+	for _, tp := range api.Types {
+		if item, ok := tp.(spec.DefineStruct); ok {
+			v.types[item.Name()] = item
+		}
+	}
+
+	targets, err := v.targets(api)
+	if err != nil {
+		return err
+	}
+
+	var validators []string
+	for _, tp := range api.Types {
+		if _, ok := targets[tp.Name()]; !ok {
+			continue
+		}
+
+		code, err := v.genType(tp.(spec.DefineStruct), targets)
+		if err != nil {
+			return err
+		}
+
+		validators = append(validators, code)
+	}
+
+	var imports []string
+	for item := range v.imports {
+		imports = append(imports, strconv.Quote(item))
+	}
+	sort.Strings(imports)
+
+	filename, err := format.FileNamingFormat(cfg.NamingFormat, validateFile)
+	if err != nil {
+		return err
+	}
+
+	return genFile(fileGenConfig{
+		dir:             dir,
+		subdir:          typesDir,
+		filename:        filename + ".go",
+		overwrite:       true,
+		templateName:    "validateTemplate",
+		category:        "",
+		templateFile:    "",
+		builtinTemplate: validateTemplate,
+		data: map[string]interface{}{
+			"imports":    strings.Join(imports, "\n\t"),
+			"vars":       strings.Join(v.vars, "\n\t"),
+			"validators": strings.Join(validators, "\n\n"),
+		},
+	})
+}
+
+// targets returns the types which need the Validate method, they're the request types,
+// the types with rules or enum fields, and the types embedded into or dived by them
+func (v *validator) targets(api *spec.ApiSpec) (map[string]struct{}, error) {
+	var queue []string
+	for _, route := range api.Service.Routes() {
+		if tp, ok := route.RequestType.(spec.DefineStruct); ok && len(tp.Package) == 0 {
+			queue = append(queue, tp.Name())
+		}
+	}
+	for _, tp := range api.Types {
+		item, ok := tp.(spec.DefineStruct)
+		if !ok {
+			continue
+		}
+
+		for _, member := range item.Members {
+			rules, err := member.Rules()
+			if err != nil {
+				return nil, err
+			}
+
+			if _, ok := enumOf(member.Type); ok || len(rules) > 0 {
+				queue = append(queue, item.Name())
+				break
+			}
+		}
+	}
+
+	targets := make(map[string]struct{})
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		if _, ok := targets[name]; ok {
+			continue
+		}
+
+		tp, ok := v.types[name]
+		if !ok {
+			continue
+		}
+
+		targets[name] = struct{}{}
+		for _, member := range tp.Members {
+			if member.IsInline {
+				queue = append(queue, member.Type.Name())
+				continue
+			}
+
+			rules, err := member.Rules()
+			if err != nil {
+				return nil, err
+			}
+
+			for _, rule := range rules {
+				if rule.Name != spec.RuleDive {
+					continue
+				}
+
+				target, err := v.diveTarget(member.Type)
+				if err != nil {
+					return nil, fmt.Errorf("type %s field %s: %v", name, member.Name, err)
+				}
+
+				queue = append(queue, target)
+			}
+		}
+	}
+
+	return targets, nil
+}
+
+// diveTarget returns the name of the struct which is validated by the dive rule,
+// the struct can be wrapped by a pointer, a slice or a map
+func (v *validator) diveTarget(tp spec.Type) (string, error) {
+	switch item := tp.(type) {
+	case spec.ArrayType:
+		tp = item.Value
+	case spec.MapType:
+		tp = item.Value
+	}
+	if item, ok := tp.(spec.PointerType); ok {
+		tp = item.Type
+	}
+
+	item, ok := tp.(spec.DefineStruct)
+	if !ok || len(item.Package) > 0 {
+		return "", fmt.Errorf("rule %s expects a struct defined in api, but got %s", spec.RuleDive, tp.Name())
+	}
+	if _, ok := v.types[item.Name()]; !ok {
+		return "", fmt.Errorf("type %s not defined", item.Name())
+	}
+
+	return item.Name(), nil
+}
+
+func (v *validator) genType(tp spec.DefineStruct, targets map[string]struct{}) (string, error) {
+	var builder strings.Builder
+	name := util.Title(tp.Name())
+	fmt.Fprintf(&builder, "// Validate validates the fields of %s\n", name)
+	fmt.Fprintf(&builder, "func (v *%s) Validate() error {\n", name)
+	for _, member := range tp.Members {
+		if member.IsInline {
+			if _, ok := targets[member.Type.Name()]; ok {
+				fmt.Fprintf(&builder, "if err := v.%s.Validate(); err != nil {\nreturn err\n}\n\n",
+					strings.Title(member.Type.Name()))
+			}
+			continue
+		}
+
+		code, err := v.genMember(tp, member)
+		if err != nil {
+			return "", fmt.Errorf("type %s field %s: %v", tp.Name(), member.Name, err)
+		}
+
+		builder.WriteString(code)
+	}
+	builder.WriteString("return nil\n}")
+
+	return builder.String(), nil
+}
+
+func (v *validator) genMember(tp spec.DefineStruct, member spec.Member) (string, error) {
+	rules, err := member.Rules()
+	if err != nil {
+		return "", err
+	}
+
+	enum, isEnum := enumOf(member.Type)
+	if !isEnum && len(rules) == 0 {
+		return "", nil
+	}
+
+	field := fieldName(member)
+
+	expr := "v." + strings.Title(member.Name)
+	valueType := member.Type
+	pointer, isPointer := member.Type.(spec.PointerType)
+	if isPointer {
+		valueType = pointer.Type
+	}
+
+	var checks, valueChecks []string
+	if isEnum {
+		// httpx accepts any value of the base type, so the values out of the enum are rejected here,
+		// the zero value of an optional field means the field is absent
+		valueChecks = append(valueChecks, v.enumCheck(field, valueExpr(expr, isPointer), enum,
+			!isPointer && member.IsOptional()))
+	}
+	for _, rule := range rules {
+		switch rule.Name {
+		case spec.RuleRequired:
+			cond, err := zeroCondition(expr, member.Type)
+			if err != nil {
+				return "", err
+			}
+
+			checks = append(checks, v.check(cond, fmt.Sprintf("%s is required", field)))
+		case spec.RuleRequiredIf:
+			cond, err := zeroCondition(expr, member.Type)
+			if err != nil {
+				return "", err
+			}
+
+			other, value, err := v.requiredIf(tp, rule)
+			if err != nil {
+				return "", err
+			}
+
+			_, raw := rule.RequiredIf()
+			checks = append(checks, v.check(fmt.Sprintf("v.%s == %s && %s", strings.Title(other.Name), value, cond),
+				fmt.Sprintf("%s is required if %s is %s", field, fieldName(other), raw)))
+		case spec.RuleMin, spec.RuleMax:
+			code, err := v.boundCheck(rule, field, valueExpr(expr, isPointer), valueType)
+			if err != nil {
+				return "", err
+			}
+
+			valueChecks = append(valueChecks, code)
+		case spec.RuleEmail:
+			if !isString(valueType) {
+				return "", fmt.Errorf("rule %s expects a string", rule.Name)
+			}
+
+			v.addVar("emailRegex", fmt.Sprintf("emailRegex = regexp.MustCompile(%s)", strconv.Quote(emailPattern)))
+			value := valueExpr(expr, isPointer)
+			valueChecks = append(valueChecks, v.check(fmt.Sprintf("len(%s) > 0 && !emailRegex.MatchString(%s)",
+				value, value), fmt.Sprintf("%s must be an email address", field)))
+		case spec.RuleRegex:
+			if !isString(valueType) {
+				return "", fmt.Errorf("rule %s expects a string", rule.Name)
+			}
+			if _, err := regexp.Compile(rule.Value); err != nil {
+				return "", err
+			}
+
+			name := util.Untitle(tp.Name()) + util.Title(member.Name) + "Regex"
+			v.addVar(name, fmt.Sprintf("%s = regexp.MustCompile(%s)", name, strconv.Quote(rule.Value)))
+			value := valueExpr(expr, isPointer)
+			valueChecks = append(valueChecks, v.check(fmt.Sprintf("len(%s) > 0 && !%s.MatchString(%s)",
+				value, name, value), fmt.Sprintf("%s must match %s", field, rule.Value)))
+		case spec.RuleDive:
+			code, err := v.diveCheck(field, expr, member.Type)
+			if err != nil {
+				return "", err
+			}
+
+			checks = append(checks, code)
+		}
+	}
+
+	code := strings.Join(checks, "")
+	if len(valueChecks) > 0 {
+		if isPointer {
+			code += fmt.Sprintf("if %s != nil {\n%s}\n\n", expr,
+				strings.TrimSuffix(strings.Join(valueChecks, ""), "\n"))
+		} else {
+			code += strings.Join(valueChecks, "")
+		}
+	}
+
+	return code, nil
+}
+
+// requiredIf returns the member which the required_if rule depends on and the literal of the value
+func (v *validator) requiredIf(tp spec.DefineStruct, rule spec.Rule) (spec.Member, string, error) {
+	name, value := rule.RequiredIf()
+	for _, member := range tp.Members {
+		if member.IsInline || member.Name != name {
+			continue
+		}
+
+		if enum, ok := member.Type.(spec.EnumType); ok {
+			for _, item := range enum.Values {
+				if item.Name == value {
+					return member, util.Title(enum.Name()) + util.Title(item.Name), nil
+				}
+			}
+
+			return spec.Member{}, "", fmt.Errorf("rule %s: %s is not a value of enum %s", rule.Name, value, enum.Name())
+		}
+
+		primitive, ok := member.Type.(spec.PrimitiveType)
+		if !ok {
+			return spec.Member{}, "", fmt.Errorf("rule %s expects a field of basic type, but %s is %s",
+				rule.Name, name, member.Type.Name())
+		}
+
+		switch {
+		case isString(primitive):
+			return member, strconv.Quote(value), nil
+		case primitive.RawName == "bool":
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return spec.Member{}, "", err
+			}
+
+			return member, strconv.FormatBool(b), nil
+		default:
+			if err := checkNumber(primitive, value); err != nil {
+				return spec.Member{}, "", err
+			}
+		}
+
+		return member, value, nil
+	}
+
+	return spec.Member{}, "", fmt.Errorf("rule %s: field %s not found", rule.Name, name)
+}
+
+func (v *validator) boundCheck(rule spec.Rule, field, expr string, tp spec.Type) (string, error) {
+	op, word := "<", "at least"
+	if rule.Name == spec.RuleMax {
+		op, word = ">", "at most"
+	}
+
+	switch item := tp.(type) {
+	case spec.PrimitiveType:
+		if isString(item) {
+			if err := checkLength(rule.Value); err != nil {
+				return "", err
+			}
+
+			v.imports["unicode/utf8"] = struct{}{}
+			return v.check(fmt.Sprintf("utf8.RuneCountInString(%s) %s %s", expr, op, rule.Value),
+				fmt.Sprintf("%s length must be %s %s", field, word, rule.Value)), nil
+		}
+
+		if err := checkNumber(item, rule.Value); err != nil {
+			return "", err
+		}
+
+		return v.check(fmt.Sprintf("%s %s %s", expr, op, rule.Value),
+			fmt.Sprintf("%s must be %s %s", field, word, rule.Value)), nil
+	case spec.ArrayType, spec.MapType:
+		if err := checkLength(rule.Value); err != nil {
+			return "", err
+		}
+
+		return v.check(fmt.Sprintf("len(%s) %s %s", expr, op, rule.Value),
+			fmt.Sprintf("%s size must be %s %s", field, word, rule.Value)), nil
+	default:
+		return "", fmt.Errorf("rule %s expects a string, number, slice or map, but got %s", rule.Name, tp.Name())
+	}
+}
+
+func (v *validator) enumCheck(field, expr string, tp spec.EnumType, allowZero bool) string {
+	zero, zeroLiteral := "0", "0"
+	if tp.IsString() {
+		zero, zeroLiteral = "", `""`
+	}
+
+	name := util.Title(tp.Name())
+	var cases, values []string
+	for _, item := range tp.Values {
+		cases = append(cases, name+util.Title(item.Name))
+		values = append(values, item.Value)
+		if item.Value == zero {
+			allowZero = false
+		}
+	}
+	if allowZero {
+		cases = append(cases, zeroLiteral)
+	}
+
+	v.imports["errors"] = struct{}{}
+	return fmt.Sprintf("switch %s {\ncase %s:\ndefault:\nreturn errors.New(%s)\n}\n\n", expr,
+		strings.Join(cases, ", "), strconv.Quote(fmt.Sprintf("%s must be one of %s", field,
+			strings.Join(values, ", "))))
+}
+
+func (v *validator) diveCheck(field, expr string, tp spec.Type) (string, error) {
+	v.imports["fmt"] = struct{}{}
+	validate := func(item string, nullable bool, errExpr string) string {
+		code := fmt.Sprintf("if err := %s.Validate(); err != nil {\nreturn %s\n}\n", item, errExpr)
+		if nullable {
+			code = fmt.Sprintf("if %s != nil {\n%s}\n", item, code)
+		}
+		return code
+	}
+
+	switch item := tp.(type) {
+	case spec.DefineStruct:
+		return validate(expr, false, fmt.Sprintf(`fmt.Errorf("%s: %%w", err)`, field)) + "\n", nil
+	case spec.PointerType:
+		return validate(expr, true, fmt.Sprintf(`fmt.Errorf("%s: %%w", err)`, field)) + "\n", nil
+	case spec.ArrayType:
+		_, nullable := item.Value.(spec.PointerType)
+		return fmt.Sprintf("for i, item := range %s {\n%s}\n\n", expr,
+			validate("item", nullable, fmt.Sprintf(`fmt.Errorf("%s[%%d]: %%w", i, err)`, field))), nil
+	case spec.MapType:
+		_, nullable := item.Value.(spec.PointerType)
+		return fmt.Sprintf("for key, item := range %s {\n%s}\n\n", expr,
+			validate("item", nullable, fmt.Sprintf(`fmt.Errorf("%s[%%v]: %%w", key, err)`, field))), nil
+	default:
+		return "", fmt.Errorf("rule %s expects a struct, but got %s", spec.RuleDive, tp.Name())
+	}
+}
+
+func (v *validator) addVar(name, code string) {
+	if _, ok := v.hasVar[name]; ok {
+		return
+	}
+
+	v.hasVar[name] = struct{}{}
+	v.imports["regexp"] = struct{}{}
+	v.vars = append(v.vars, code)
+}
+
+// fieldName returns the name of the member in the request, such as the json, form, path or header key
+func fieldName(member spec.Member) string {
+	if name, err := member.GetPropertyName(); err == nil {
+		return name
+	}
+
+	for _, tag := range member.Tags() {
+		if tag.Key != spec.ValidateTagKey && len(tag.Name) > 0 {
+			return tag.Name
+		}
+	}
+
+	return member.Name
+}
+
+func (v *validator) check(cond, msg string) string {
+	v.imports["errors"] = struct{}{}
+	return fmt.Sprintf("if %s {\nreturn errors.New(%s)\n}\n\n", cond, strconv.Quote(msg))
+}
+
+func valueExpr(expr string, pointer bool) string {
+	if pointer {
+		return "*" + expr
+	}
+
+	return expr
+}
+
+func zeroCondition(expr string, tp spec.Type) (string, error) {
+	switch item := tp.(type) {
+	case spec.PrimitiveType:
+		if isString(item) {
+			return fmt.Sprintf("len(%s) == 0", expr), nil
+		}
+		if item.RawName == "bool" {
+			return "", fmt.Errorf("bool field can't be required, use a pointer instead")
+		}
+
+		return fmt.Sprintf("%s == 0", expr), nil
+	case spec.EnumType:
+		if item.IsString() {
+			return fmt.Sprintf("len(%s) == 0", expr), nil
+		}
+
+		return fmt.Sprintf("%s == 0", expr), nil
+	case spec.ArrayType, spec.MapType:
+		return fmt.Sprintf("len(%s) == 0", expr), nil
+	case spec.PointerType, spec.InterfaceType:
+		return fmt.Sprintf("%s == nil", expr), nil
+	default:
+		return "", fmt.Errorf("%s field can't be required", tp.Name())
+	}
+}
+
+// enumOf returns the enum type of the field, the field can be a pointer to the enum
+func enumOf(tp spec.Type) (spec.EnumType, bool) {
+	if item, ok := tp.(spec.PointerType); ok {
+		tp = item.Type
+	}
+
+	enum, ok := tp.(spec.EnumType)
+	return enum, ok
+}
+
+func isString(tp spec.Type) bool {
+	item, ok := tp.(spec.PrimitiveType)
+	return ok && item.RawName == "string"
+}
+
+func checkLength(value string) error {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return fmt.Errorf("invalid length %q", value)
+	}
+
+	return nil
+}
+
+func checkNumber(tp spec.PrimitiveType, value string) error {
+	var err error
+	switch tp.RawName {
+	case "int", "int8", "int16", "int32", "int64", "rune":
+		_, err = strconv.ParseInt(value, 10, 64)
+	case "uint", "uint8", "uint16", "uint32", "uint64", "uintptr", "byte":
+		_, err = strconv.ParseUint(value, 10, 64)
+	case "float32", "float64":
+		_, err = strconv.ParseFloat(value, 64)
+	default:
+		return fmt.Errorf("%s is not comparable", tp.RawName)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid %s value %q", tp.RawName, value)
+	}
+
+	return nil
+}
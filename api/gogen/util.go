@@ -5,6 +5,7 @@ import (
 	"fmt"
 	goformat "go/format"
 	"io"
+	"path"
 	"path/filepath"
 	"strings"
 	"text/template"
@@ -25,9 +26,27 @@ type fileGenConfig struct {
 	templateFile    string
 	builtinTemplate string
 	data            interface{}
+	// merge is true if the codes are merged into the existing go file
+	merge bool
+	// overwrite is true if the existing file is regenerated
+	overwrite bool
 }
 
 func genFile(c fileGenConfig) error {
+	fpath := path.Join(c.dir, c.subdir, c.filename)
+	if c.merge && filepath.Ext(c.filename) == ".go" && ctlutil.FileExists(fpath) {
+		code, err := executeTemplate(c)
+		if err != nil {
+			return err
+		}
+
+		return ctlutil.MergeGoFile(fpath, []byte(code))
+	}
+
+	if c.overwrite || ctlutil.IsDryRun() {
+		return writeFile(c, fpath)
+	}
+
 	fp, created, err := util.MaybeCreateFile(c.dir, c.subdir, c.filename)
 	if err != nil {
 		return err
@@ -37,13 +56,44 @@ func genFile(c fileGenConfig) error {
 	}
 	defer fp.Close()
 
+	code, err := executeTemplate(c)
+	if err != nil {
+		return err
+	}
+
+	_, err = fp.WriteString(code)
+	return err
+}
+
+// writeFile writes the codes to fpath, the existing file is skipped unless overwrite is true
+func writeFile(c fileGenConfig, fpath string) error {
+	if ctlutil.FileExists(fpath) && !c.overwrite {
+		ctlutil.SkipFile(fpath)
+		return nil
+	}
+
+	err := ctlutil.MkdirIfNotExist(path.Join(c.dir, c.subdir))
+	if err != nil {
+		return err
+	}
+
+	code, err := executeTemplate(c)
+	if err != nil {
+		return err
+	}
+
+	return ctlutil.WriteFile(fpath, []byte(code), 0666)
+}
+
+func executeTemplate(c fileGenConfig) (string, error) {
 	var text string
+	var err error
 	if len(c.category) == 0 || len(c.templateFile) == 0 {
 		text = c.builtinTemplate
 	} else {
 		text, err = ctlutil.LoadTemplate(c.category, c.templateFile, c.builtinTemplate)
 		if err != nil {
-			return err
+			return "", err
 		}
 	}
 
@@ -51,12 +101,10 @@ func genFile(c fileGenConfig) error {
 	buffer := new(bytes.Buffer)
 	err = t.Execute(buffer, c.data)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	code := formatCode(buffer.String())
-	_, err = fp.WriteString(code)
-	return err
+	return formatCode(buffer.String()), nil
 }
 
 func getParentPackage(dir string) (string, error) {
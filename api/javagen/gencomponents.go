@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"path"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -60,6 +61,23 @@ public class {{.className}} extends {{.superClassName}} {
 {{.indent}}public void set{{.property}}({{.type}} {{.propertyValue}}) {
 {{.indent}}	this.{{.tagValue}} = {{.propertyValue}};
 {{.indent}}}
+`
+	enumTemplate = `// Code generated by goctl. DO NOT EDIT.
+package com.xhb.logic.http.packet.{{.packet}}.model;
+
+{{.doc}}public enum {{.className}} {
+{{.values}}
+
+	private final {{.type}} value;
+
+	{{.className}}({{.type}} value) {
+		this.value = value;
+	}
+
+	public {{.type}} getValue() {
+		return value;
+	}
+}
 `
 	httpResponseData = "import com.xhb.core.response.HttpResponseData;"
 	httpData         = "import com.xhb.core.packet.HttpData;"
@@ -104,6 +122,10 @@ func genComponents(dir, packetName string, api *spec.ApiSpec, importMap map[stri
 }
 
 func (c *componentsContext) createComponent(dir, packetName string, ty spec.Type) error {
+	if enumType, ok := ty.(spec.EnumType); ok {
+		return c.createEnum(dir, packetName, enumType)
+	}
+
 	defineStruct, ok := ty.(spec.DefineStruct)
 	if !ok {
 		return errors.New("unsupported type %s" + ty.Name())
@@ -152,15 +174,6 @@ func (c *componentsContext) createComponent(dir, packetName string, ty spec.Type
 		return err
 	}
 
-	fp, created, err := apiutil.MaybeCreateFile(dir, modelDir, modelFile)
-	if err != nil {
-		return err
-	}
-	if !created {
-		return nil
-	}
-	defer fp.Close()
-
 	buffer := new(bytes.Buffer)
 	t := template.Must(template.New("componentType").Parse(componentTemplate))
 	err = t.Execute(buffer, map[string]interface{}{
@@ -178,8 +191,62 @@ func (c *componentsContext) createComponent(dir, packetName string, ty spec.Type
 		return err
 	}
 
-	_, err = fp.WriteString(formatSource(buffer.String()))
-	return err
+	return apiutil.MaybeWriteFile(dir, modelDir, modelFile, []byte(formatSource(buffer.String())))
+}
+
+func (c *componentsContext) createEnum(dir, packetName string, tp spec.EnumType) error {
+	modelFile := util.Title(tp.Name()) + ".java"
+	filename := path.Join(dir, modelDir, modelFile)
+	if err := util.RemoveOrQuit(filename); err != nil {
+		return err
+	}
+
+	var values []string
+	for index, item := range tp.Values {
+		var builder strings.Builder
+		for _, doc := range item.Docs {
+			writeIndent(&builder, 1)
+			fmt.Fprintln(&builder, doc)
+		}
+
+		literal := item.Value
+		if tp.IsString() {
+			literal = strconv.Quote(literal)
+		} else {
+			literal += "L"
+		}
+		writeIndent(&builder, 1)
+		fmt.Fprintf(&builder, "%s(%s)", strings.ToUpper(apiutil.ToSnakeCase(item.Name)), literal)
+		if index == len(tp.Values)-1 {
+			builder.WriteString(";")
+		} else {
+			builder.WriteString(",")
+		}
+		if len(item.Comment) > 0 {
+			fmt.Fprintf(&builder, " %s", item.Comment)
+		}
+		values = append(values, builder.String())
+	}
+
+	var doc string
+	if len(tp.Docs) > 0 {
+		doc = strings.Join(tp.Docs, util.NL) + util.NL
+	}
+
+	buffer := new(bytes.Buffer)
+	t := template.Must(template.New("enumType").Parse(enumTemplate))
+	err := t.Execute(buffer, map[string]interface{}{
+		"packet":    packetName,
+		"doc":       doc,
+		"className": util.Title(tp.Name()),
+		"values":    strings.Join(values, util.NL),
+		"type":      enumValueType(tp),
+	})
+	if err != nil {
+		return err
+	}
+
+	return apiutil.MaybeWriteFile(dir, modelDir, modelFile, buffer.Bytes())
 }
 
 func (c *componentsContext) buildProperties(defineStruct spec.DefineStruct) (string, error) {
@@ -58,15 +58,6 @@ func createWith(dir string, api *spec.ApiSpec, route spec.Route, packetName stri
 	}
 
 	javaFile := packet + ".java"
-	fp, created, err := apiutil.MaybeCreateFile(dir, "", javaFile)
-	if err != nil {
-		return err
-	}
-	if !created {
-		return nil
-	}
-	defer fp.Close()
-
 	var hasRequestBody = false
 	if route.RequestType != nil {
 		if defineStruct, ok := route.RequestType.(spec.DefineStruct); ok {
@@ -88,7 +79,7 @@ func createWith(dir string, api *spec.ApiSpec, route spec.Route, packetName stri
 
 	t := template.Must(template.New("packetTemplate").Parse(packetTemplate))
 	var tmplBytes bytes.Buffer
-	err = t.Execute(&tmplBytes, map[string]interface{}{
+	err := t.Execute(&tmplBytes, map[string]interface{}{
 		"packetName":        packet,
 		"method":            strings.ToUpper(route.Method),
 		"uri":               processUri(route),
@@ -106,8 +97,7 @@ func createWith(dir string, api *spec.ApiSpec, route spec.Route, packetName stri
 		return err
 	}
 
-	_, err = fp.WriteString(formatSource(tmplBytes.String()))
-	return err
+	return apiutil.MaybeWriteFile(dir, "", javaFile, []byte(formatSource(tmplBytes.String())))
 }
 
 func doc(route spec.Route) string {
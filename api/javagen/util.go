@@ -73,6 +73,8 @@ func specTypeToJava(tp spec.Type) (string, error) {
 	switch v := tp.(type) {
 	case spec.DefineStruct:
 		return util.Title(v.TypeName), nil
+	case spec.EnumType:
+		return util.Title(v.Name()), nil
 	case spec.PrimitiveType:
 		r, ok := primitiveType(tp.Name())
 		if !ok {
@@ -137,3 +139,11 @@ func primitiveType(tp string) (string, bool) {
 
 	return "", false
 }
+
+func enumValueType(tp spec.EnumType) string {
+	if tp.IsString() {
+		return "String"
+	}
+
+	return "long"
+}
@@ -3,10 +3,12 @@ package ktgen
 import (
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"text/template"
 
 	"github.com/iancoleman/strcase"
+	"github.com/zeromicro/goctl/api/spec"
 	"github.com/zeromicro/goctl/api/util"
 )
 
@@ -14,6 +16,8 @@ var funcsMap = template.FuncMap{
 	"lowCamelCase":    lowCamelCase,
 	"routeToFuncName": routeToFuncName,
 	"parseType":       parseType,
+	"isEnum":          isEnum,
+	"enumValues":      enumValues,
 	"add":             add,
 	"upperCase":       upperCase,
 }
@@ -38,10 +42,26 @@ func routeToFuncName(method, path string) string {
 	return strings.ToLower(method) + strcase.ToCamel(path)
 }
 
-func parseType(t string) string {
+func parseType(tp spec.Type) string {
+	switch v := tp.(type) {
+	case spec.EnumType:
+		// the enums are declared as constants, so the fields are declared as the types of values
+		return parseTypeName(v.Base)
+	case spec.PointerType:
+		return parseType(v.Type)
+	case spec.ArrayType:
+		return "List<" + parseType(v.Value) + ">"
+	case spec.MapType:
+		return "Map<String," + parseType(v.Value) + ">"
+	default:
+		return parseTypeName(tp.Name())
+	}
+}
+
+func parseTypeName(t string) string {
 	t = strings.Replace(t, "*", "", -1)
 	if strings.HasPrefix(t, "[]") {
-		return "List<" + parseType(t[2:]) + ">"
+		return "List<" + parseTypeName(t[2:]) + ">"
 	}
 
 	if strings.HasPrefix(t, "map") {
@@ -52,7 +72,7 @@ func parseType(t string) string {
 		if len(tys) != 2 {
 			log.Fatal("Map type number !=2")
 		}
-		return "Map<String," + parseType(tys[1]) + ">"
+		return "Map<String," + parseTypeName(tys[1]) + ">"
 	}
 
 	switch t {
@@ -69,6 +89,25 @@ func parseType(t string) string {
 	}
 }
 
+func isEnum(tp spec.Type) bool {
+	_, ok := tp.(spec.EnumType)
+	return ok
+}
+
+func enumValues(tp spec.EnumType) []string {
+	var values []string
+	for _, item := range tp.Values {
+		literal := item.Value
+		if tp.IsString() {
+			literal = strconv.Quote(literal)
+		}
+
+		values = append(values, fmt.Sprintf("%s = %s", strings.ToUpper(util.ToSnakeCase(item.Name)), literal))
+	}
+
+	return values
+}
+
 func decomposeType(t string) (result []string, err error) {
 	add := func(tp string) error {
 		ret, err := decomposeType(tp)
@@ -1,6 +1,7 @@
 package ktgen
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/iancoleman/strcase"
 	"github.com/zeromicro/goctl/api/spec"
+	"github.com/zeromicro/goctl/util"
 )
 
 const (
@@ -78,10 +80,13 @@ suspend fun apiRequest(
 import com.google.gson.Gson
 
 object {{with .Info}}{{.Title}}{{end}}{
-	{{range .Types}}
+	{{range .Types}}{{if isEnum .}}
+	object {{.Name}} {{"{"}}{{range enumValues .}}
+		const val {{.}}{{end}}
+	}{{else}}
 	data class {{.Name}}({{$length := (len .Members)}}{{range $i,$item := .Members}}
 		val {{with $item}}{{lowCamelCase .Name}}: {{parseType .Type}}{{end}}{{if ne $i (add $length -1)}},{{end}}{{end}}
-	){{end}}
+	){{end}}{{end}}
 	{{with .Service}}
 	{{range .Routes}}suspend fun {{routeToFuncName .Method .Path}}({{with .RequestType}}{{if ne .Name ""}}
 		req:{{.Name}},{{end}}{{end}}
@@ -98,31 +103,31 @@ object {{with .Info}}{{.Title}}{{end}}{
 )
 
 func genBase(dir, pkg string, api *spec.ApiSpec) error {
-	e := os.MkdirAll(dir, 0755)
+	e := util.MkdirIfNotExist(dir)
 	if e != nil {
 		return e
 	}
 	path := filepath.Join(dir, "BaseApi.kt")
 	if _, e := os.Stat(path); e == nil {
-		fmt.Println("BaseApi.kt already exists, skipped it.")
+		if util.IsDryRun() {
+			util.SkipFile(path)
+		} else {
+			fmt.Println("BaseApi.kt already exists, skipped it.")
+		}
 		return nil
 	}
 
-	file, e := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
-	if e != nil {
-		return e
-	}
-	defer file.Close()
-
 	t, e := template.New("n").Parse(apiBaseTemplate)
 	if e != nil {
 		return e
 	}
-	e = t.Execute(file, pkg)
+
+	var buffer bytes.Buffer
+	e = t.Execute(&buffer, pkg)
 	if e != nil {
 		return e
 	}
-	return nil
+	return util.WriteFile(path, buffer.Bytes(), 0644)
 }
 
 func genApi(dir, pkg string, api *spec.ApiSpec) error {
@@ -146,20 +151,20 @@ func genApi(dir, pkg string, api *spec.ApiSpec) error {
 	api.Info.Title = name
 	api.Info.Desc = desc
 
-	e := os.MkdirAll(dir, 0755)
+	e := util.MkdirIfNotExist(dir)
 	if e != nil {
 		return e
 	}
 
-	file, e := os.OpenFile(path, os.O_WRONLY|os.O_TRUNC|os.O_CREATE, 0644)
+	t, e := template.New("api").Funcs(funcsMap).Parse(apiTemplate)
 	if e != nil {
 		return e
 	}
-	defer file.Close()
 
-	t, e := template.New("api").Funcs(funcsMap).Parse(apiTemplate)
+	var buffer bytes.Buffer
+	e = t.Execute(&buffer, api)
 	if e != nil {
 		return e
 	}
-	return t.Execute(file, api)
+	return util.WriteFile(path, buffer.Bytes(), 0644)
 }
@@ -12,6 +12,7 @@ spec:           syntaxLit
                 |infoSpec
                 |typeSpec
                 |serviceSpec
+                |enumSpec
                 ;
 
 // syntax
@@ -58,6 +59,11 @@ packageExpr:    packageName=ID dot='.';
 mapType:        {match(p,"map")}mapToken=ID lbrack='[' {checkKey(p)}key=ID rbrack=']' value=dataType;
 arrayType:      lbrack='[' rbrack=']' dataType;
 
+// enum
+// eg: enum Status { Active = 1; Disabled = 2 }
+enumSpec:       {match(p,"enum")}enumToken=ID {checkKeyword(p)}enumName=ID lbrace='{' enumValue+ rbrace='}';
+enumValue:      {checkKeyword(p)}name=ID assign='=' value=(STRING|INT) ';'?;
+
 // service
 serviceSpec:    atServer? serviceApi;
 atServer:       ATSERVER lp='(' kvLit+ rp=')';
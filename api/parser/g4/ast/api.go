@@ -202,6 +202,10 @@ func (v *ApiVisitor) VisitSpec(ctx *api.SpecContext) interface{} {
 		root.Service = []*Service{ctx.ServiceSpec().Accept(v).(*Service)}
 	}
 
+	if ctx.EnumSpec() != nil {
+		root.Type = []TypeExpr{ctx.EnumSpec().Accept(v).(*TypeEnum)}
+	}
+
 	return &root
 }
 
@@ -412,11 +412,16 @@ func (p *Parser) checkServices(apiItem *Api, types map[string]TypeExpr, linePref
 					continue
 				}
 
-				_, ok := types[structName]
+				tp, ok := types[structName]
 				if !ok {
 					return fmt.Errorf("%s line %d:%d can not found declaration '%s' in context",
 						linePrefix, route.Reply.Name.Expr().Line(), route.Reply.Name.Expr().Column(), structName)
 				}
+
+				if _, ok := tp.(*TypeEnum); ok {
+					return fmt.Errorf("%s line %d:%d response type '%s' can not be an enum",
+						linePrefix, route.Reply.Name.Expr().Line(), route.Reply.Name.Expr().Column(), structName)
+				}
 			}
 		}
 	}
@@ -425,11 +430,16 @@ func (p *Parser) checkServices(apiItem *Api, types map[string]TypeExpr, linePref
 
 func (p *Parser) checkRequestBody(route *Route, types map[string]TypeExpr, linePrefix string) error {
 	if route.Req != nil && route.Req.Name.IsNotNil() && route.Req.Name.Expr().IsNotNil() {
-		_, ok := types[route.Req.Name.Expr().Text()]
+		tp, ok := types[route.Req.Name.Expr().Text()]
 		if !ok {
 			return fmt.Errorf("%s line %d:%d can not found declaration '%s' in context",
 				linePrefix, route.Req.Name.Expr().Line(), route.Req.Name.Expr().Column(), route.Req.Name.Expr().Text())
 		}
+
+		if _, ok := tp.(*TypeEnum); ok {
+			return fmt.Errorf("%s line %d:%d request type '%s' can not be an enum",
+				linePrefix, route.Req.Name.Expr().Line(), route.Req.Name.Expr().Column(), route.Req.Name.Expr().Text())
+		}
 	}
 	return nil
 }
@@ -0,0 +1,210 @@
+package ast
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/zeromicro/goctl/api/parser/g4/gen/api"
+)
+
+type (
+	// TypeEnum describes enum ast for api syntax, such as
+	// enum Status {
+	//     Active = 1
+	//     Disabled = 2
+	// }
+	TypeEnum struct {
+		Enum    Expr
+		Name    Expr
+		LBrace  Expr
+		RBrace  Expr
+		DocExpr []Expr
+		Values  []*EnumValue
+	}
+
+	// EnumValue describes a value of the enum, the value is an integer or a string
+	EnumValue struct {
+		Name        Expr
+		Assign      Expr
+		Value       Expr
+		DocExpr     []Expr
+		CommentExpr Expr
+	}
+)
+
+// NameExpr returns the expression string of TypeEnum
+func (e *TypeEnum) NameExpr() Expr {
+	return e.Name
+}
+
+// Doc returns the document of TypeEnum, like // some text
+func (e *TypeEnum) Doc() []Expr {
+	return e.DocExpr
+}
+
+// Format provides a formatter for api command, now nothing to do
+func (e *TypeEnum) Format() error {
+	return nil
+}
+
+// Equal compares whether the element literals in two TypeEnum are equal
+func (e *TypeEnum) Equal(v interface{}) bool {
+	if v == nil {
+		return false
+	}
+
+	enum, ok := v.(*TypeEnum)
+	if !ok {
+		return false
+	}
+
+	if !e.Name.Equal(enum.Name) || len(e.Values) != len(enum.Values) {
+		return false
+	}
+
+	for index, each := range e.Values {
+		if !each.Equal(enum.Values[index]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsString returns true if the values of the enum are strings
+func (e *TypeEnum) IsString() bool {
+	return len(e.Values) > 0 && strings.HasPrefix(e.Values[0].Value.Text(), `"`)
+}
+
+// Parsed returns the value without quotes for a string, or the decimal literal for an integer,
+// so that 1 and 01 are the same value
+func (e *EnumValue) Parsed() string {
+	text := e.Value.Text()
+	if strings.HasPrefix(text, `"`) {
+		if value, err := strconv.Unquote(text); err == nil {
+			return value
+		}
+
+		return text
+	}
+
+	if value, err := strconv.ParseInt(text, 10, 64); err == nil {
+		return strconv.FormatInt(value, 10)
+	}
+
+	return text
+}
+
+// Doc returns the document of EnumValue, like // some text
+func (e *EnumValue) Doc() []Expr {
+	return e.DocExpr
+}
+
+// Comment returns the comment of EnumValue, like // some text
+func (e *EnumValue) Comment() Expr {
+	return e.CommentExpr
+}
+
+// Format provides a formatter for api command, now nothing to do
+func (e *EnumValue) Format() error {
+	return nil
+}
+
+// Equal compares whether the element literals in two EnumValue are equal
+func (e *EnumValue) Equal(v interface{}) bool {
+	if v == nil {
+		return false
+	}
+
+	value, ok := v.(*EnumValue)
+	if !ok {
+		return false
+	}
+
+	if !e.Name.Equal(value.Name) || !e.Value.Equal(value.Value) {
+		return false
+	}
+
+	return EqualDoc(e, value)
+}
+
+// ParseEnums returns the enum declarations in content, the imported api files are not resolved
+func ParseEnums(content string) ([]*TypeEnum, error) {
+	v, err := NewParser().Accept(func(p *api.ApiParserParser, visitor *ApiVisitor) interface{} {
+		return p.Api().Accept(visitor)
+	}, content)
+	if err != nil {
+		return nil, err
+	}
+
+	var enums []*TypeEnum
+	for _, each := range v.(*Api).Type {
+		if enum, ok := each.(*TypeEnum); ok {
+			enums = append(enums, enum)
+		}
+	}
+
+	return enums, nil
+}
+
+// VisitEnumSpec implements from api.BaseApiParserVisitor
+func (v *ApiVisitor) VisitEnumSpec(ctx *api.EnumSpecContext) interface{} {
+	var enum TypeEnum
+	enum.Enum = v.newExprWithToken(ctx.GetEnumToken())
+	enum.Name = v.newExprWithToken(ctx.GetEnumName())
+	if api.IsBasicType(enum.Name.Text()) {
+		v.panic(enum.Name, fmt.Sprintf("expecting enum name, found '%s'", enum.Name.Text()))
+	}
+
+	enum.DocExpr = v.getDoc(ctx)
+	enum.LBrace = v.newExprWithToken(ctx.GetLbrace())
+	enum.RBrace = v.newExprWithToken(ctx.GetRbrace())
+	for _, each := range ctx.AllEnumValue() {
+		enum.Values = append(enum.Values, each.Accept(v).(*EnumValue))
+	}
+
+	v.checkEnum(&enum)
+	return &enum
+}
+
+// VisitEnumValue implements from api.BaseApiParserVisitor
+func (v *ApiVisitor) VisitEnumValue(ctx *api.EnumValueContext) interface{} {
+	var value EnumValue
+	value.Name = v.newExprWithToken(ctx.GetName())
+	value.Assign = v.newExprWithToken(ctx.GetAssign())
+	value.Value = v.newExprWithToken(ctx.GetValue())
+	text := value.Value.Text()
+	if ctx.STRING() != nil {
+		if _, err := strconv.Unquote(text); err != nil {
+			v.panic(value.Value, fmt.Sprintf("invalid string %s", text))
+		}
+	} else if _, err := strconv.ParseInt(text, 10, 64); err != nil {
+		v.panic(value.Value, fmt.Sprintf("integer %s out of range", text))
+	}
+
+	value.DocExpr = v.getDoc(ctx)
+	value.CommentExpr = v.getComment(ctx)
+	return &value
+}
+
+// checkEnum checks that the values of enum are all integers or all strings, and no name or value is duplicate
+func (v *ApiVisitor) checkEnum(enum *TypeEnum) {
+	isString := enum.IsString()
+	names := make(map[string]PlaceHolder)
+	values := make(map[string]PlaceHolder)
+	for _, each := range enum.Values {
+		if strings.HasPrefix(each.Value.Text(), `"`) != isString {
+			v.panic(each.Value, fmt.Sprintf("mixed integer and string values in enum %s", enum.Name.Text()))
+		}
+		if _, ok := names[each.Name.Text()]; ok {
+			v.panic(each.Name, fmt.Sprintf("duplicate enum value name '%s'", each.Name.Text()))
+		}
+		if _, ok := values[each.Parsed()]; ok {
+			v.panic(each.Value, fmt.Sprintf("duplicate enum value %s", each.Value.Text()))
+		}
+
+		names[each.Name.Text()] = Holder
+		values[each.Parsed()] = Holder
+	}
+}
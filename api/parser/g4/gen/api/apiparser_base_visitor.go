@@ -115,6 +115,14 @@ func (v *BaseApiParserVisitor) VisitArrayType(ctx *ArrayTypeContext) interface{}
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseApiParserVisitor) VisitEnumSpec(ctx *EnumSpecContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseApiParserVisitor) VisitEnumValue(ctx *EnumValueContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseApiParserVisitor) VisitServiceSpec(ctx *ServiceSpecContext) interface{} {
 	return v.VisitChildren(ctx)
 }
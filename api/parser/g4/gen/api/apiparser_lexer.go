@@ -14,134 +14,141 @@ var _ = fmt.Printf
 var _ = unicode.IsLetter
 
 var serializedLexerAtn = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 26, 270,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 28, 284,
 	8, 1, 4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7,
 	9, 7, 4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12,
 	4, 13, 9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4,
 	18, 9, 18, 4, 19, 9, 19, 4, 20, 9, 20, 4, 21, 9, 21, 4, 22, 9, 22, 4, 23,
 	9, 23, 4, 24, 9, 24, 4, 25, 9, 25, 4, 26, 9, 26, 4, 27, 9, 27, 4, 28, 9,
-	28, 4, 29, 9, 29, 4, 30, 9, 30, 4, 31, 9, 31, 4, 32, 9, 32, 3, 2, 3, 2,
-	3, 3, 3, 3, 3, 4, 3, 4, 3, 5, 3, 5, 3, 6, 3, 6, 3, 7, 3, 7, 3, 8, 3, 8,
-	3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 9, 3, 9, 3, 10, 3, 10,
-	3, 11, 3, 11, 3, 12, 3, 12, 3, 13, 3, 13, 3, 14, 3, 14, 3, 14, 3, 15, 3,
-	15, 3, 15, 3, 15, 3, 15, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16,
-	3, 16, 3, 16, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 3,
-	17, 3, 17, 3, 17, 3, 17, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18,
-	3, 18, 3, 19, 6, 19, 136, 10, 19, 13, 19, 14, 19, 137, 3, 19, 3, 19, 3,
-	20, 3, 20, 3, 20, 3, 20, 7, 20, 146, 10, 20, 12, 20, 14, 20, 149, 11, 20,
-	3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 21, 3, 21, 3, 21, 3, 21, 7, 21, 160,
-	10, 21, 12, 21, 14, 21, 163, 11, 21, 3, 21, 3, 21, 3, 22, 3, 22, 3, 22,
-	7, 22, 170, 10, 22, 12, 22, 14, 22, 173, 11, 22, 3, 22, 3, 22, 3, 23, 3,
-	23, 3, 23, 6, 23, 180, 10, 23, 13, 23, 14, 23, 181, 3, 23, 3, 23, 3, 24,
-	3, 24, 7, 24, 188, 10, 24, 12, 24, 14, 24, 191, 11, 24, 3, 24, 3, 24, 7,
-	24, 195, 10, 24, 12, 24, 14, 24, 198, 11, 24, 5, 24, 200, 10, 24, 3, 25,
-	3, 25, 7, 25, 204, 10, 25, 12, 25, 14, 25, 207, 11, 25, 3, 26, 3, 26, 5,
-	26, 211, 10, 26, 3, 26, 3, 26, 3, 27, 3, 27, 3, 27, 3, 27, 5, 27, 219,
-	10, 27, 3, 27, 5, 27, 222, 10, 27, 3, 27, 3, 27, 3, 27, 6, 27, 227, 10,
-	27, 13, 27, 14, 27, 228, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 5, 27, 236,
-	10, 27, 3, 28, 3, 28, 3, 28, 7, 28, 241, 10, 28, 12, 28, 14, 28, 244, 11,
-	28, 3, 28, 5, 28, 247, 10, 28, 3, 29, 3, 29, 3, 30, 3, 30, 7, 30, 253,
-	10, 30, 12, 30, 14, 30, 256, 11, 30, 3, 30, 5, 30, 259, 10, 30, 3, 31,
-	3, 31, 5, 31, 263, 10, 31, 3, 32, 3, 32, 3, 32, 3, 32, 5, 32, 269, 10,
-	32, 3, 147, 2, 33, 3, 3, 5, 4, 7, 5, 9, 6, 11, 7, 13, 8, 15, 9, 17, 10,
-	19, 11, 21, 12, 23, 13, 25, 14, 27, 15, 29, 16, 31, 17, 33, 18, 35, 19,
-	37, 20, 39, 21, 41, 22, 43, 23, 45, 24, 47, 25, 49, 26, 51, 2, 53, 2, 55,
-	2, 57, 2, 59, 2, 61, 2, 63, 2, 3, 2, 20, 5, 2, 11, 12, 14, 15, 34, 34,
-	4, 2, 12, 12, 15, 15, 4, 2, 36, 36, 94, 94, 6, 2, 12, 12, 15, 15, 94, 94,
-	98, 98, 4, 2, 11, 11, 34, 34, 6, 2, 12, 12, 15, 15, 36, 36, 98, 98, 4,
-	2, 71, 71, 103, 103, 4, 2, 45, 45, 47, 47, 10, 2, 36, 36, 41, 41, 94, 94,
-	100, 100, 104, 104, 112, 112, 116, 116, 118, 118, 3, 2, 50, 53, 3, 2, 50,
-	57, 5, 2, 50, 59, 67, 72, 99, 104, 3, 2, 50, 59, 4, 2, 50, 59, 97, 97,
-	6, 2, 38, 38, 67, 92, 97, 97, 99, 124, 4, 2, 2, 129, 55298, 56321, 3, 2,
-	55298, 56321, 3, 2, 56322, 57345, 2, 287, 2, 3, 3, 2, 2, 2, 2, 5, 3, 2,
-	2, 2, 2, 7, 3, 2, 2, 2, 2, 9, 3, 2, 2, 2, 2, 11, 3, 2, 2, 2, 2, 13, 3,
-	2, 2, 2, 2, 15, 3, 2, 2, 2, 2, 17, 3, 2, 2, 2, 2, 19, 3, 2, 2, 2, 2, 21,
-	3, 2, 2, 2, 2, 23, 3, 2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2, 2,
-	29, 3, 2, 2, 2, 2, 31, 3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2, 35, 3, 2, 2, 2,
-	2, 37, 3, 2, 2, 2, 2, 39, 3, 2, 2, 2, 2, 41, 3, 2, 2, 2, 2, 43, 3, 2, 2,
-	2, 2, 45, 3, 2, 2, 2, 2, 47, 3, 2, 2, 2, 2, 49, 3, 2, 2, 2, 3, 65, 3, 2,
-	2, 2, 5, 67, 3, 2, 2, 2, 7, 69, 3, 2, 2, 2, 9, 71, 3, 2, 2, 2, 11, 73,
-	3, 2, 2, 2, 13, 75, 3, 2, 2, 2, 15, 77, 3, 2, 2, 2, 17, 87, 3, 2, 2, 2,
-	19, 89, 3, 2, 2, 2, 21, 91, 3, 2, 2, 2, 23, 93, 3, 2, 2, 2, 25, 95, 3,
-	2, 2, 2, 27, 97, 3, 2, 2, 2, 29, 100, 3, 2, 2, 2, 31, 105, 3, 2, 2, 2,
-	33, 114, 3, 2, 2, 2, 35, 126, 3, 2, 2, 2, 37, 135, 3, 2, 2, 2, 39, 141,
-	3, 2, 2, 2, 41, 155, 3, 2, 2, 2, 43, 166, 3, 2, 2, 2, 45, 176, 3, 2, 2,
-	2, 47, 185, 3, 2, 2, 2, 49, 201, 3, 2, 2, 2, 51, 208, 3, 2, 2, 2, 53, 235,
-	3, 2, 2, 2, 55, 237, 3, 2, 2, 2, 57, 248, 3, 2, 2, 2, 59, 250, 3, 2, 2,
-	2, 61, 262, 3, 2, 2, 2, 63, 268, 3, 2, 2, 2, 65, 66, 7, 63, 2, 2, 66, 4,
-	3, 2, 2, 2, 67, 68, 7, 42, 2, 2, 68, 6, 3, 2, 2, 2, 69, 70, 7, 43, 2, 2,
-	70, 8, 3, 2, 2, 2, 71, 72, 7, 125, 2, 2, 72, 10, 3, 2, 2, 2, 73, 74, 7,
-	127, 2, 2, 74, 12, 3, 2, 2, 2, 75, 76, 7, 44, 2, 2, 76, 14, 3, 2, 2, 2,
-	77, 78, 7, 118, 2, 2, 78, 79, 7, 107, 2, 2, 79, 80, 7, 111, 2, 2, 80, 81,
-	7, 103, 2, 2, 81, 82, 7, 48, 2, 2, 82, 83, 7, 86, 2, 2, 83, 84, 7, 107,
-	2, 2, 84, 85, 7, 111, 2, 2, 85, 86, 7, 103, 2, 2, 86, 16, 3, 2, 2, 2, 87,
-	88, 7, 48, 2, 2, 88, 18, 3, 2, 2, 2, 89, 90, 7, 93, 2, 2, 90, 20, 3, 2,
-	2, 2, 91, 92, 7, 95, 2, 2, 92, 22, 3, 2, 2, 2, 93, 94, 7, 47, 2, 2, 94,
-	24, 3, 2, 2, 2, 95, 96, 7, 49, 2, 2, 96, 26, 3, 2, 2, 2, 97, 98, 7, 49,
-	2, 2, 98, 99, 7, 60, 2, 2, 99, 28, 3, 2, 2, 2, 100, 101, 7, 66, 2, 2, 101,
-	102, 7, 102, 2, 2, 102, 103, 7, 113, 2, 2, 103, 104, 7, 101, 2, 2, 104,
-	30, 3, 2, 2, 2, 105, 106, 7, 66, 2, 2, 106, 107, 7, 106, 2, 2, 107, 108,
-	7, 99, 2, 2, 108, 109, 7, 112, 2, 2, 109, 110, 7, 102, 2, 2, 110, 111,
-	7, 110, 2, 2, 111, 112, 7, 103, 2, 2, 112, 113, 7, 116, 2, 2, 113, 32,
-	3, 2, 2, 2, 114, 115, 7, 107, 2, 2, 115, 116, 7, 112, 2, 2, 116, 117, 7,
-	118, 2, 2, 117, 118, 7, 103, 2, 2, 118, 119, 7, 116, 2, 2, 119, 120, 7,
-	104, 2, 2, 120, 121, 7, 99, 2, 2, 121, 122, 7, 101, 2, 2, 122, 123, 7,
-	103, 2, 2, 123, 124, 7, 125, 2, 2, 124, 125, 7, 127, 2, 2, 125, 34, 3,
-	2, 2, 2, 126, 127, 7, 66, 2, 2, 127, 128, 7, 117, 2, 2, 128, 129, 7, 103,
-	2, 2, 129, 130, 7, 116, 2, 2, 130, 131, 7, 120, 2, 2, 131, 132, 7, 103,
-	2, 2, 132, 133, 7, 116, 2, 2, 133, 36, 3, 2, 2, 2, 134, 136, 9, 2, 2, 2,
-	135, 134, 3, 2, 2, 2, 136, 137, 3, 2, 2, 2, 137, 135, 3, 2, 2, 2, 137,
-	138, 3, 2, 2, 2, 138, 139, 3, 2, 2, 2, 139, 140, 8, 19, 2, 2, 140, 38,
-	3, 2, 2, 2, 141, 142, 7, 49, 2, 2, 142, 143, 7, 44, 2, 2, 143, 147, 3,
-	2, 2, 2, 144, 146, 11, 2, 2, 2, 145, 144, 3, 2, 2, 2, 146, 149, 3, 2, 2,
-	2, 147, 148, 3, 2, 2, 2, 147, 145, 3, 2, 2, 2, 148, 150, 3, 2, 2, 2, 149,
-	147, 3, 2, 2, 2, 150, 151, 7, 44, 2, 2, 151, 152, 7, 49, 2, 2, 152, 153,
-	3, 2, 2, 2, 153, 154, 8, 20, 3, 2, 154, 40, 3, 2, 2, 2, 155, 156, 7, 49,
-	2, 2, 156, 157, 7, 49, 2, 2, 157, 161, 3, 2, 2, 2, 158, 160, 10, 3, 2,
-	2, 159, 158, 3, 2, 2, 2, 160, 163, 3, 2, 2, 2, 161, 159, 3, 2, 2, 2, 161,
-	162, 3, 2, 2, 2, 162, 164, 3, 2, 2, 2, 163, 161, 3, 2, 2, 2, 164, 165,
-	8, 21, 3, 2, 165, 42, 3, 2, 2, 2, 166, 171, 7, 36, 2, 2, 167, 170, 10,
-	4, 2, 2, 168, 170, 5, 53, 27, 2, 169, 167, 3, 2, 2, 2, 169, 168, 3, 2,
-	2, 2, 170, 173, 3, 2, 2, 2, 171, 169, 3, 2, 2, 2, 171, 172, 3, 2, 2, 2,
-	172, 174, 3, 2, 2, 2, 173, 171, 3, 2, 2, 2, 174, 175, 7, 36, 2, 2, 175,
-	44, 3, 2, 2, 2, 176, 179, 7, 98, 2, 2, 177, 180, 10, 5, 2, 2, 178, 180,
-	5, 53, 27, 2, 179, 177, 3, 2, 2, 2, 179, 178, 3, 2, 2, 2, 180, 181, 3,
-	2, 2, 2, 181, 179, 3, 2, 2, 2, 181, 182, 3, 2, 2, 2, 182, 183, 3, 2, 2,
-	2, 183, 184, 7, 98, 2, 2, 184, 46, 3, 2, 2, 2, 185, 189, 7, 60, 2, 2, 186,
-	188, 9, 6, 2, 2, 187, 186, 3, 2, 2, 2, 188, 191, 3, 2, 2, 2, 189, 187,
-	3, 2, 2, 2, 189, 190, 3, 2, 2, 2, 190, 199, 3, 2, 2, 2, 191, 189, 3, 2,
-	2, 2, 192, 200, 5, 43, 22, 2, 193, 195, 10, 7, 2, 2, 194, 193, 3, 2, 2,
-	2, 195, 198, 3, 2, 2, 2, 196, 194, 3, 2, 2, 2, 196, 197, 3, 2, 2, 2, 197,
-	200, 3, 2, 2, 2, 198, 196, 3, 2, 2, 2, 199, 192, 3, 2, 2, 2, 199, 196,
-	3, 2, 2, 2, 200, 48, 3, 2, 2, 2, 201, 205, 5, 63, 32, 2, 202, 204, 5, 61,
-	31, 2, 203, 202, 3, 2, 2, 2, 204, 207, 3, 2, 2, 2, 205, 203, 3, 2, 2, 2,
-	205, 206, 3, 2, 2, 2, 206, 50, 3, 2, 2, 2, 207, 205, 3, 2, 2, 2, 208, 210,
-	9, 8, 2, 2, 209, 211, 9, 9, 2, 2, 210, 209, 3, 2, 2, 2, 210, 211, 3, 2,
-	2, 2, 211, 212, 3, 2, 2, 2, 212, 213, 5, 59, 30, 2, 213, 52, 3, 2, 2, 2,
-	214, 215, 7, 94, 2, 2, 215, 236, 9, 10, 2, 2, 216, 221, 7, 94, 2, 2, 217,
-	219, 9, 11, 2, 2, 218, 217, 3, 2, 2, 2, 218, 219, 3, 2, 2, 2, 219, 220,
-	3, 2, 2, 2, 220, 222, 9, 12, 2, 2, 221, 218, 3, 2, 2, 2, 221, 222, 3, 2,
-	2, 2, 222, 223, 3, 2, 2, 2, 223, 236, 9, 12, 2, 2, 224, 226, 7, 94, 2,
-	2, 225, 227, 7, 119, 2, 2, 226, 225, 3, 2, 2, 2, 227, 228, 3, 2, 2, 2,
-	228, 226, 3, 2, 2, 2, 228, 229, 3, 2, 2, 2, 229, 230, 3, 2, 2, 2, 230,
-	231, 5, 57, 29, 2, 231, 232, 5, 57, 29, 2, 232, 233, 5, 57, 29, 2, 233,
-	234, 5, 57, 29, 2, 234, 236, 3, 2, 2, 2, 235, 214, 3, 2, 2, 2, 235, 216,
-	3, 2, 2, 2, 235, 224, 3, 2, 2, 2, 236, 54, 3, 2, 2, 2, 237, 246, 5, 57,
-	29, 2, 238, 241, 5, 57, 29, 2, 239, 241, 7, 97, 2, 2, 240, 238, 3, 2, 2,
-	2, 240, 239, 3, 2, 2, 2, 241, 244, 3, 2, 2, 2, 242, 240, 3, 2, 2, 2, 242,
-	243, 3, 2, 2, 2, 243, 245, 3, 2, 2, 2, 244, 242, 3, 2, 2, 2, 245, 247,
-	5, 57, 29, 2, 246, 242, 3, 2, 2, 2, 246, 247, 3, 2, 2, 2, 247, 56, 3, 2,
-	2, 2, 248, 249, 9, 13, 2, 2, 249, 58, 3, 2, 2, 2, 250, 258, 9, 14, 2, 2,
-	251, 253, 9, 15, 2, 2, 252, 251, 3, 2, 2, 2, 253, 256, 3, 2, 2, 2, 254,
-	252, 3, 2, 2, 2, 254, 255, 3, 2, 2, 2, 255, 257, 3, 2, 2, 2, 256, 254,
-	3, 2, 2, 2, 257, 259, 9, 14, 2, 2, 258, 254, 3, 2, 2, 2, 258, 259, 3, 2,
-	2, 2, 259, 60, 3, 2, 2, 2, 260, 263, 5, 63, 32, 2, 261, 263, 9, 14, 2,
-	2, 262, 260, 3, 2, 2, 2, 262, 261, 3, 2, 2, 2, 263, 62, 3, 2, 2, 2, 264,
-	269, 9, 16, 2, 2, 265, 269, 10, 17, 2, 2, 266, 267, 9, 18, 2, 2, 267, 269,
-	9, 19, 2, 2, 268, 264, 3, 2, 2, 2, 268, 265, 3, 2, 2, 2, 268, 266, 3, 2,
-	2, 2, 269, 64, 3, 2, 2, 2, 26, 2, 137, 147, 161, 169, 171, 179, 181, 189,
-	196, 199, 205, 210, 218, 221, 228, 235, 240, 242, 246, 254, 258, 262, 268,
-	4, 2, 3, 2, 2, 90, 2,
+	28, 4, 29, 9, 29, 4, 30, 9, 30, 4, 31, 9, 31, 4, 32, 9, 32, 4, 33, 9, 33,
+	4, 34, 9, 34, 3, 2, 3, 2, 3, 3, 3, 3, 3, 4, 3, 4, 3, 5, 3, 5, 3, 6, 3,
+	6, 3, 7, 3, 7, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3,
+	8, 3, 9, 3, 9, 3, 10, 3, 10, 3, 11, 3, 11, 3, 12, 3, 12, 3, 13, 3, 13,
+	3, 14, 3, 14, 3, 14, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 16, 3, 16, 3,
+	16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 17, 3, 17, 3, 17, 3, 17,
+	3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 18, 3, 18, 3,
+	18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 19, 6, 19, 140, 10, 19, 13, 19,
+	14, 19, 141, 3, 19, 3, 19, 3, 20, 3, 20, 3, 20, 3, 20, 7, 20, 150, 10,
+	20, 12, 20, 14, 20, 153, 11, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3,
+	21, 3, 21, 3, 21, 3, 21, 7, 21, 164, 10, 21, 12, 21, 14, 21, 167, 11, 21,
+	3, 21, 3, 21, 3, 22, 3, 22, 3, 22, 7, 22, 174, 10, 22, 12, 22, 14, 22,
+	177, 11, 22, 3, 22, 3, 22, 3, 23, 3, 23, 3, 23, 6, 23, 184, 10, 23, 13,
+	23, 14, 23, 185, 3, 23, 3, 23, 3, 24, 3, 24, 7, 24, 192, 10, 24, 12, 24,
+	14, 24, 195, 11, 24, 3, 24, 3, 24, 7, 24, 199, 10, 24, 12, 24, 14, 24,
+	202, 11, 24, 5, 24, 204, 10, 24, 3, 25, 3, 25, 7, 25, 208, 10, 25, 12,
+	25, 14, 25, 211, 11, 25, 3, 26, 5, 26, 214, 10, 26, 3, 26, 6, 26, 217,
+	10, 26, 13, 26, 14, 26, 218, 3, 27, 3, 27, 3, 28, 3, 28, 5, 28, 225, 10,
+	28, 3, 28, 3, 28, 3, 29, 3, 29, 3, 29, 3, 29, 5, 29, 233, 10, 29, 3, 29,
+	5, 29, 236, 10, 29, 3, 29, 3, 29, 3, 29, 6, 29, 241, 10, 29, 13, 29, 14,
+	29, 242, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 5, 29, 250, 10, 29, 3, 30,
+	3, 30, 3, 30, 7, 30, 255, 10, 30, 12, 30, 14, 30, 258, 11, 30, 3, 30, 5,
+	30, 261, 10, 30, 3, 31, 3, 31, 3, 32, 3, 32, 7, 32, 267, 10, 32, 12, 32,
+	14, 32, 270, 11, 32, 3, 32, 5, 32, 273, 10, 32, 3, 33, 3, 33, 5, 33, 277,
+	10, 33, 3, 34, 3, 34, 3, 34, 3, 34, 5, 34, 283, 10, 34, 3, 151, 2, 35,
+	3, 3, 5, 4, 7, 5, 9, 6, 11, 7, 13, 8, 15, 9, 17, 10, 19, 11, 21, 12, 23,
+	13, 25, 14, 27, 15, 29, 16, 31, 17, 33, 18, 35, 19, 37, 20, 39, 21, 41,
+	22, 43, 23, 45, 24, 47, 25, 49, 26, 51, 27, 53, 28, 55, 2, 57, 2, 59, 2,
+	61, 2, 63, 2, 65, 2, 67, 2, 3, 2, 20, 5, 2, 11, 12, 14, 15, 34, 34, 4,
+	2, 12, 12, 15, 15, 4, 2, 36, 36, 94, 94, 6, 2, 12, 12, 15, 15, 94, 94,
+	98, 98, 4, 2, 11, 11, 34, 34, 6, 2, 12, 12, 15, 15, 36, 36, 98, 98, 3,
+	2, 50, 59, 4, 2, 71, 71, 103, 103, 4, 2, 45, 45, 47, 47, 10, 2, 36, 36,
+	41, 41, 94, 94, 100, 100, 104, 104, 112, 112, 116, 116, 118, 118, 3, 2,
+	50, 53, 3, 2, 50, 57, 5, 2, 50, 59, 67, 72, 99, 104, 4, 2, 50, 59, 97,
+	97, 6, 2, 38, 38, 67, 92, 97, 97, 99, 124, 4, 2, 2, 129, 55298, 56321,
+	3, 2, 55298, 56321, 3, 2, 56322, 57345, 2, 303, 2, 3, 3, 2, 2, 2, 2, 5,
+	3, 2, 2, 2, 2, 7, 3, 2, 2, 2, 2, 9, 3, 2, 2, 2, 2, 11, 3, 2, 2, 2, 2, 13,
+	3, 2, 2, 2, 2, 15, 3, 2, 2, 2, 2, 17, 3, 2, 2, 2, 2, 19, 3, 2, 2, 2, 2,
+	21, 3, 2, 2, 2, 2, 23, 3, 2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2,
+	2, 29, 3, 2, 2, 2, 2, 31, 3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2, 35, 3, 2, 2,
+	2, 2, 37, 3, 2, 2, 2, 2, 39, 3, 2, 2, 2, 2, 41, 3, 2, 2, 2, 2, 43, 3, 2,
+	2, 2, 2, 45, 3, 2, 2, 2, 2, 47, 3, 2, 2, 2, 2, 49, 3, 2, 2, 2, 2, 51, 3,
+	2, 2, 2, 2, 53, 3, 2, 2, 2, 3, 69, 3, 2, 2, 2, 5, 71, 3, 2, 2, 2, 7, 73,
+	3, 2, 2, 2, 9, 75, 3, 2, 2, 2, 11, 77, 3, 2, 2, 2, 13, 79, 3, 2, 2, 2,
+	15, 81, 3, 2, 2, 2, 17, 91, 3, 2, 2, 2, 19, 93, 3, 2, 2, 2, 21, 95, 3,
+	2, 2, 2, 23, 97, 3, 2, 2, 2, 25, 99, 3, 2, 2, 2, 27, 101, 3, 2, 2, 2, 29,
+	104, 3, 2, 2, 2, 31, 109, 3, 2, 2, 2, 33, 118, 3, 2, 2, 2, 35, 130, 3,
+	2, 2, 2, 37, 139, 3, 2, 2, 2, 39, 145, 3, 2, 2, 2, 41, 159, 3, 2, 2, 2,
+	43, 170, 3, 2, 2, 2, 45, 180, 3, 2, 2, 2, 47, 189, 3, 2, 2, 2, 49, 205,
+	3, 2, 2, 2, 51, 213, 3, 2, 2, 2, 53, 220, 3, 2, 2, 2, 55, 222, 3, 2, 2,
+	2, 57, 249, 3, 2, 2, 2, 59, 251, 3, 2, 2, 2, 61, 262, 3, 2, 2, 2, 63, 264,
+	3, 2, 2, 2, 65, 276, 3, 2, 2, 2, 67, 282, 3, 2, 2, 2, 69, 70, 7, 63, 2,
+	2, 70, 4, 3, 2, 2, 2, 71, 72, 7, 42, 2, 2, 72, 6, 3, 2, 2, 2, 73, 74, 7,
+	43, 2, 2, 74, 8, 3, 2, 2, 2, 75, 76, 7, 125, 2, 2, 76, 10, 3, 2, 2, 2,
+	77, 78, 7, 127, 2, 2, 78, 12, 3, 2, 2, 2, 79, 80, 7, 44, 2, 2, 80, 14,
+	3, 2, 2, 2, 81, 82, 7, 118, 2, 2, 82, 83, 7, 107, 2, 2, 83, 84, 7, 111,
+	2, 2, 84, 85, 7, 103, 2, 2, 85, 86, 7, 48, 2, 2, 86, 87, 7, 86, 2, 2, 87,
+	88, 7, 107, 2, 2, 88, 89, 7, 111, 2, 2, 89, 90, 7, 103, 2, 2, 90, 16, 3,
+	2, 2, 2, 91, 92, 7, 48, 2, 2, 92, 18, 3, 2, 2, 2, 93, 94, 7, 93, 2, 2,
+	94, 20, 3, 2, 2, 2, 95, 96, 7, 95, 2, 2, 96, 22, 3, 2, 2, 2, 97, 98, 7,
+	47, 2, 2, 98, 24, 3, 2, 2, 2, 99, 100, 7, 49, 2, 2, 100, 26, 3, 2, 2, 2,
+	101, 102, 7, 49, 2, 2, 102, 103, 7, 60, 2, 2, 103, 28, 3, 2, 2, 2, 104,
+	105, 7, 66, 2, 2, 105, 106, 7, 102, 2, 2, 106, 107, 7, 113, 2, 2, 107,
+	108, 7, 101, 2, 2, 108, 30, 3, 2, 2, 2, 109, 110, 7, 66, 2, 2, 110, 111,
+	7, 106, 2, 2, 111, 112, 7, 99, 2, 2, 112, 113, 7, 112, 2, 2, 113, 114,
+	7, 102, 2, 2, 114, 115, 7, 110, 2, 2, 115, 116, 7, 103, 2, 2, 116, 117,
+	7, 116, 2, 2, 117, 32, 3, 2, 2, 2, 118, 119, 7, 107, 2, 2, 119, 120, 7,
+	112, 2, 2, 120, 121, 7, 118, 2, 2, 121, 122, 7, 103, 2, 2, 122, 123, 7,
+	116, 2, 2, 123, 124, 7, 104, 2, 2, 124, 125, 7, 99, 2, 2, 125, 126, 7,
+	101, 2, 2, 126, 127, 7, 103, 2, 2, 127, 128, 7, 125, 2, 2, 128, 129, 7,
+	127, 2, 2, 129, 34, 3, 2, 2, 2, 130, 131, 7, 66, 2, 2, 131, 132, 7, 117,
+	2, 2, 132, 133, 7, 103, 2, 2, 133, 134, 7, 116, 2, 2, 134, 135, 7, 120,
+	2, 2, 135, 136, 7, 103, 2, 2, 136, 137, 7, 116, 2, 2, 137, 36, 3, 2, 2,
+	2, 138, 140, 9, 2, 2, 2, 139, 138, 3, 2, 2, 2, 140, 141, 3, 2, 2, 2, 141,
+	139, 3, 2, 2, 2, 141, 142, 3, 2, 2, 2, 142, 143, 3, 2, 2, 2, 143, 144,
+	8, 19, 2, 2, 144, 38, 3, 2, 2, 2, 145, 146, 7, 49, 2, 2, 146, 147, 7, 44,
+	2, 2, 147, 151, 3, 2, 2, 2, 148, 150, 11, 2, 2, 2, 149, 148, 3, 2, 2, 2,
+	150, 153, 3, 2, 2, 2, 151, 152, 3, 2, 2, 2, 151, 149, 3, 2, 2, 2, 152,
+	154, 3, 2, 2, 2, 153, 151, 3, 2, 2, 2, 154, 155, 7, 44, 2, 2, 155, 156,
+	7, 49, 2, 2, 156, 157, 3, 2, 2, 2, 157, 158, 8, 20, 3, 2, 158, 40, 3, 2,
+	2, 2, 159, 160, 7, 49, 2, 2, 160, 161, 7, 49, 2, 2, 161, 165, 3, 2, 2,
+	2, 162, 164, 10, 3, 2, 2, 163, 162, 3, 2, 2, 2, 164, 167, 3, 2, 2, 2, 165,
+	163, 3, 2, 2, 2, 165, 166, 3, 2, 2, 2, 166, 168, 3, 2, 2, 2, 167, 165,
+	3, 2, 2, 2, 168, 169, 8, 21, 3, 2, 169, 42, 3, 2, 2, 2, 170, 175, 7, 36,
+	2, 2, 171, 174, 10, 4, 2, 2, 172, 174, 5, 57, 29, 2, 173, 171, 3, 2, 2,
+	2, 173, 172, 3, 2, 2, 2, 174, 177, 3, 2, 2, 2, 175, 173, 3, 2, 2, 2, 175,
+	176, 3, 2, 2, 2, 176, 178, 3, 2, 2, 2, 177, 175, 3, 2, 2, 2, 178, 179,
+	7, 36, 2, 2, 179, 44, 3, 2, 2, 2, 180, 183, 7, 98, 2, 2, 181, 184, 10,
+	5, 2, 2, 182, 184, 5, 57, 29, 2, 183, 181, 3, 2, 2, 2, 183, 182, 3, 2,
+	2, 2, 184, 185, 3, 2, 2, 2, 185, 183, 3, 2, 2, 2, 185, 186, 3, 2, 2, 2,
+	186, 187, 3, 2, 2, 2, 187, 188, 7, 98, 2, 2, 188, 46, 3, 2, 2, 2, 189,
+	193, 7, 60, 2, 2, 190, 192, 9, 6, 2, 2, 191, 190, 3, 2, 2, 2, 192, 195,
+	3, 2, 2, 2, 193, 191, 3, 2, 2, 2, 193, 194, 3, 2, 2, 2, 194, 203, 3, 2,
+	2, 2, 195, 193, 3, 2, 2, 2, 196, 204, 5, 43, 22, 2, 197, 199, 10, 7, 2,
+	2, 198, 197, 3, 2, 2, 2, 199, 202, 3, 2, 2, 2, 200, 198, 3, 2, 2, 2, 200,
+	201, 3, 2, 2, 2, 201, 204, 3, 2, 2, 2, 202, 200, 3, 2, 2, 2, 203, 196,
+	3, 2, 2, 2, 203, 200, 3, 2, 2, 2, 204, 48, 3, 2, 2, 2, 205, 209, 5, 67,
+	34, 2, 206, 208, 5, 65, 33, 2, 207, 206, 3, 2, 2, 2, 208, 211, 3, 2, 2,
+	2, 209, 207, 3, 2, 2, 2, 209, 210, 3, 2, 2, 2, 210, 50, 3, 2, 2, 2, 211,
+	209, 3, 2, 2, 2, 212, 214, 7, 47, 2, 2, 213, 212, 3, 2, 2, 2, 213, 214,
+	3, 2, 2, 2, 214, 216, 3, 2, 2, 2, 215, 217, 9, 8, 2, 2, 216, 215, 3, 2,
+	2, 2, 217, 218, 3, 2, 2, 2, 218, 216, 3, 2, 2, 2, 218, 219, 3, 2, 2, 2,
+	219, 52, 3, 2, 2, 2, 220, 221, 7, 61, 2, 2, 221, 54, 3, 2, 2, 2, 222, 224,
+	9, 9, 2, 2, 223, 225, 9, 10, 2, 2, 224, 223, 3, 2, 2, 2, 224, 225, 3, 2,
+	2, 2, 225, 226, 3, 2, 2, 2, 226, 227, 5, 63, 32, 2, 227, 56, 3, 2, 2, 2,
+	228, 229, 7, 94, 2, 2, 229, 250, 9, 11, 2, 2, 230, 235, 7, 94, 2, 2, 231,
+	233, 9, 12, 2, 2, 232, 231, 3, 2, 2, 2, 232, 233, 3, 2, 2, 2, 233, 234,
+	3, 2, 2, 2, 234, 236, 9, 13, 2, 2, 235, 232, 3, 2, 2, 2, 235, 236, 3, 2,
+	2, 2, 236, 237, 3, 2, 2, 2, 237, 250, 9, 13, 2, 2, 238, 240, 7, 94, 2,
+	2, 239, 241, 7, 119, 2, 2, 240, 239, 3, 2, 2, 2, 241, 242, 3, 2, 2, 2,
+	242, 240, 3, 2, 2, 2, 242, 243, 3, 2, 2, 2, 243, 244, 3, 2, 2, 2, 244,
+	245, 5, 61, 31, 2, 245, 246, 5, 61, 31, 2, 246, 247, 5, 61, 31, 2, 247,
+	248, 5, 61, 31, 2, 248, 250, 3, 2, 2, 2, 249, 228, 3, 2, 2, 2, 249, 230,
+	3, 2, 2, 2, 249, 238, 3, 2, 2, 2, 250, 58, 3, 2, 2, 2, 251, 260, 5, 61,
+	31, 2, 252, 255, 5, 61, 31, 2, 253, 255, 7, 97, 2, 2, 254, 252, 3, 2, 2,
+	2, 254, 253, 3, 2, 2, 2, 255, 258, 3, 2, 2, 2, 256, 254, 3, 2, 2, 2, 256,
+	257, 3, 2, 2, 2, 257, 259, 3, 2, 2, 2, 258, 256, 3, 2, 2, 2, 259, 261,
+	5, 61, 31, 2, 260, 256, 3, 2, 2, 2, 260, 261, 3, 2, 2, 2, 261, 60, 3, 2,
+	2, 2, 262, 263, 9, 14, 2, 2, 263, 62, 3, 2, 2, 2, 264, 272, 9, 8, 2, 2,
+	265, 267, 9, 15, 2, 2, 266, 265, 3, 2, 2, 2, 267, 270, 3, 2, 2, 2, 268,
+	266, 3, 2, 2, 2, 268, 269, 3, 2, 2, 2, 269, 271, 3, 2, 2, 2, 270, 268,
+	3, 2, 2, 2, 271, 273, 9, 8, 2, 2, 272, 268, 3, 2, 2, 2, 272, 273, 3, 2,
+	2, 2, 273, 64, 3, 2, 2, 2, 274, 277, 5, 67, 34, 2, 275, 277, 9, 8, 2, 2,
+	276, 274, 3, 2, 2, 2, 276, 275, 3, 2, 2, 2, 277, 66, 3, 2, 2, 2, 278, 283,
+	9, 16, 2, 2, 279, 283, 10, 17, 2, 2, 280, 281, 9, 18, 2, 2, 281, 283, 9,
+	19, 2, 2, 282, 278, 3, 2, 2, 2, 282, 279, 3, 2, 2, 2, 282, 280, 3, 2, 2,
+	2, 283, 68, 3, 2, 2, 2, 28, 2, 141, 151, 165, 173, 175, 183, 185, 193,
+	200, 203, 209, 213, 218, 224, 232, 235, 242, 249, 254, 256, 260, 268, 272,
+	276, 282, 4, 2, 3, 2, 2, 90, 2,
 }
 
 var lexerChannelNames = []string{
@@ -155,20 +162,21 @@ var lexerModeNames = []string{
 var lexerLiteralNames = []string{
 	"", "'='", "'('", "')'", "'{'", "'}'", "'*'", "'time.Time'", "'.'", "'['",
 	"']'", "'-'", "'/'", "'/:'", "'@doc'", "'@handler'", "'interface{}'", "'@server'",
+	"", "", "", "", "", "", "", "", "';'",
 }
 
 var lexerSymbolicNames = []string{
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "ATDOC", "ATHANDLER",
 	"INTERFACE", "ATSERVER", "WS", "COMMENT", "LINE_COMMENT", "STRING", "RAW_STRING",
-	"LINE_VALUE", "ID",
+	"LINE_VALUE", "ID", "INT", "SEMICOLON",
 }
 
 var lexerRuleNames = []string{
 	"T__0", "T__1", "T__2", "T__3", "T__4", "T__5", "T__6", "T__7", "T__8",
 	"T__9", "T__10", "T__11", "T__12", "ATDOC", "ATHANDLER", "INTERFACE", "ATSERVER",
 	"WS", "COMMENT", "LINE_COMMENT", "STRING", "RAW_STRING", "LINE_VALUE",
-	"ID", "ExponentPart", "EscapeSequence", "HexDigits", "HexDigit", "Digits",
-	"LetterOrDigit", "Letter",
+	"ID", "INT", "SEMICOLON", "ExponentPart", "EscapeSequence", "HexDigits",
+	"HexDigit", "Digits", "LetterOrDigit", "Letter",
 }
 
 type ApiParserLexer struct {
@@ -232,6 +240,8 @@ const (
 	ApiParserLexerRAW_STRING   = 22
 	ApiParserLexerLINE_VALUE   = 23
 	ApiParserLexerID           = 24
+	ApiParserLexerINT          = 25
+	ApiParserLexerSEMICOLON    = 26
 )
 
 const COMEMNTS = 88
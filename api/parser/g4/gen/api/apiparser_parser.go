@@ -15,7 +15,7 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 26, 370,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 28, 394,
 	4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7, 9, 7,
 	4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12, 4, 13,
 	9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4, 18, 9,
@@ -23,168 +23,181 @@ var parserATN = []uint16{
 	4, 24, 9, 24, 4, 25, 9, 25, 4, 26, 9, 26, 4, 27, 9, 27, 4, 28, 9, 28, 4,
 	29, 9, 29, 4, 30, 9, 30, 4, 31, 9, 31, 4, 32, 9, 32, 4, 33, 9, 33, 4, 34,
 	9, 34, 4, 35, 9, 35, 4, 36, 9, 36, 4, 37, 9, 37, 4, 38, 9, 38, 4, 39, 9,
-	39, 4, 40, 9, 40, 3, 2, 7, 2, 82, 10, 2, 12, 2, 14, 2, 85, 11, 2, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 5, 3, 92, 10, 3, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4,
-	3, 4, 3, 5, 3, 5, 5, 5, 102, 10, 5, 3, 6, 3, 6, 3, 6, 3, 6, 3, 7, 3, 7,
-	3, 7, 3, 7, 6, 7, 112, 10, 7, 13, 7, 14, 7, 113, 3, 7, 3, 7, 3, 8, 3, 8,
-	3, 9, 3, 9, 3, 9, 5, 9, 123, 10, 9, 3, 10, 3, 10, 3, 10, 3, 10, 3, 11,
-	3, 11, 3, 11, 3, 11, 6, 11, 133, 10, 11, 13, 11, 14, 11, 134, 3, 11, 3,
-	11, 3, 12, 3, 12, 5, 12, 141, 10, 12, 3, 13, 3, 13, 3, 13, 3, 13, 3, 14,
-	3, 14, 3, 14, 3, 14, 7, 14, 151, 10, 14, 12, 14, 14, 14, 154, 11, 14, 3,
-	14, 3, 14, 3, 15, 3, 15, 5, 15, 160, 10, 15, 3, 16, 3, 16, 5, 16, 164,
-	10, 16, 3, 17, 3, 17, 3, 17, 5, 17, 169, 10, 17, 3, 17, 3, 17, 7, 17, 173,
-	10, 17, 12, 17, 14, 17, 176, 11, 17, 3, 17, 3, 17, 3, 18, 3, 18, 3, 18,
-	5, 18, 183, 10, 18, 3, 18, 3, 18, 3, 19, 3, 19, 3, 19, 5, 19, 190, 10,
-	19, 3, 19, 3, 19, 7, 19, 194, 10, 19, 12, 19, 14, 19, 197, 11, 19, 3, 19,
-	3, 19, 3, 20, 3, 20, 3, 20, 5, 20, 204, 10, 20, 3, 20, 3, 20, 3, 21, 3,
-	21, 3, 21, 5, 21, 211, 10, 21, 3, 22, 3, 22, 3, 22, 3, 22, 5, 22, 217,
-	10, 22, 3, 23, 5, 23, 220, 10, 23, 3, 23, 5, 23, 223, 10, 23, 3, 23, 3,
-	23, 3, 24, 5, 24, 228, 10, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24,
-	3, 24, 3, 24, 5, 24, 238, 10, 24, 3, 25, 3, 25, 5, 25, 242, 10, 25, 3,
-	25, 3, 25, 3, 25, 3, 26, 3, 26, 3, 26, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27,
-	3, 27, 3, 27, 3, 27, 3, 28, 3, 28, 3, 28, 3, 28, 3, 29, 5, 29, 263, 10,
-	29, 3, 29, 3, 29, 3, 30, 3, 30, 3, 30, 6, 30, 270, 10, 30, 13, 30, 14,
-	30, 271, 3, 30, 3, 30, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 7, 31, 281, 10,
-	31, 12, 31, 14, 31, 284, 11, 31, 3, 31, 3, 31, 3, 32, 5, 32, 289, 10, 32,
-	3, 32, 3, 32, 5, 32, 293, 10, 32, 3, 32, 3, 32, 3, 33, 3, 33, 5, 33, 299,
-	10, 33, 3, 33, 6, 33, 302, 10, 33, 13, 33, 14, 33, 303, 3, 33, 5, 33, 307,
-	10, 33, 3, 33, 5, 33, 310, 10, 33, 3, 34, 3, 34, 3, 34, 3, 35, 3, 35, 3,
-	35, 3, 35, 5, 35, 319, 10, 35, 3, 35, 5, 35, 322, 10, 35, 3, 35, 5, 35,
-	325, 10, 35, 3, 36, 3, 36, 5, 36, 329, 10, 36, 3, 36, 3, 36, 3, 37, 3,
-	37, 5, 37, 335, 10, 37, 3, 37, 3, 37, 3, 38, 3, 38, 3, 38, 3, 38, 3, 39,
-	3, 39, 5, 39, 345, 10, 39, 6, 39, 347, 10, 39, 13, 39, 14, 39, 348, 3,
-	40, 3, 40, 3, 40, 3, 40, 7, 40, 355, 10, 40, 12, 40, 14, 40, 358, 11, 40,
-	3, 40, 3, 40, 3, 40, 3, 40, 5, 40, 364, 10, 40, 6, 40, 366, 10, 40, 13,
-	40, 14, 40, 367, 3, 40, 2, 2, 41, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22,
-	24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58,
-	60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 2, 2, 2, 381, 2, 83, 3, 2, 2, 2,
-	4, 91, 3, 2, 2, 2, 6, 93, 3, 2, 2, 2, 8, 101, 3, 2, 2, 2, 10, 103, 3, 2,
-	2, 2, 12, 107, 3, 2, 2, 2, 14, 117, 3, 2, 2, 2, 16, 119, 3, 2, 2, 2, 18,
-	124, 3, 2, 2, 2, 20, 128, 3, 2, 2, 2, 22, 140, 3, 2, 2, 2, 24, 142, 3,
-	2, 2, 2, 26, 146, 3, 2, 2, 2, 28, 159, 3, 2, 2, 2, 30, 163, 3, 2, 2, 2,
-	32, 165, 3, 2, 2, 2, 34, 179, 3, 2, 2, 2, 36, 186, 3, 2, 2, 2, 38, 200,
-	3, 2, 2, 2, 40, 210, 3, 2, 2, 2, 42, 212, 3, 2, 2, 2, 44, 219, 3, 2, 2,
-	2, 46, 237, 3, 2, 2, 2, 48, 239, 3, 2, 2, 2, 50, 246, 3, 2, 2, 2, 52, 249,
-	3, 2, 2, 2, 54, 257, 3, 2, 2, 2, 56, 262, 3, 2, 2, 2, 58, 266, 3, 2, 2,
-	2, 60, 275, 3, 2, 2, 2, 62, 288, 3, 2, 2, 2, 64, 296, 3, 2, 2, 2, 66, 311,
-	3, 2, 2, 2, 68, 314, 3, 2, 2, 2, 70, 326, 3, 2, 2, 2, 72, 332, 3, 2, 2,
-	2, 74, 338, 3, 2, 2, 2, 76, 346, 3, 2, 2, 2, 78, 365, 3, 2, 2, 2, 80, 82,
-	5, 4, 3, 2, 81, 80, 3, 2, 2, 2, 82, 85, 3, 2, 2, 2, 83, 81, 3, 2, 2, 2,
-	83, 84, 3, 2, 2, 2, 84, 3, 3, 2, 2, 2, 85, 83, 3, 2, 2, 2, 86, 92, 5, 6,
-	4, 2, 87, 92, 5, 8, 5, 2, 88, 92, 5, 20, 11, 2, 89, 92, 5, 22, 12, 2, 90,
-	92, 5, 56, 29, 2, 91, 86, 3, 2, 2, 2, 91, 87, 3, 2, 2, 2, 91, 88, 3, 2,
-	2, 2, 91, 89, 3, 2, 2, 2, 91, 90, 3, 2, 2, 2, 92, 5, 3, 2, 2, 2, 93, 94,
-	8, 4, 1, 2, 94, 95, 7, 26, 2, 2, 95, 96, 7, 3, 2, 2, 96, 97, 8, 4, 1, 2,
-	97, 98, 7, 23, 2, 2, 98, 7, 3, 2, 2, 2, 99, 102, 5, 10, 6, 2, 100, 102,
-	5, 12, 7, 2, 101, 99, 3, 2, 2, 2, 101, 100, 3, 2, 2, 2, 102, 9, 3, 2, 2,
-	2, 103, 104, 8, 6, 1, 2, 104, 105, 7, 26, 2, 2, 105, 106, 5, 16, 9, 2,
-	106, 11, 3, 2, 2, 2, 107, 108, 8, 7, 1, 2, 108, 109, 7, 26, 2, 2, 109,
-	111, 7, 4, 2, 2, 110, 112, 5, 14, 8, 2, 111, 110, 3, 2, 2, 2, 112, 113,
-	3, 2, 2, 2, 113, 111, 3, 2, 2, 2, 113, 114, 3, 2, 2, 2, 114, 115, 3, 2,
-	2, 2, 115, 116, 7, 5, 2, 2, 116, 13, 3, 2, 2, 2, 117, 118, 5, 16, 9, 2,
-	118, 15, 3, 2, 2, 2, 119, 120, 8, 9, 1, 2, 120, 122, 7, 23, 2, 2, 121,
-	123, 5, 18, 10, 2, 122, 121, 3, 2, 2, 2, 122, 123, 3, 2, 2, 2, 123, 17,
-	3, 2, 2, 2, 124, 125, 8, 10, 1, 2, 125, 126, 7, 26, 2, 2, 126, 127, 7,
-	26, 2, 2, 127, 19, 3, 2, 2, 2, 128, 129, 8, 11, 1, 2, 129, 130, 7, 26,
-	2, 2, 130, 132, 7, 4, 2, 2, 131, 133, 5, 74, 38, 2, 132, 131, 3, 2, 2,
-	2, 133, 134, 3, 2, 2, 2, 134, 132, 3, 2, 2, 2, 134, 135, 3, 2, 2, 2, 135,
-	136, 3, 2, 2, 2, 136, 137, 7, 5, 2, 2, 137, 21, 3, 2, 2, 2, 138, 141, 5,
-	24, 13, 2, 139, 141, 5, 26, 14, 2, 140, 138, 3, 2, 2, 2, 140, 139, 3, 2,
-	2, 2, 141, 23, 3, 2, 2, 2, 142, 143, 8, 13, 1, 2, 143, 144, 7, 26, 2, 2,
-	144, 145, 5, 28, 15, 2, 145, 25, 3, 2, 2, 2, 146, 147, 8, 14, 1, 2, 147,
-	148, 7, 26, 2, 2, 148, 152, 7, 4, 2, 2, 149, 151, 5, 30, 16, 2, 150, 149,
-	3, 2, 2, 2, 151, 154, 3, 2, 2, 2, 152, 150, 3, 2, 2, 2, 152, 153, 3, 2,
-	2, 2, 153, 155, 3, 2, 2, 2, 154, 152, 3, 2, 2, 2, 155, 156, 7, 5, 2, 2,
-	156, 27, 3, 2, 2, 2, 157, 160, 5, 32, 17, 2, 158, 160, 5, 34, 18, 2, 159,
-	157, 3, 2, 2, 2, 159, 158, 3, 2, 2, 2, 160, 29, 3, 2, 2, 2, 161, 164, 5,
-	36, 19, 2, 162, 164, 5, 38, 20, 2, 163, 161, 3, 2, 2, 2, 163, 162, 3, 2,
-	2, 2, 164, 31, 3, 2, 2, 2, 165, 166, 8, 17, 1, 2, 166, 168, 7, 26, 2, 2,
-	167, 169, 7, 26, 2, 2, 168, 167, 3, 2, 2, 2, 168, 169, 3, 2, 2, 2, 169,
-	170, 3, 2, 2, 2, 170, 174, 7, 6, 2, 2, 171, 173, 5, 40, 21, 2, 172, 171,
-	3, 2, 2, 2, 173, 176, 3, 2, 2, 2, 174, 172, 3, 2, 2, 2, 174, 175, 3, 2,
-	2, 2, 175, 177, 3, 2, 2, 2, 176, 174, 3, 2, 2, 2, 177, 178, 7, 7, 2, 2,
-	178, 33, 3, 2, 2, 2, 179, 180, 8, 18, 1, 2, 180, 182, 7, 26, 2, 2, 181,
-	183, 7, 3, 2, 2, 182, 181, 3, 2, 2, 2, 182, 183, 3, 2, 2, 2, 183, 184,
-	3, 2, 2, 2, 184, 185, 5, 46, 24, 2, 185, 35, 3, 2, 2, 2, 186, 187, 8, 19,
-	1, 2, 187, 189, 7, 26, 2, 2, 188, 190, 7, 26, 2, 2, 189, 188, 3, 2, 2,
-	2, 189, 190, 3, 2, 2, 2, 190, 191, 3, 2, 2, 2, 191, 195, 7, 6, 2, 2, 192,
-	194, 5, 40, 21, 2, 193, 192, 3, 2, 2, 2, 194, 197, 3, 2, 2, 2, 195, 193,
-	3, 2, 2, 2, 195, 196, 3, 2, 2, 2, 196, 198, 3, 2, 2, 2, 197, 195, 3, 2,
-	2, 2, 198, 199, 7, 7, 2, 2, 199, 37, 3, 2, 2, 2, 200, 201, 8, 20, 1, 2,
-	201, 203, 7, 26, 2, 2, 202, 204, 7, 3, 2, 2, 203, 202, 3, 2, 2, 2, 203,
-	204, 3, 2, 2, 2, 204, 205, 3, 2, 2, 2, 205, 206, 5, 46, 24, 2, 206, 39,
-	3, 2, 2, 2, 207, 208, 6, 21, 2, 2, 208, 211, 5, 42, 22, 2, 209, 211, 5,
-	44, 23, 2, 210, 207, 3, 2, 2, 2, 210, 209, 3, 2, 2, 2, 211, 41, 3, 2, 2,
-	2, 212, 213, 8, 22, 1, 2, 213, 214, 7, 26, 2, 2, 214, 216, 5, 46, 24, 2,
-	215, 217, 7, 24, 2, 2, 216, 215, 3, 2, 2, 2, 216, 217, 3, 2, 2, 2, 217,
-	43, 3, 2, 2, 2, 218, 220, 7, 8, 2, 2, 219, 218, 3, 2, 2, 2, 219, 220, 3,
-	2, 2, 2, 220, 222, 3, 2, 2, 2, 221, 223, 5, 50, 26, 2, 222, 221, 3, 2,
-	2, 2, 222, 223, 3, 2, 2, 2, 223, 224, 3, 2, 2, 2, 224, 225, 7, 26, 2, 2,
-	225, 45, 3, 2, 2, 2, 226, 228, 5, 50, 26, 2, 227, 226, 3, 2, 2, 2, 227,
-	228, 3, 2, 2, 2, 228, 229, 3, 2, 2, 2, 229, 230, 8, 24, 1, 2, 230, 238,
-	7, 26, 2, 2, 231, 238, 5, 52, 27, 2, 232, 238, 5, 54, 28, 2, 233, 238,
-	7, 18, 2, 2, 234, 238, 7, 9, 2, 2, 235, 238, 5, 48, 25, 2, 236, 238, 5,
-	32, 17, 2, 237, 227, 3, 2, 2, 2, 237, 231, 3, 2, 2, 2, 237, 232, 3, 2,
-	2, 2, 237, 233, 3, 2, 2, 2, 237, 234, 3, 2, 2, 2, 237, 235, 3, 2, 2, 2,
-	237, 236, 3, 2, 2, 2, 238, 47, 3, 2, 2, 2, 239, 241, 7, 8, 2, 2, 240, 242,
-	5, 50, 26, 2, 241, 240, 3, 2, 2, 2, 241, 242, 3, 2, 2, 2, 242, 243, 3,
-	2, 2, 2, 243, 244, 8, 25, 1, 2, 244, 245, 7, 26, 2, 2, 245, 49, 3, 2, 2,
-	2, 246, 247, 7, 26, 2, 2, 247, 248, 7, 10, 2, 2, 248, 51, 3, 2, 2, 2, 249,
-	250, 8, 27, 1, 2, 250, 251, 7, 26, 2, 2, 251, 252, 7, 11, 2, 2, 252, 253,
-	8, 27, 1, 2, 253, 254, 7, 26, 2, 2, 254, 255, 7, 12, 2, 2, 255, 256, 5,
-	46, 24, 2, 256, 53, 3, 2, 2, 2, 257, 258, 7, 11, 2, 2, 258, 259, 7, 12,
-	2, 2, 259, 260, 5, 46, 24, 2, 260, 55, 3, 2, 2, 2, 261, 263, 5, 58, 30,
-	2, 262, 261, 3, 2, 2, 2, 262, 263, 3, 2, 2, 2, 263, 264, 3, 2, 2, 2, 264,
-	265, 5, 60, 31, 2, 265, 57, 3, 2, 2, 2, 266, 267, 7, 19, 2, 2, 267, 269,
-	7, 4, 2, 2, 268, 270, 5, 74, 38, 2, 269, 268, 3, 2, 2, 2, 270, 271, 3,
-	2, 2, 2, 271, 269, 3, 2, 2, 2, 271, 272, 3, 2, 2, 2, 272, 273, 3, 2, 2,
-	2, 273, 274, 7, 5, 2, 2, 274, 59, 3, 2, 2, 2, 275, 276, 8, 31, 1, 2, 276,
-	277, 7, 26, 2, 2, 277, 278, 5, 76, 39, 2, 278, 282, 7, 6, 2, 2, 279, 281,
-	5, 62, 32, 2, 280, 279, 3, 2, 2, 2, 281, 284, 3, 2, 2, 2, 282, 280, 3,
-	2, 2, 2, 282, 283, 3, 2, 2, 2, 283, 285, 3, 2, 2, 2, 284, 282, 3, 2, 2,
-	2, 285, 286, 7, 7, 2, 2, 286, 61, 3, 2, 2, 2, 287, 289, 5, 64, 33, 2, 288,
-	287, 3, 2, 2, 2, 288, 289, 3, 2, 2, 2, 289, 292, 3, 2, 2, 2, 290, 293,
-	5, 58, 30, 2, 291, 293, 5, 66, 34, 2, 292, 290, 3, 2, 2, 2, 292, 291, 3,
-	2, 2, 2, 293, 294, 3, 2, 2, 2, 294, 295, 5, 68, 35, 2, 295, 63, 3, 2, 2,
-	2, 296, 298, 7, 16, 2, 2, 297, 299, 7, 4, 2, 2, 298, 297, 3, 2, 2, 2, 298,
-	299, 3, 2, 2, 2, 299, 306, 3, 2, 2, 2, 300, 302, 5, 74, 38, 2, 301, 300,
-	3, 2, 2, 2, 302, 303, 3, 2, 2, 2, 303, 301, 3, 2, 2, 2, 303, 304, 3, 2,
-	2, 2, 304, 307, 3, 2, 2, 2, 305, 307, 7, 23, 2, 2, 306, 301, 3, 2, 2, 2,
-	306, 305, 3, 2, 2, 2, 307, 309, 3, 2, 2, 2, 308, 310, 7, 5, 2, 2, 309,
-	308, 3, 2, 2, 2, 309, 310, 3, 2, 2, 2, 310, 65, 3, 2, 2, 2, 311, 312, 7,
-	17, 2, 2, 312, 313, 7, 26, 2, 2, 313, 67, 3, 2, 2, 2, 314, 315, 8, 35,
-	1, 2, 315, 316, 7, 26, 2, 2, 316, 318, 5, 78, 40, 2, 317, 319, 5, 70, 36,
-	2, 318, 317, 3, 2, 2, 2, 318, 319, 3, 2, 2, 2, 319, 321, 3, 2, 2, 2, 320,
-	322, 7, 26, 2, 2, 321, 320, 3, 2, 2, 2, 321, 322, 3, 2, 2, 2, 322, 324,
-	3, 2, 2, 2, 323, 325, 5, 72, 37, 2, 324, 323, 3, 2, 2, 2, 324, 325, 3,
-	2, 2, 2, 325, 69, 3, 2, 2, 2, 326, 328, 7, 4, 2, 2, 327, 329, 7, 26, 2,
-	2, 328, 327, 3, 2, 2, 2, 328, 329, 3, 2, 2, 2, 329, 330, 3, 2, 2, 2, 330,
-	331, 7, 5, 2, 2, 331, 71, 3, 2, 2, 2, 332, 334, 7, 4, 2, 2, 333, 335, 5,
-	46, 24, 2, 334, 333, 3, 2, 2, 2, 334, 335, 3, 2, 2, 2, 335, 336, 3, 2,
-	2, 2, 336, 337, 7, 5, 2, 2, 337, 73, 3, 2, 2, 2, 338, 339, 7, 26, 2, 2,
-	339, 340, 8, 38, 1, 2, 340, 341, 7, 25, 2, 2, 341, 75, 3, 2, 2, 2, 342,
-	344, 7, 26, 2, 2, 343, 345, 7, 13, 2, 2, 344, 343, 3, 2, 2, 2, 344, 345,
-	3, 2, 2, 2, 345, 347, 3, 2, 2, 2, 346, 342, 3, 2, 2, 2, 347, 348, 3, 2,
-	2, 2, 348, 346, 3, 2, 2, 2, 348, 349, 3, 2, 2, 2, 349, 77, 3, 2, 2, 2,
-	350, 351, 7, 14, 2, 2, 351, 356, 7, 26, 2, 2, 352, 353, 7, 13, 2, 2, 353,
-	355, 7, 26, 2, 2, 354, 352, 3, 2, 2, 2, 355, 358, 3, 2, 2, 2, 356, 354,
-	3, 2, 2, 2, 356, 357, 3, 2, 2, 2, 357, 366, 3, 2, 2, 2, 358, 356, 3, 2,
-	2, 2, 359, 360, 7, 15, 2, 2, 360, 363, 7, 26, 2, 2, 361, 362, 7, 13, 2,
-	2, 362, 364, 7, 26, 2, 2, 363, 361, 3, 2, 2, 2, 363, 364, 3, 2, 2, 2, 364,
-	366, 3, 2, 2, 2, 365, 350, 3, 2, 2, 2, 365, 359, 3, 2, 2, 2, 366, 367,
-	3, 2, 2, 2, 367, 365, 3, 2, 2, 2, 367, 368, 3, 2, 2, 2, 368, 79, 3, 2,
-	2, 2, 45, 83, 91, 101, 113, 122, 134, 140, 152, 159, 163, 168, 174, 182,
-	189, 195, 203, 210, 216, 219, 222, 227, 237, 241, 262, 271, 282, 288, 292,
-	298, 303, 306, 309, 318, 321, 324, 328, 334, 344, 348, 356, 363, 365, 367,
+	39, 4, 40, 9, 40, 4, 41, 9, 41, 4, 42, 9, 42, 3, 2, 7, 2, 86, 10, 2, 12,
+	2, 14, 2, 89, 11, 2, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 5, 3, 97, 10,
+	3, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 5, 3, 5, 5, 5, 107, 10, 5, 3,
+	6, 3, 6, 3, 6, 3, 6, 3, 7, 3, 7, 3, 7, 3, 7, 6, 7, 117, 10, 7, 13, 7, 14,
+	7, 118, 3, 7, 3, 7, 3, 8, 3, 8, 3, 9, 3, 9, 3, 9, 5, 9, 128, 10, 9, 3,
+	10, 3, 10, 3, 10, 3, 10, 3, 11, 3, 11, 3, 11, 3, 11, 6, 11, 138, 10, 11,
+	13, 11, 14, 11, 139, 3, 11, 3, 11, 3, 12, 3, 12, 5, 12, 146, 10, 12, 3,
+	13, 3, 13, 3, 13, 3, 13, 3, 14, 3, 14, 3, 14, 3, 14, 7, 14, 156, 10, 14,
+	12, 14, 14, 14, 159, 11, 14, 3, 14, 3, 14, 3, 15, 3, 15, 5, 15, 165, 10,
+	15, 3, 16, 3, 16, 5, 16, 169, 10, 16, 3, 17, 3, 17, 3, 17, 5, 17, 174,
+	10, 17, 3, 17, 3, 17, 7, 17, 178, 10, 17, 12, 17, 14, 17, 181, 11, 17,
+	3, 17, 3, 17, 3, 18, 3, 18, 3, 18, 5, 18, 188, 10, 18, 3, 18, 3, 18, 3,
+	19, 3, 19, 3, 19, 5, 19, 195, 10, 19, 3, 19, 3, 19, 7, 19, 199, 10, 19,
+	12, 19, 14, 19, 202, 11, 19, 3, 19, 3, 19, 3, 20, 3, 20, 3, 20, 5, 20,
+	209, 10, 20, 3, 20, 3, 20, 3, 21, 3, 21, 3, 21, 5, 21, 216, 10, 21, 3,
+	22, 3, 22, 3, 22, 3, 22, 5, 22, 222, 10, 22, 3, 23, 5, 23, 225, 10, 23,
+	3, 23, 5, 23, 228, 10, 23, 3, 23, 3, 23, 3, 24, 5, 24, 233, 10, 24, 3,
+	24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 5, 24, 243, 10, 24,
+	3, 25, 3, 25, 5, 25, 247, 10, 25, 3, 25, 3, 25, 3, 25, 3, 26, 3, 26, 3,
+	26, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 28, 3, 28,
+	3, 28, 3, 28, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 6, 29, 273, 10,
+	29, 13, 29, 14, 29, 274, 3, 29, 3, 29, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30,
+	5, 30, 284, 10, 30, 3, 31, 5, 31, 287, 10, 31, 3, 31, 3, 31, 3, 32, 3,
+	32, 3, 32, 6, 32, 294, 10, 32, 13, 32, 14, 32, 295, 3, 32, 3, 32, 3, 33,
+	3, 33, 3, 33, 3, 33, 3, 33, 7, 33, 305, 10, 33, 12, 33, 14, 33, 308, 11,
+	33, 3, 33, 3, 33, 3, 34, 5, 34, 313, 10, 34, 3, 34, 3, 34, 5, 34, 317,
+	10, 34, 3, 34, 3, 34, 3, 35, 3, 35, 5, 35, 323, 10, 35, 3, 35, 6, 35, 326,
+	10, 35, 13, 35, 14, 35, 327, 3, 35, 5, 35, 331, 10, 35, 3, 35, 5, 35, 334,
+	10, 35, 3, 36, 3, 36, 3, 36, 3, 37, 3, 37, 3, 37, 3, 37, 5, 37, 343, 10,
+	37, 3, 37, 5, 37, 346, 10, 37, 3, 37, 5, 37, 349, 10, 37, 3, 38, 3, 38,
+	5, 38, 353, 10, 38, 3, 38, 3, 38, 3, 39, 3, 39, 5, 39, 359, 10, 39, 3,
+	39, 3, 39, 3, 40, 3, 40, 3, 40, 3, 40, 3, 41, 3, 41, 5, 41, 369, 10, 41,
+	6, 41, 371, 10, 41, 13, 41, 14, 41, 372, 3, 42, 3, 42, 3, 42, 3, 42, 7,
+	42, 379, 10, 42, 12, 42, 14, 42, 382, 11, 42, 3, 42, 3, 42, 3, 42, 3, 42,
+	5, 42, 388, 10, 42, 6, 42, 390, 10, 42, 13, 42, 14, 42, 391, 3, 42, 2,
+	2, 43, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34,
+	36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66, 68, 70,
+	72, 74, 76, 78, 80, 82, 2, 3, 4, 2, 23, 23, 27, 27, 2, 406, 2, 87, 3, 2,
+	2, 2, 4, 96, 3, 2, 2, 2, 6, 98, 3, 2, 2, 2, 8, 106, 3, 2, 2, 2, 10, 108,
+	3, 2, 2, 2, 12, 112, 3, 2, 2, 2, 14, 122, 3, 2, 2, 2, 16, 124, 3, 2, 2,
+	2, 18, 129, 3, 2, 2, 2, 20, 133, 3, 2, 2, 2, 22, 145, 3, 2, 2, 2, 24, 147,
+	3, 2, 2, 2, 26, 151, 3, 2, 2, 2, 28, 164, 3, 2, 2, 2, 30, 168, 3, 2, 2,
+	2, 32, 170, 3, 2, 2, 2, 34, 184, 3, 2, 2, 2, 36, 191, 3, 2, 2, 2, 38, 205,
+	3, 2, 2, 2, 40, 215, 3, 2, 2, 2, 42, 217, 3, 2, 2, 2, 44, 224, 3, 2, 2,
+	2, 46, 242, 3, 2, 2, 2, 48, 244, 3, 2, 2, 2, 50, 251, 3, 2, 2, 2, 52, 254,
+	3, 2, 2, 2, 54, 262, 3, 2, 2, 2, 56, 266, 3, 2, 2, 2, 58, 278, 3, 2, 2,
+	2, 60, 286, 3, 2, 2, 2, 62, 290, 3, 2, 2, 2, 64, 299, 3, 2, 2, 2, 66, 312,
+	3, 2, 2, 2, 68, 320, 3, 2, 2, 2, 70, 335, 3, 2, 2, 2, 72, 338, 3, 2, 2,
+	2, 74, 350, 3, 2, 2, 2, 76, 356, 3, 2, 2, 2, 78, 362, 3, 2, 2, 2, 80, 370,
+	3, 2, 2, 2, 82, 389, 3, 2, 2, 2, 84, 86, 5, 4, 3, 2, 85, 84, 3, 2, 2, 2,
+	86, 89, 3, 2, 2, 2, 87, 85, 3, 2, 2, 2, 87, 88, 3, 2, 2, 2, 88, 3, 3, 2,
+	2, 2, 89, 87, 3, 2, 2, 2, 90, 97, 5, 6, 4, 2, 91, 97, 5, 8, 5, 2, 92, 97,
+	5, 20, 11, 2, 93, 97, 5, 22, 12, 2, 94, 97, 5, 60, 31, 2, 95, 97, 5, 56,
+	29, 2, 96, 90, 3, 2, 2, 2, 96, 91, 3, 2, 2, 2, 96, 92, 3, 2, 2, 2, 96,
+	93, 3, 2, 2, 2, 96, 94, 3, 2, 2, 2, 96, 95, 3, 2, 2, 2, 97, 5, 3, 2, 2,
+	2, 98, 99, 8, 4, 1, 2, 99, 100, 7, 26, 2, 2, 100, 101, 7, 3, 2, 2, 101,
+	102, 8, 4, 1, 2, 102, 103, 7, 23, 2, 2, 103, 7, 3, 2, 2, 2, 104, 107, 5,
+	10, 6, 2, 105, 107, 5, 12, 7, 2, 106, 104, 3, 2, 2, 2, 106, 105, 3, 2,
+	2, 2, 107, 9, 3, 2, 2, 2, 108, 109, 8, 6, 1, 2, 109, 110, 7, 26, 2, 2,
+	110, 111, 5, 16, 9, 2, 111, 11, 3, 2, 2, 2, 112, 113, 8, 7, 1, 2, 113,
+	114, 7, 26, 2, 2, 114, 116, 7, 4, 2, 2, 115, 117, 5, 14, 8, 2, 116, 115,
+	3, 2, 2, 2, 117, 118, 3, 2, 2, 2, 118, 116, 3, 2, 2, 2, 118, 119, 3, 2,
+	2, 2, 119, 120, 3, 2, 2, 2, 120, 121, 7, 5, 2, 2, 121, 13, 3, 2, 2, 2,
+	122, 123, 5, 16, 9, 2, 123, 15, 3, 2, 2, 2, 124, 125, 8, 9, 1, 2, 125,
+	127, 7, 23, 2, 2, 126, 128, 5, 18, 10, 2, 127, 126, 3, 2, 2, 2, 127, 128,
+	3, 2, 2, 2, 128, 17, 3, 2, 2, 2, 129, 130, 8, 10, 1, 2, 130, 131, 7, 26,
+	2, 2, 131, 132, 7, 26, 2, 2, 132, 19, 3, 2, 2, 2, 133, 134, 8, 11, 1, 2,
+	134, 135, 7, 26, 2, 2, 135, 137, 7, 4, 2, 2, 136, 138, 5, 78, 40, 2, 137,
+	136, 3, 2, 2, 2, 138, 139, 3, 2, 2, 2, 139, 137, 3, 2, 2, 2, 139, 140,
+	3, 2, 2, 2, 140, 141, 3, 2, 2, 2, 141, 142, 7, 5, 2, 2, 142, 21, 3, 2,
+	2, 2, 143, 146, 5, 24, 13, 2, 144, 146, 5, 26, 14, 2, 145, 143, 3, 2, 2,
+	2, 145, 144, 3, 2, 2, 2, 146, 23, 3, 2, 2, 2, 147, 148, 8, 13, 1, 2, 148,
+	149, 7, 26, 2, 2, 149, 150, 5, 28, 15, 2, 150, 25, 3, 2, 2, 2, 151, 152,
+	8, 14, 1, 2, 152, 153, 7, 26, 2, 2, 153, 157, 7, 4, 2, 2, 154, 156, 5,
+	30, 16, 2, 155, 154, 3, 2, 2, 2, 156, 159, 3, 2, 2, 2, 157, 155, 3, 2,
+	2, 2, 157, 158, 3, 2, 2, 2, 158, 160, 3, 2, 2, 2, 159, 157, 3, 2, 2, 2,
+	160, 161, 7, 5, 2, 2, 161, 27, 3, 2, 2, 2, 162, 165, 5, 32, 17, 2, 163,
+	165, 5, 34, 18, 2, 164, 162, 3, 2, 2, 2, 164, 163, 3, 2, 2, 2, 165, 29,
+	3, 2, 2, 2, 166, 169, 5, 36, 19, 2, 167, 169, 5, 38, 20, 2, 168, 166, 3,
+	2, 2, 2, 168, 167, 3, 2, 2, 2, 169, 31, 3, 2, 2, 2, 170, 171, 8, 17, 1,
+	2, 171, 173, 7, 26, 2, 2, 172, 174, 7, 26, 2, 2, 173, 172, 3, 2, 2, 2,
+	173, 174, 3, 2, 2, 2, 174, 175, 3, 2, 2, 2, 175, 179, 7, 6, 2, 2, 176,
+	178, 5, 40, 21, 2, 177, 176, 3, 2, 2, 2, 178, 181, 3, 2, 2, 2, 179, 177,
+	3, 2, 2, 2, 179, 180, 3, 2, 2, 2, 180, 182, 3, 2, 2, 2, 181, 179, 3, 2,
+	2, 2, 182, 183, 7, 7, 2, 2, 183, 33, 3, 2, 2, 2, 184, 185, 8, 18, 1, 2,
+	185, 187, 7, 26, 2, 2, 186, 188, 7, 3, 2, 2, 187, 186, 3, 2, 2, 2, 187,
+	188, 3, 2, 2, 2, 188, 189, 3, 2, 2, 2, 189, 190, 5, 46, 24, 2, 190, 35,
+	3, 2, 2, 2, 191, 192, 8, 19, 1, 2, 192, 194, 7, 26, 2, 2, 193, 195, 7,
+	26, 2, 2, 194, 193, 3, 2, 2, 2, 194, 195, 3, 2, 2, 2, 195, 196, 3, 2, 2,
+	2, 196, 200, 7, 6, 2, 2, 197, 199, 5, 40, 21, 2, 198, 197, 3, 2, 2, 2,
+	199, 202, 3, 2, 2, 2, 200, 198, 3, 2, 2, 2, 200, 201, 3, 2, 2, 2, 201,
+	203, 3, 2, 2, 2, 202, 200, 3, 2, 2, 2, 203, 204, 7, 7, 2, 2, 204, 37, 3,
+	2, 2, 2, 205, 206, 8, 20, 1, 2, 206, 208, 7, 26, 2, 2, 207, 209, 7, 3,
+	2, 2, 208, 207, 3, 2, 2, 2, 208, 209, 3, 2, 2, 2, 209, 210, 3, 2, 2, 2,
+	210, 211, 5, 46, 24, 2, 211, 39, 3, 2, 2, 2, 212, 213, 6, 21, 2, 2, 213,
+	216, 5, 42, 22, 2, 214, 216, 5, 44, 23, 2, 215, 212, 3, 2, 2, 2, 215, 214,
+	3, 2, 2, 2, 216, 41, 3, 2, 2, 2, 217, 218, 8, 22, 1, 2, 218, 219, 7, 26,
+	2, 2, 219, 221, 5, 46, 24, 2, 220, 222, 7, 24, 2, 2, 221, 220, 3, 2, 2,
+	2, 221, 222, 3, 2, 2, 2, 222, 43, 3, 2, 2, 2, 223, 225, 7, 8, 2, 2, 224,
+	223, 3, 2, 2, 2, 224, 225, 3, 2, 2, 2, 225, 227, 3, 2, 2, 2, 226, 228,
+	5, 50, 26, 2, 227, 226, 3, 2, 2, 2, 227, 228, 3, 2, 2, 2, 228, 229, 3,
+	2, 2, 2, 229, 230, 7, 26, 2, 2, 230, 45, 3, 2, 2, 2, 231, 233, 5, 50, 26,
+	2, 232, 231, 3, 2, 2, 2, 232, 233, 3, 2, 2, 2, 233, 234, 3, 2, 2, 2, 234,
+	235, 8, 24, 1, 2, 235, 243, 7, 26, 2, 2, 236, 243, 5, 52, 27, 2, 237, 243,
+	5, 54, 28, 2, 238, 243, 7, 18, 2, 2, 239, 243, 7, 9, 2, 2, 240, 243, 5,
+	48, 25, 2, 241, 243, 5, 32, 17, 2, 242, 232, 3, 2, 2, 2, 242, 236, 3, 2,
+	2, 2, 242, 237, 3, 2, 2, 2, 242, 238, 3, 2, 2, 2, 242, 239, 3, 2, 2, 2,
+	242, 240, 3, 2, 2, 2, 242, 241, 3, 2, 2, 2, 243, 47, 3, 2, 2, 2, 244, 246,
+	7, 8, 2, 2, 245, 247, 5, 50, 26, 2, 246, 245, 3, 2, 2, 2, 246, 247, 3,
+	2, 2, 2, 247, 248, 3, 2, 2, 2, 248, 249, 8, 25, 1, 2, 249, 250, 7, 26,
+	2, 2, 250, 49, 3, 2, 2, 2, 251, 252, 7, 26, 2, 2, 252, 253, 7, 10, 2, 2,
+	253, 51, 3, 2, 2, 2, 254, 255, 8, 27, 1, 2, 255, 256, 7, 26, 2, 2, 256,
+	257, 7, 11, 2, 2, 257, 258, 8, 27, 1, 2, 258, 259, 7, 26, 2, 2, 259, 260,
+	7, 12, 2, 2, 260, 261, 5, 46, 24, 2, 261, 53, 3, 2, 2, 2, 262, 263, 7,
+	11, 2, 2, 263, 264, 7, 12, 2, 2, 264, 265, 5, 46, 24, 2, 265, 55, 3, 2,
+	2, 2, 266, 267, 8, 29, 1, 2, 267, 268, 7, 26, 2, 2, 268, 269, 8, 29, 1,
+	2, 269, 270, 7, 26, 2, 2, 270, 272, 7, 6, 2, 2, 271, 273, 5, 58, 30, 2,
+	272, 271, 3, 2, 2, 2, 273, 274, 3, 2, 2, 2, 274, 272, 3, 2, 2, 2, 274,
+	275, 3, 2, 2, 2, 275, 276, 3, 2, 2, 2, 276, 277, 7, 7, 2, 2, 277, 57, 3,
+	2, 2, 2, 278, 279, 8, 30, 1, 2, 279, 280, 7, 26, 2, 2, 280, 281, 7, 3,
+	2, 2, 281, 283, 9, 2, 2, 2, 282, 284, 7, 28, 2, 2, 283, 282, 3, 2, 2, 2,
+	283, 284, 3, 2, 2, 2, 284, 59, 3, 2, 2, 2, 285, 287, 5, 62, 32, 2, 286,
+	285, 3, 2, 2, 2, 286, 287, 3, 2, 2, 2, 287, 288, 3, 2, 2, 2, 288, 289,
+	5, 64, 33, 2, 289, 61, 3, 2, 2, 2, 290, 291, 7, 19, 2, 2, 291, 293, 7,
+	4, 2, 2, 292, 294, 5, 78, 40, 2, 293, 292, 3, 2, 2, 2, 294, 295, 3, 2,
+	2, 2, 295, 293, 3, 2, 2, 2, 295, 296, 3, 2, 2, 2, 296, 297, 3, 2, 2, 2,
+	297, 298, 7, 5, 2, 2, 298, 63, 3, 2, 2, 2, 299, 300, 8, 33, 1, 2, 300,
+	301, 7, 26, 2, 2, 301, 302, 5, 80, 41, 2, 302, 306, 7, 6, 2, 2, 303, 305,
+	5, 66, 34, 2, 304, 303, 3, 2, 2, 2, 305, 308, 3, 2, 2, 2, 306, 304, 3,
+	2, 2, 2, 306, 307, 3, 2, 2, 2, 307, 309, 3, 2, 2, 2, 308, 306, 3, 2, 2,
+	2, 309, 310, 7, 7, 2, 2, 310, 65, 3, 2, 2, 2, 311, 313, 5, 68, 35, 2, 312,
+	311, 3, 2, 2, 2, 312, 313, 3, 2, 2, 2, 313, 316, 3, 2, 2, 2, 314, 317,
+	5, 62, 32, 2, 315, 317, 5, 70, 36, 2, 316, 314, 3, 2, 2, 2, 316, 315, 3,
+	2, 2, 2, 317, 318, 3, 2, 2, 2, 318, 319, 5, 72, 37, 2, 319, 67, 3, 2, 2,
+	2, 320, 322, 7, 16, 2, 2, 321, 323, 7, 4, 2, 2, 322, 321, 3, 2, 2, 2, 322,
+	323, 3, 2, 2, 2, 323, 330, 3, 2, 2, 2, 324, 326, 5, 78, 40, 2, 325, 324,
+	3, 2, 2, 2, 326, 327, 3, 2, 2, 2, 327, 325, 3, 2, 2, 2, 327, 328, 3, 2,
+	2, 2, 328, 331, 3, 2, 2, 2, 329, 331, 7, 23, 2, 2, 330, 325, 3, 2, 2, 2,
+	330, 329, 3, 2, 2, 2, 331, 333, 3, 2, 2, 2, 332, 334, 7, 5, 2, 2, 333,
+	332, 3, 2, 2, 2, 333, 334, 3, 2, 2, 2, 334, 69, 3, 2, 2, 2, 335, 336, 7,
+	17, 2, 2, 336, 337, 7, 26, 2, 2, 337, 71, 3, 2, 2, 2, 338, 339, 8, 37,
+	1, 2, 339, 340, 7, 26, 2, 2, 340, 342, 5, 82, 42, 2, 341, 343, 5, 74, 38,
+	2, 342, 341, 3, 2, 2, 2, 342, 343, 3, 2, 2, 2, 343, 345, 3, 2, 2, 2, 344,
+	346, 7, 26, 2, 2, 345, 344, 3, 2, 2, 2, 345, 346, 3, 2, 2, 2, 346, 348,
+	3, 2, 2, 2, 347, 349, 5, 76, 39, 2, 348, 347, 3, 2, 2, 2, 348, 349, 3,
+	2, 2, 2, 349, 73, 3, 2, 2, 2, 350, 352, 7, 4, 2, 2, 351, 353, 7, 26, 2,
+	2, 352, 351, 3, 2, 2, 2, 352, 353, 3, 2, 2, 2, 353, 354, 3, 2, 2, 2, 354,
+	355, 7, 5, 2, 2, 355, 75, 3, 2, 2, 2, 356, 358, 7, 4, 2, 2, 357, 359, 5,
+	46, 24, 2, 358, 357, 3, 2, 2, 2, 358, 359, 3, 2, 2, 2, 359, 360, 3, 2,
+	2, 2, 360, 361, 7, 5, 2, 2, 361, 77, 3, 2, 2, 2, 362, 363, 7, 26, 2, 2,
+	363, 364, 8, 40, 1, 2, 364, 365, 7, 25, 2, 2, 365, 79, 3, 2, 2, 2, 366,
+	368, 7, 26, 2, 2, 367, 369, 7, 13, 2, 2, 368, 367, 3, 2, 2, 2, 368, 369,
+	3, 2, 2, 2, 369, 371, 3, 2, 2, 2, 370, 366, 3, 2, 2, 2, 371, 372, 3, 2,
+	2, 2, 372, 370, 3, 2, 2, 2, 372, 373, 3, 2, 2, 2, 373, 81, 3, 2, 2, 2,
+	374, 375, 7, 14, 2, 2, 375, 380, 7, 26, 2, 2, 376, 377, 7, 13, 2, 2, 377,
+	379, 7, 26, 2, 2, 378, 376, 3, 2, 2, 2, 379, 382, 3, 2, 2, 2, 380, 378,
+	3, 2, 2, 2, 380, 381, 3, 2, 2, 2, 381, 390, 3, 2, 2, 2, 382, 380, 3, 2,
+	2, 2, 383, 384, 7, 15, 2, 2, 384, 387, 7, 26, 2, 2, 385, 386, 7, 13, 2,
+	2, 386, 388, 7, 26, 2, 2, 387, 385, 3, 2, 2, 2, 387, 388, 3, 2, 2, 2, 388,
+	390, 3, 2, 2, 2, 389, 374, 3, 2, 2, 2, 389, 383, 3, 2, 2, 2, 390, 391,
+	3, 2, 2, 2, 391, 389, 3, 2, 2, 2, 391, 392, 3, 2, 2, 2, 392, 83, 3, 2,
+	2, 2, 47, 87, 96, 106, 118, 127, 139, 145, 157, 164, 168, 173, 179, 187,
+	194, 200, 208, 215, 221, 224, 227, 232, 242, 246, 274, 283, 286, 295, 306,
+	312, 316, 322, 327, 330, 333, 342, 345, 348, 352, 358, 368, 372, 380, 387,
+	389, 391,
 }
 var literalNames = []string{
 	"", "'='", "'('", "')'", "'{'", "'}'", "'*'", "'time.Time'", "'.'", "'['",
 	"']'", "'-'", "'/'", "'/:'", "'@doc'", "'@handler'", "'interface{}'", "'@server'",
+	"", "", "", "", "", "", "", "", "';'",
 }
 var symbolicNames = []string{
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "ATDOC", "ATHANDLER",
 	"INTERFACE", "ATSERVER", "WS", "COMMENT", "LINE_COMMENT", "STRING", "RAW_STRING",
-	"LINE_VALUE", "ID",
+	"LINE_VALUE", "ID", "INT", "SEMICOLON",
 }
 
 var ruleNames = []string{
@@ -192,9 +205,9 @@ var ruleNames = []string{
 	"importValue", "importPackage", "infoSpec", "typeSpec", "typeLit", "typeBlock",
 	"typeLitBody", "typeBlockBody", "typeStruct", "typeAlias", "typeBlockStruct",
 	"typeBlockAlias", "field", "normalField", "anonymousFiled", "dataType",
-	"pointerType", "packageExpr", "mapType", "arrayType", "serviceSpec", "atServer",
-	"serviceApi", "serviceRoute", "atDoc", "atHandler", "route", "body", "replybody",
-	"kvLit", "serviceName", "path",
+	"pointerType", "packageExpr", "mapType", "arrayType", "enumSpec", "enumValue",
+	"serviceSpec", "atServer", "serviceApi", "serviceRoute", "atDoc", "atHandler",
+	"route", "body", "replybody", "kvLit", "serviceName", "path",
 }
 
 type ApiParserParser struct {
@@ -253,6 +266,8 @@ const (
 	ApiParserParserRAW_STRING   = 22
 	ApiParserParserLINE_VALUE   = 23
 	ApiParserParserID           = 24
+	ApiParserParserINT          = 25
+	ApiParserParserSEMICOLON    = 26
 )
 
 // ApiParserParser rules.
@@ -284,18 +299,20 @@ const (
 	ApiParserParserRULE_packageExpr      = 24
 	ApiParserParserRULE_mapType          = 25
 	ApiParserParserRULE_arrayType        = 26
-	ApiParserParserRULE_serviceSpec      = 27
-	ApiParserParserRULE_atServer         = 28
-	ApiParserParserRULE_serviceApi       = 29
-	ApiParserParserRULE_serviceRoute     = 30
-	ApiParserParserRULE_atDoc            = 31
-	ApiParserParserRULE_atHandler        = 32
-	ApiParserParserRULE_route            = 33
-	ApiParserParserRULE_body             = 34
-	ApiParserParserRULE_replybody        = 35
-	ApiParserParserRULE_kvLit            = 36
-	ApiParserParserRULE_serviceName      = 37
-	ApiParserParserRULE_path             = 38
+	ApiParserParserRULE_enumSpec         = 27
+	ApiParserParserRULE_enumValue        = 28
+	ApiParserParserRULE_serviceSpec      = 29
+	ApiParserParserRULE_atServer         = 30
+	ApiParserParserRULE_serviceApi       = 31
+	ApiParserParserRULE_serviceRoute     = 32
+	ApiParserParserRULE_atDoc            = 33
+	ApiParserParserRULE_atHandler        = 34
+	ApiParserParserRULE_route            = 35
+	ApiParserParserRULE_body             = 36
+	ApiParserParserRULE_replybody        = 37
+	ApiParserParserRULE_kvLit            = 38
+	ApiParserParserRULE_serviceName      = 39
+	ApiParserParserRULE_path             = 40
 )
 
 // IApiContext is an interface to support dynamic dispatch.
@@ -399,17 +416,17 @@ func (p *ApiParserParser) Api() (localctx IApiContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(81)
+	p.SetState(85)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == ApiParserParserATSERVER || _la == ApiParserParserID {
 		{
-			p.SetState(78)
+			p.SetState(82)
 			p.Spec()
 		}
 
-		p.SetState(83)
+		p.SetState(87)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -505,6 +522,16 @@ func (s *SpecContext) ServiceSpec() IServiceSpecContext {
 	return t.(IServiceSpecContext)
 }
 
+func (s *SpecContext) EnumSpec() IEnumSpecContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IEnumSpecContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEnumSpecContext)
+}
+
 func (s *SpecContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -543,44 +570,51 @@ func (p *ApiParserParser) Spec() (localctx ISpecContext) {
 		}
 	}()
 
-	p.SetState(89)
+	p.SetState(94)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 1, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(84)
+			p.SetState(88)
 			p.SyntaxLit()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(85)
+			p.SetState(89)
 			p.ImportSpec()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(86)
+			p.SetState(90)
 			p.InfoSpec()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(87)
+			p.SetState(91)
 			p.TypeSpec()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(88)
+			p.SetState(92)
 			p.ServiceSpec()
 		}
 
+	case 6:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(93)
+			p.EnumSpec()
+		}
+
 	}
 
 	return localctx
@@ -706,14 +740,14 @@ func (p *ApiParserParser) SyntaxLit() (localctx ISyntaxLitContext) {
 	p.EnterOuterAlt(localctx, 1)
 	match(p, "syntax")
 	{
-		p.SetState(92)
+		p.SetState(97)
 
 		var _m = p.Match(ApiParserParserID)
 
 		localctx.(*SyntaxLitContext).syntaxToken = _m
 	}
 	{
-		p.SetState(93)
+		p.SetState(98)
 
 		var _m = p.Match(ApiParserParserT__0)
 
@@ -721,7 +755,7 @@ func (p *ApiParserParser) SyntaxLit() (localctx ISyntaxLitContext) {
 	}
 	checkVersion(p)
 	{
-		p.SetState(95)
+		p.SetState(100)
 
 		var _m = p.Match(ApiParserParserSTRING)
 
@@ -827,20 +861,20 @@ func (p *ApiParserParser) ImportSpec() (localctx IImportSpecContext) {
 		}
 	}()
 
-	p.SetState(99)
+	p.SetState(104)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 2, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(97)
+			p.SetState(102)
 			p.ImportLit()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(98)
+			p.SetState(103)
 			p.ImportBlock()
 		}
 
@@ -953,14 +987,14 @@ func (p *ApiParserParser) ImportLit() (localctx IImportLitContext) {
 	p.EnterOuterAlt(localctx, 1)
 	match(p, "import")
 	{
-		p.SetState(102)
+		p.SetState(107)
 
 		var _m = p.Match(ApiParserParserID)
 
 		localctx.(*ImportLitContext).importToken = _m
 	}
 	{
-		p.SetState(103)
+		p.SetState(108)
 		p.ImportValue()
 	}
 
@@ -1085,32 +1119,32 @@ func (p *ApiParserParser) ImportBlock() (localctx IImportBlockContext) {
 	p.EnterOuterAlt(localctx, 1)
 	match(p, "import")
 	{
-		p.SetState(106)
+		p.SetState(111)
 
 		var _m = p.Match(ApiParserParserID)
 
 		localctx.(*ImportBlockContext).importToken = _m
 	}
 	{
-		p.SetState(107)
+		p.SetState(112)
 		p.Match(ApiParserParserT__1)
 	}
-	p.SetState(109)
+	p.SetState(114)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for ok := true; ok; ok = _la == ApiParserParserSTRING {
 		{
-			p.SetState(108)
+			p.SetState(113)
 			p.ImportBlockValue()
 		}
 
-		p.SetState(111)
+		p.SetState(116)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(113)
+		p.SetState(118)
 		p.Match(ApiParserParserT__2)
 	}
 
@@ -1205,7 +1239,7 @@ func (p *ApiParserParser) ImportBlockValue() (localctx IImportBlockValueContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(115)
+		p.SetState(120)
 		p.ImportValue()
 	}
 
@@ -1305,15 +1339,15 @@ func (p *ApiParserParser) ImportValue() (localctx IImportValueContext) {
 	p.EnterOuterAlt(localctx, 1)
 	checkImportValue(p)
 	{
-		p.SetState(118)
+		p.SetState(123)
 		p.Match(ApiParserParserSTRING)
 	}
-	p.SetState(120)
+	p.SetState(125)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 4, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(119)
+			p.SetState(124)
 			p.ImportPackage()
 		}
 
@@ -1431,14 +1465,14 @@ func (p *ApiParserParser) ImportPackage() (localctx IImportPackageContext) {
 	p.EnterOuterAlt(localctx, 1)
 	match(p, "as")
 	{
-		p.SetState(123)
+		p.SetState(128)
 
 		var _m = p.Match(ApiParserParserID)
 
 		localctx.(*ImportPackageContext).asToken = _m
 	}
 	{
-		p.SetState(124)
+		p.SetState(129)
 
 		var _m = p.Match(ApiParserParserID)
 
@@ -1588,35 +1622,35 @@ func (p *ApiParserParser) InfoSpec() (localctx IInfoSpecContext) {
 	p.EnterOuterAlt(localctx, 1)
 	match(p, "info")
 	{
-		p.SetState(127)
+		p.SetState(132)
 
 		var _m = p.Match(ApiParserParserID)
 
 		localctx.(*InfoSpecContext).infoToken = _m
 	}
 	{
-		p.SetState(128)
+		p.SetState(133)
 
 		var _m = p.Match(ApiParserParserT__1)
 
 		localctx.(*InfoSpecContext).lp = _m
 	}
-	p.SetState(130)
+	p.SetState(135)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for ok := true; ok; ok = _la == ApiParserParserID {
 		{
-			p.SetState(129)
+			p.SetState(134)
 			p.KvLit()
 		}
 
-		p.SetState(132)
+		p.SetState(137)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(134)
+		p.SetState(139)
 
 		var _m = p.Match(ApiParserParserT__2)
 
@@ -1722,20 +1756,20 @@ func (p *ApiParserParser) TypeSpec() (localctx ITypeSpecContext) {
 		}
 	}()
 
-	p.SetState(138)
+	p.SetState(143)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 6, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(136)
+			p.SetState(141)
 			p.TypeLit()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(137)
+			p.SetState(142)
 			p.TypeBlock()
 		}
 
@@ -1848,14 +1882,14 @@ func (p *ApiParserParser) TypeLit() (localctx ITypeLitContext) {
 	p.EnterOuterAlt(localctx, 1)
 	match(p, "type")
 	{
-		p.SetState(141)
+		p.SetState(146)
 
 		var _m = p.Match(ApiParserParserID)
 
 		localctx.(*TypeLitContext).typeToken = _m
 	}
 	{
-		p.SetState(142)
+		p.SetState(147)
 		p.TypeLitBody()
 	}
 
@@ -2002,35 +2036,35 @@ func (p *ApiParserParser) TypeBlock() (localctx ITypeBlockContext) {
 	p.EnterOuterAlt(localctx, 1)
 	match(p, "type")
 	{
-		p.SetState(145)
+		p.SetState(150)
 
 		var _m = p.Match(ApiParserParserID)
 
 		localctx.(*TypeBlockContext).typeToken = _m
 	}
 	{
-		p.SetState(146)
+		p.SetState(151)
 
 		var _m = p.Match(ApiParserParserT__1)
 
 		localctx.(*TypeBlockContext).lp = _m
 	}
-	p.SetState(150)
+	p.SetState(155)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == ApiParserParserID {
 		{
-			p.SetState(147)
+			p.SetState(152)
 			p.TypeBlockBody()
 		}
 
-		p.SetState(152)
+		p.SetState(157)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(153)
+		p.SetState(158)
 
 		var _m = p.Match(ApiParserParserT__2)
 
@@ -2136,20 +2170,20 @@ func (p *ApiParserParser) TypeLitBody() (localctx ITypeLitBodyContext) {
 		}
 	}()
 
-	p.SetState(157)
+	p.SetState(162)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 8, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(155)
+			p.SetState(160)
 			p.TypeStruct()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(156)
+			p.SetState(161)
 			p.TypeAlias()
 		}
 
@@ -2254,20 +2288,20 @@ func (p *ApiParserParser) TypeBlockBody() (localctx ITypeBlockBodyContext) {
 		}
 	}()
 
-	p.SetState(161)
+	p.SetState(166)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 9, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(159)
+			p.SetState(164)
 			p.TypeBlockStruct()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(160)
+			p.SetState(165)
 			p.TypeBlockAlias()
 		}
 
@@ -2433,19 +2467,19 @@ func (p *ApiParserParser) TypeStruct() (localctx ITypeStructContext) {
 	p.EnterOuterAlt(localctx, 1)
 	checkKeyword(p)
 	{
-		p.SetState(164)
+		p.SetState(169)
 
 		var _m = p.Match(ApiParserParserID)
 
 		localctx.(*TypeStructContext).structName = _m
 	}
-	p.SetState(166)
+	p.SetState(171)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == ApiParserParserID {
 		{
-			p.SetState(165)
+			p.SetState(170)
 
 			var _m = p.Match(ApiParserParserID)
 
@@ -2454,30 +2488,30 @@ func (p *ApiParserParser) TypeStruct() (localctx ITypeStructContext) {
 
 	}
 	{
-		p.SetState(168)
+		p.SetState(173)
 
 		var _m = p.Match(ApiParserParserT__3)
 
 		localctx.(*TypeStructContext).lbrace = _m
 	}
-	p.SetState(172)
+	p.SetState(177)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 11, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(169)
+				p.SetState(174)
 				p.Field()
 			}
 
 		}
-		p.SetState(174)
+		p.SetState(179)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 11, p.GetParserRuleContext())
 	}
 	{
-		p.SetState(175)
+		p.SetState(180)
 
 		var _m = p.Match(ApiParserParserT__4)
 
@@ -2603,19 +2637,19 @@ func (p *ApiParserParser) TypeAlias() (localctx ITypeAliasContext) {
 	p.EnterOuterAlt(localctx, 1)
 	checkKeyword(p)
 	{
-		p.SetState(178)
+		p.SetState(183)
 
 		var _m = p.Match(ApiParserParserID)
 
 		localctx.(*TypeAliasContext).alias = _m
 	}
-	p.SetState(180)
+	p.SetState(185)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == ApiParserParserT__0 {
 		{
-			p.SetState(179)
+			p.SetState(184)
 
 			var _m = p.Match(ApiParserParserT__0)
 
@@ -2624,7 +2658,7 @@ func (p *ApiParserParser) TypeAlias() (localctx ITypeAliasContext) {
 
 	}
 	{
-		p.SetState(182)
+		p.SetState(187)
 		p.DataType()
 	}
 
@@ -2788,19 +2822,19 @@ func (p *ApiParserParser) TypeBlockStruct() (localctx ITypeBlockStructContext) {
 	p.EnterOuterAlt(localctx, 1)
 	checkKeyword(p)
 	{
-		p.SetState(185)
+		p.SetState(190)
 
 		var _m = p.Match(ApiParserParserID)
 
 		localctx.(*TypeBlockStructContext).structName = _m
 	}
-	p.SetState(187)
+	p.SetState(192)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == ApiParserParserID {
 		{
-			p.SetState(186)
+			p.SetState(191)
 
 			var _m = p.Match(ApiParserParserID)
 
@@ -2809,30 +2843,30 @@ func (p *ApiParserParser) TypeBlockStruct() (localctx ITypeBlockStructContext) {
 
 	}
 	{
-		p.SetState(189)
+		p.SetState(194)
 
 		var _m = p.Match(ApiParserParserT__3)
 
 		localctx.(*TypeBlockStructContext).lbrace = _m
 	}
-	p.SetState(193)
+	p.SetState(198)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 14, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(190)
+				p.SetState(195)
 				p.Field()
 			}
 
 		}
-		p.SetState(195)
+		p.SetState(200)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 14, p.GetParserRuleContext())
 	}
 	{
-		p.SetState(196)
+		p.SetState(201)
 
 		var _m = p.Match(ApiParserParserT__4)
 
@@ -2958,19 +2992,19 @@ func (p *ApiParserParser) TypeBlockAlias() (localctx ITypeBlockAliasContext) {
 	p.EnterOuterAlt(localctx, 1)
 	checkKeyword(p)
 	{
-		p.SetState(199)
+		p.SetState(204)
 
 		var _m = p.Match(ApiParserParserID)
 
 		localctx.(*TypeBlockAliasContext).alias = _m
 	}
-	p.SetState(201)
+	p.SetState(206)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == ApiParserParserT__0 {
 		{
-			p.SetState(200)
+			p.SetState(205)
 
 			var _m = p.Match(ApiParserParserT__0)
 
@@ -2979,7 +3013,7 @@ func (p *ApiParserParser) TypeBlockAlias() (localctx ITypeBlockAliasContext) {
 
 	}
 	{
-		p.SetState(203)
+		p.SetState(208)
 		p.DataType()
 	}
 
@@ -3082,25 +3116,25 @@ func (p *ApiParserParser) Field() (localctx IFieldContext) {
 		}
 	}()
 
-	p.SetState(208)
+	p.SetState(213)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 16, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
-		p.SetState(205)
+		p.SetState(210)
 
 		if !(isNormal(p)) {
 			panic(antlr.NewFailedPredicateException(p, "isNormal(p)", ""))
 		}
 		{
-			p.SetState(206)
+			p.SetState(211)
 			p.NormalField()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(207)
+			p.SetState(212)
 			p.AnonymousFiled()
 		}
 
@@ -3228,22 +3262,22 @@ func (p *ApiParserParser) NormalField() (localctx INormalFieldContext) {
 	p.EnterOuterAlt(localctx, 1)
 	checkKeyword(p)
 	{
-		p.SetState(211)
+		p.SetState(216)
 
 		var _m = p.Match(ApiParserParserID)
 
 		localctx.(*NormalFieldContext).fieldName = _m
 	}
 	{
-		p.SetState(212)
+		p.SetState(217)
 		p.DataType()
 	}
-	p.SetState(214)
+	p.SetState(219)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 17, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(213)
+			p.SetState(218)
 
 			var _m = p.Match(ApiParserParserRAW_STRING)
 
@@ -3358,13 +3392,13 @@ func (p *ApiParserParser) AnonymousFiled() (localctx IAnonymousFiledContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(217)
+	p.SetState(222)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == ApiParserParserT__5 {
 		{
-			p.SetState(216)
+			p.SetState(221)
 
 			var _m = p.Match(ApiParserParserT__5)
 
@@ -3372,18 +3406,18 @@ func (p *ApiParserParser) AnonymousFiled() (localctx IAnonymousFiledContext) {
 		}
 
 	}
-	p.SetState(220)
+	p.SetState(225)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 19, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(219)
+			p.SetState(224)
 			p.PackageExpr()
 		}
 
 	}
 	{
-		p.SetState(222)
+		p.SetState(227)
 		p.Match(ApiParserParserID)
 	}
 
@@ -3546,45 +3580,45 @@ func (p *ApiParserParser) DataType() (localctx IDataTypeContext) {
 		}
 	}()
 
-	p.SetState(235)
+	p.SetState(240)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 21, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
-		p.SetState(225)
+		p.SetState(230)
 		p.GetErrorHandler().Sync(p)
 
 		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 20, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(224)
+				p.SetState(229)
 				p.PackageExpr()
 			}
 
 		}
 		isInterface(p)
 		{
-			p.SetState(228)
+			p.SetState(233)
 			p.Match(ApiParserParserID)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(229)
+			p.SetState(234)
 			p.MapType()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(230)
+			p.SetState(235)
 			p.ArrayType()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(231)
+			p.SetState(236)
 
 			var _m = p.Match(ApiParserParserINTERFACE)
 
@@ -3594,7 +3628,7 @@ func (p *ApiParserParser) DataType() (localctx IDataTypeContext) {
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(232)
+			p.SetState(237)
 
 			var _m = p.Match(ApiParserParserT__6)
 
@@ -3604,14 +3638,14 @@ func (p *ApiParserParser) DataType() (localctx IDataTypeContext) {
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(233)
+			p.SetState(238)
 			p.PointerType()
 		}
 
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(234)
+			p.SetState(239)
 			p.TypeStruct()
 		}
 
@@ -3723,25 +3757,25 @@ func (p *ApiParserParser) PointerType() (localctx IPointerTypeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(237)
+		p.SetState(242)
 
 		var _m = p.Match(ApiParserParserT__5)
 
 		localctx.(*PointerTypeContext).star = _m
 	}
-	p.SetState(239)
+	p.SetState(244)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 22, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(238)
+			p.SetState(243)
 			p.PackageExpr()
 		}
 
 	}
 	checkKeyword(p)
 	{
-		p.SetState(242)
+		p.SetState(247)
 		p.Match(ApiParserParserID)
 	}
 
@@ -3852,14 +3886,14 @@ func (p *ApiParserParser) PackageExpr() (localctx IPackageExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(244)
+		p.SetState(249)
 
 		var _m = p.Match(ApiParserParserID)
 
 		localctx.(*PackageExprContext).packageName = _m
 	}
 	{
-		p.SetState(245)
+		p.SetState(250)
 
 		var _m = p.Match(ApiParserParserT__7)
 
@@ -4021,14 +4055,14 @@ func (p *ApiParserParser) MapType() (localctx IMapTypeContext) {
 	p.EnterOuterAlt(localctx, 1)
 	match(p, "map")
 	{
-		p.SetState(248)
+		p.SetState(253)
 
 		var _m = p.Match(ApiParserParserID)
 
 		localctx.(*MapTypeContext).mapToken = _m
 	}
 	{
-		p.SetState(249)
+		p.SetState(254)
 
 		var _m = p.Match(ApiParserParserT__8)
 
@@ -4036,21 +4070,21 @@ func (p *ApiParserParser) MapType() (localctx IMapTypeContext) {
 	}
 	checkKey(p)
 	{
-		p.SetState(251)
+		p.SetState(256)
 
 		var _m = p.Match(ApiParserParserID)
 
 		localctx.(*MapTypeContext).key = _m
 	}
 	{
-		p.SetState(252)
+		p.SetState(257)
 
 		var _m = p.Match(ApiParserParserT__9)
 
 		localctx.(*MapTypeContext).rbrack = _m
 	}
 	{
-		p.SetState(253)
+		p.SetState(258)
 
 		var _x = p.DataType()
 
@@ -4170,27 +4204,403 @@ func (p *ApiParserParser) ArrayType() (localctx IArrayTypeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(255)
+		p.SetState(260)
 
 		var _m = p.Match(ApiParserParserT__8)
 
 		localctx.(*ArrayTypeContext).lbrack = _m
 	}
 	{
-		p.SetState(256)
+		p.SetState(261)
 
 		var _m = p.Match(ApiParserParserT__9)
 
 		localctx.(*ArrayTypeContext).rbrack = _m
 	}
 	{
-		p.SetState(257)
+		p.SetState(262)
 		p.DataType()
 	}
 
 	return localctx
 }
 
+// IEnumSpecContext is an interface to support dynamic dispatch.
+type IEnumSpecContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// GetEnumToken returns the enumToken token.
+	GetEnumToken() antlr.Token
+
+	// GetEnumName returns the enumName token.
+	GetEnumName() antlr.Token
+
+	// GetLbrace returns the lbrace token.
+	GetLbrace() antlr.Token
+
+	// GetRbrace returns the rbrace token.
+	GetRbrace() antlr.Token
+
+	// SetEnumToken sets the enumToken token.
+	SetEnumToken(antlr.Token)
+
+	// SetEnumName sets the enumName token.
+	SetEnumName(antlr.Token)
+
+	// SetLbrace sets the lbrace token.
+	SetLbrace(antlr.Token)
+
+	// SetRbrace sets the rbrace token.
+	SetRbrace(antlr.Token)
+
+	// IsEnumSpecContext differentiates from other interfaces.
+	IsEnumSpecContext()
+}
+
+type EnumSpecContext struct {
+	*antlr.BaseParserRuleContext
+	parser    antlr.Parser
+	enumToken antlr.Token
+	enumName  antlr.Token
+	lbrace    antlr.Token
+	rbrace    antlr.Token
+}
+
+func NewEmptyEnumSpecContext() *EnumSpecContext {
+	var p = new(EnumSpecContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = ApiParserParserRULE_enumSpec
+	return p
+}
+
+func (*EnumSpecContext) IsEnumSpecContext() {}
+
+func NewEnumSpecContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EnumSpecContext {
+	var p = new(EnumSpecContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = ApiParserParserRULE_enumSpec
+
+	return p
+}
+
+func (s *EnumSpecContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *EnumSpecContext) GetEnumToken() antlr.Token { return s.enumToken }
+
+func (s *EnumSpecContext) GetEnumName() antlr.Token { return s.enumName }
+
+func (s *EnumSpecContext) GetLbrace() antlr.Token { return s.lbrace }
+
+func (s *EnumSpecContext) GetRbrace() antlr.Token { return s.rbrace }
+
+func (s *EnumSpecContext) SetEnumToken(v antlr.Token) { s.enumToken = v }
+
+func (s *EnumSpecContext) SetEnumName(v antlr.Token) { s.enumName = v }
+
+func (s *EnumSpecContext) SetLbrace(v antlr.Token) { s.lbrace = v }
+
+func (s *EnumSpecContext) SetRbrace(v antlr.Token) { s.rbrace = v }
+
+func (s *EnumSpecContext) AllID() []antlr.TerminalNode {
+	return s.GetTokens(ApiParserParserID)
+}
+
+func (s *EnumSpecContext) ID(i int) antlr.TerminalNode {
+	return s.GetToken(ApiParserParserID, i)
+}
+
+func (s *EnumSpecContext) AllEnumValue() []IEnumValueContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IEnumValueContext)(nil)).Elem())
+	var tst = make([]IEnumValueContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IEnumValueContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *EnumSpecContext) EnumValue(i int) IEnumValueContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IEnumValueContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEnumValueContext)
+}
+
+func (s *EnumSpecContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *EnumSpecContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *EnumSpecContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case ApiParserVisitor:
+		return t.VisitEnumSpec(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *ApiParserParser) EnumSpec() (localctx IEnumSpecContext) {
+	localctx = NewEnumSpecContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 54, ApiParserParserRULE_enumSpec)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	match(p, "enum")
+	{
+		p.SetState(265)
+
+		var _m = p.Match(ApiParserParserID)
+
+		localctx.(*EnumSpecContext).enumToken = _m
+	}
+	checkKeyword(p)
+	{
+		p.SetState(267)
+
+		var _m = p.Match(ApiParserParserID)
+
+		localctx.(*EnumSpecContext).enumName = _m
+	}
+	{
+		p.SetState(268)
+
+		var _m = p.Match(ApiParserParserT__3)
+
+		localctx.(*EnumSpecContext).lbrace = _m
+	}
+	p.SetState(270)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for ok := true; ok; ok = _la == ApiParserParserID {
+		{
+			p.SetState(269)
+			p.EnumValue()
+		}
+
+		p.SetState(272)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(274)
+
+		var _m = p.Match(ApiParserParserT__4)
+
+		localctx.(*EnumSpecContext).rbrace = _m
+	}
+
+	return localctx
+}
+
+// IEnumValueContext is an interface to support dynamic dispatch.
+type IEnumValueContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// GetName returns the name token.
+	GetName() antlr.Token
+
+	// GetAssign returns the assign token.
+	GetAssign() antlr.Token
+
+	// GetValue returns the value token.
+	GetValue() antlr.Token
+
+	// SetName sets the name token.
+	SetName(antlr.Token)
+
+	// SetAssign sets the assign token.
+	SetAssign(antlr.Token)
+
+	// SetValue sets the value token.
+	SetValue(antlr.Token)
+
+	// IsEnumValueContext differentiates from other interfaces.
+	IsEnumValueContext()
+}
+
+type EnumValueContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+	name   antlr.Token
+	assign antlr.Token
+	value  antlr.Token
+}
+
+func NewEmptyEnumValueContext() *EnumValueContext {
+	var p = new(EnumValueContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = ApiParserParserRULE_enumValue
+	return p
+}
+
+func (*EnumValueContext) IsEnumValueContext() {}
+
+func NewEnumValueContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EnumValueContext {
+	var p = new(EnumValueContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = ApiParserParserRULE_enumValue
+
+	return p
+}
+
+func (s *EnumValueContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *EnumValueContext) GetName() antlr.Token { return s.name }
+
+func (s *EnumValueContext) GetAssign() antlr.Token { return s.assign }
+
+func (s *EnumValueContext) GetValue() antlr.Token { return s.value }
+
+func (s *EnumValueContext) SetName(v antlr.Token) { s.name = v }
+
+func (s *EnumValueContext) SetAssign(v antlr.Token) { s.assign = v }
+
+func (s *EnumValueContext) SetValue(v antlr.Token) { s.value = v }
+
+func (s *EnumValueContext) ID() antlr.TerminalNode {
+	return s.GetToken(ApiParserParserID, 0)
+}
+
+func (s *EnumValueContext) STRING() antlr.TerminalNode {
+	return s.GetToken(ApiParserParserSTRING, 0)
+}
+
+func (s *EnumValueContext) INT() antlr.TerminalNode {
+	return s.GetToken(ApiParserParserINT, 0)
+}
+
+func (s *EnumValueContext) SEMICOLON() antlr.TerminalNode {
+	return s.GetToken(ApiParserParserSEMICOLON, 0)
+}
+
+func (s *EnumValueContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *EnumValueContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *EnumValueContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case ApiParserVisitor:
+		return t.VisitEnumValue(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *ApiParserParser) EnumValue() (localctx IEnumValueContext) {
+	localctx = NewEnumValueContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 56, ApiParserParserRULE_enumValue)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	checkKeyword(p)
+	{
+		p.SetState(277)
+
+		var _m = p.Match(ApiParserParserID)
+
+		localctx.(*EnumValueContext).name = _m
+	}
+	{
+		p.SetState(278)
+
+		var _m = p.Match(ApiParserParserT__0)
+
+		localctx.(*EnumValueContext).assign = _m
+	}
+	{
+		p.SetState(279)
+
+		var _lt = p.GetTokenStream().LT(1)
+
+		localctx.(*EnumValueContext).value = _lt
+
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == ApiParserParserSTRING || _la == ApiParserParserINT) {
+			var _ri = p.GetErrorHandler().RecoverInline(p)
+
+			localctx.(*EnumValueContext).value = _ri
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+	p.SetState(281)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == ApiParserParserSEMICOLON {
+		{
+			p.SetState(280)
+			p.Match(ApiParserParserSEMICOLON)
+		}
+
+	}
+
+	return localctx
+}
+
 // IServiceSpecContext is an interface to support dynamic dispatch.
 type IServiceSpecContext interface {
 	antlr.ParserRuleContext
@@ -4269,7 +4679,7 @@ func (s *ServiceSpecContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *ApiParserParser) ServiceSpec() (localctx IServiceSpecContext) {
 	localctx = NewServiceSpecContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 54, ApiParserParserRULE_serviceSpec)
+	p.EnterRule(localctx, 58, ApiParserParserRULE_serviceSpec)
 	var _la int
 
 	defer func() {
@@ -4289,19 +4699,19 @@ func (p *ApiParserParser) ServiceSpec() (localctx IServiceSpecContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(260)
+	p.SetState(284)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == ApiParserParserATSERVER {
 		{
-			p.SetState(259)
+			p.SetState(283)
 			p.AtServer()
 		}
 
 	}
 	{
-		p.SetState(262)
+		p.SetState(286)
 		p.ServiceApi()
 	}
 
@@ -4415,7 +4825,7 @@ func (s *AtServerContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *ApiParserParser) AtServer() (localctx IAtServerContext) {
 	localctx = NewAtServerContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 56, ApiParserParserRULE_atServer)
+	p.EnterRule(localctx, 60, ApiParserParserRULE_atServer)
 	var _la int
 
 	defer func() {
@@ -4436,32 +4846,32 @@ func (p *ApiParserParser) AtServer() (localctx IAtServerContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(264)
+		p.SetState(288)
 		p.Match(ApiParserParserATSERVER)
 	}
 	{
-		p.SetState(265)
+		p.SetState(289)
 
 		var _m = p.Match(ApiParserParserT__1)
 
 		localctx.(*AtServerContext).lp = _m
 	}
-	p.SetState(267)
+	p.SetState(291)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for ok := true; ok; ok = _la == ApiParserParserID {
 		{
-			p.SetState(266)
+			p.SetState(290)
 			p.KvLit()
 		}
 
-		p.SetState(269)
+		p.SetState(293)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(271)
+		p.SetState(295)
 
 		var _m = p.Match(ApiParserParserT__2)
 
@@ -4599,7 +5009,7 @@ func (s *ServiceApiContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *ApiParserParser) ServiceApi() (localctx IServiceApiContext) {
 	localctx = NewServiceApiContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 58, ApiParserParserRULE_serviceApi)
+	p.EnterRule(localctx, 62, ApiParserParserRULE_serviceApi)
 	var _la int
 
 	defer func() {
@@ -4621,39 +5031,39 @@ func (p *ApiParserParser) ServiceApi() (localctx IServiceApiContext) {
 	p.EnterOuterAlt(localctx, 1)
 	match(p, "service")
 	{
-		p.SetState(274)
+		p.SetState(298)
 
 		var _m = p.Match(ApiParserParserID)
 
 		localctx.(*ServiceApiContext).serviceToken = _m
 	}
 	{
-		p.SetState(275)
+		p.SetState(299)
 		p.ServiceName()
 	}
 	{
-		p.SetState(276)
+		p.SetState(300)
 
 		var _m = p.Match(ApiParserParserT__3)
 
 		localctx.(*ServiceApiContext).lbrace = _m
 	}
-	p.SetState(280)
+	p.SetState(304)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for ((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<ApiParserParserATDOC)|(1<<ApiParserParserATHANDLER)|(1<<ApiParserParserATSERVER))) != 0 {
 		{
-			p.SetState(277)
+			p.SetState(301)
 			p.ServiceRoute()
 		}
 
-		p.SetState(282)
+		p.SetState(306)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(283)
+		p.SetState(307)
 
 		var _m = p.Match(ApiParserParserT__4)
 
@@ -4761,7 +5171,7 @@ func (s *ServiceRouteContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *ApiParserParser) ServiceRoute() (localctx IServiceRouteContext) {
 	localctx = NewServiceRouteContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 60, ApiParserParserRULE_serviceRoute)
+	p.EnterRule(localctx, 64, ApiParserParserRULE_serviceRoute)
 	var _la int
 
 	defer func() {
@@ -4781,30 +5191,30 @@ func (p *ApiParserParser) ServiceRoute() (localctx IServiceRouteContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(286)
+	p.SetState(310)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == ApiParserParserATDOC {
 		{
-			p.SetState(285)
+			p.SetState(309)
 			p.AtDoc()
 		}
 
 	}
-	p.SetState(290)
+	p.SetState(314)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case ApiParserParserATSERVER:
 		{
-			p.SetState(288)
+			p.SetState(312)
 			p.AtServer()
 		}
 
 	case ApiParserParserATHANDLER:
 		{
-			p.SetState(289)
+			p.SetState(313)
 			p.AtHandler()
 		}
 
@@ -4812,7 +5222,7 @@ func (p *ApiParserParser) ServiceRoute() (localctx IServiceRouteContext) {
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	{
-		p.SetState(292)
+		p.SetState(316)
 		p.Route()
 	}
 
@@ -4930,7 +5340,7 @@ func (s *AtDocContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *ApiParserParser) AtDoc() (localctx IAtDocContext) {
 	localctx = NewAtDocContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 62, ApiParserParserRULE_atDoc)
+	p.EnterRule(localctx, 66, ApiParserParserRULE_atDoc)
 	var _la int
 
 	defer func() {
@@ -4951,16 +5361,16 @@ func (p *ApiParserParser) AtDoc() (localctx IAtDocContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(294)
+		p.SetState(318)
 		p.Match(ApiParserParserATDOC)
 	}
-	p.SetState(296)
+	p.SetState(320)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == ApiParserParserT__1 {
 		{
-			p.SetState(295)
+			p.SetState(319)
 
 			var _m = p.Match(ApiParserParserT__1)
 
@@ -4968,42 +5378,42 @@ func (p *ApiParserParser) AtDoc() (localctx IAtDocContext) {
 		}
 
 	}
-	p.SetState(304)
+	p.SetState(328)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case ApiParserParserID:
-		p.SetState(299)
+		p.SetState(323)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for ok := true; ok; ok = _la == ApiParserParserID {
 			{
-				p.SetState(298)
+				p.SetState(322)
 				p.KvLit()
 			}
 
-			p.SetState(301)
+			p.SetState(325)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 
 	case ApiParserParserSTRING:
 		{
-			p.SetState(303)
+			p.SetState(327)
 			p.Match(ApiParserParserSTRING)
 		}
 
 	default:
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
-	p.SetState(307)
+	p.SetState(331)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == ApiParserParserT__2 {
 		{
-			p.SetState(306)
+			p.SetState(330)
 
 			var _m = p.Match(ApiParserParserT__2)
 
@@ -5081,7 +5491,7 @@ func (s *AtHandlerContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *ApiParserParser) AtHandler() (localctx IAtHandlerContext) {
 	localctx = NewAtHandlerContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 64, ApiParserParserRULE_atHandler)
+	p.EnterRule(localctx, 68, ApiParserParserRULE_atHandler)
 
 	defer func() {
 		p.ExitRule()
@@ -5101,11 +5511,11 @@ func (p *ApiParserParser) AtHandler() (localctx IAtHandlerContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(309)
+		p.SetState(333)
 		p.Match(ApiParserParserATHANDLER)
 	}
 	{
-		p.SetState(310)
+		p.SetState(334)
 		p.Match(ApiParserParserID)
 	}
 
@@ -5252,7 +5662,7 @@ func (s *RouteContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *ApiParserParser) Route() (localctx IRouteContext) {
 	localctx = NewRouteContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 66, ApiParserParserRULE_route)
+	p.EnterRule(localctx, 70, ApiParserParserRULE_route)
 	var _la int
 
 	defer func() {
@@ -5274,22 +5684,22 @@ func (p *ApiParserParser) Route() (localctx IRouteContext) {
 	p.EnterOuterAlt(localctx, 1)
 	checkHTTPMethod(p)
 	{
-		p.SetState(313)
+		p.SetState(337)
 
 		var _m = p.Match(ApiParserParserID)
 
 		localctx.(*RouteContext).httpMethod = _m
 	}
 	{
-		p.SetState(314)
+		p.SetState(338)
 		p.Path()
 	}
-	p.SetState(316)
+	p.SetState(340)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 32, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 34, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(315)
+			p.SetState(339)
 
 			var _x = p.Body()
 
@@ -5297,13 +5707,13 @@ func (p *ApiParserParser) Route() (localctx IRouteContext) {
 		}
 
 	}
-	p.SetState(319)
+	p.SetState(343)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == ApiParserParserID {
 		{
-			p.SetState(318)
+			p.SetState(342)
 
 			var _m = p.Match(ApiParserParserID)
 
@@ -5311,13 +5721,13 @@ func (p *ApiParserParser) Route() (localctx IRouteContext) {
 		}
 
 	}
-	p.SetState(322)
+	p.SetState(346)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == ApiParserParserT__1 {
 		{
-			p.SetState(321)
+			p.SetState(345)
 
 			var _x = p.Replybody()
 
@@ -5413,7 +5823,7 @@ func (s *BodyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *ApiParserParser) Body() (localctx IBodyContext) {
 	localctx = NewBodyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 68, ApiParserParserRULE_body)
+	p.EnterRule(localctx, 72, ApiParserParserRULE_body)
 	var _la int
 
 	defer func() {
@@ -5434,25 +5844,25 @@ func (p *ApiParserParser) Body() (localctx IBodyContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(324)
+		p.SetState(348)
 
 		var _m = p.Match(ApiParserParserT__1)
 
 		localctx.(*BodyContext).lp = _m
 	}
-	p.SetState(326)
+	p.SetState(350)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == ApiParserParserID {
 		{
-			p.SetState(325)
+			p.SetState(349)
 			p.Match(ApiParserParserID)
 		}
 
 	}
 	{
-		p.SetState(328)
+		p.SetState(352)
 
 		var _m = p.Match(ApiParserParserT__2)
 
@@ -5552,7 +5962,7 @@ func (s *ReplybodyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *ApiParserParser) Replybody() (localctx IReplybodyContext) {
 	localctx = NewReplybodyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 70, ApiParserParserRULE_replybody)
+	p.EnterRule(localctx, 74, ApiParserParserRULE_replybody)
 	var _la int
 
 	defer func() {
@@ -5573,25 +5983,25 @@ func (p *ApiParserParser) Replybody() (localctx IReplybodyContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(330)
+		p.SetState(354)
 
 		var _m = p.Match(ApiParserParserT__1)
 
 		localctx.(*ReplybodyContext).lp = _m
 	}
-	p.SetState(332)
+	p.SetState(356)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if ((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<ApiParserParserT__5)|(1<<ApiParserParserT__6)|(1<<ApiParserParserT__8)|(1<<ApiParserParserINTERFACE)|(1<<ApiParserParserID))) != 0 {
 		{
-			p.SetState(331)
+			p.SetState(355)
 			p.DataType()
 		}
 
 	}
 	{
-		p.SetState(334)
+		p.SetState(358)
 
 		var _m = p.Match(ApiParserParserT__2)
 
@@ -5689,7 +6099,7 @@ func (s *KvLitContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *ApiParserParser) KvLit() (localctx IKvLitContext) {
 	localctx = NewKvLitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 72, ApiParserParserRULE_kvLit)
+	p.EnterRule(localctx, 76, ApiParserParserRULE_kvLit)
 
 	defer func() {
 		p.ExitRule()
@@ -5709,7 +6119,7 @@ func (p *ApiParserParser) KvLit() (localctx IKvLitContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(336)
+		p.SetState(360)
 
 		var _m = p.Match(ApiParserParserID)
 
@@ -5717,7 +6127,7 @@ func (p *ApiParserParser) KvLit() (localctx IKvLitContext) {
 	}
 	checkKeyValue(p)
 	{
-		p.SetState(338)
+		p.SetState(362)
 
 		var _m = p.Match(ApiParserParserLINE_VALUE)
 
@@ -5793,7 +6203,7 @@ func (s *ServiceNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *ApiParserParser) ServiceName() (localctx IServiceNameContext) {
 	localctx = NewServiceNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 74, ApiParserParserRULE_serviceName)
+	p.EnterRule(localctx, 78, ApiParserParserRULE_serviceName)
 	var _la int
 
 	defer func() {
@@ -5813,28 +6223,28 @@ func (p *ApiParserParser) ServiceName() (localctx IServiceNameContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(344)
+	p.SetState(368)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for ok := true; ok; ok = _la == ApiParserParserID {
 		{
-			p.SetState(340)
+			p.SetState(364)
 			p.Match(ApiParserParserID)
 		}
-		p.SetState(342)
+		p.SetState(366)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == ApiParserParserT__10 {
 			{
-				p.SetState(341)
+				p.SetState(365)
 				p.Match(ApiParserParserT__10)
 			}
 
 		}
 
-		p.SetState(346)
+		p.SetState(370)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -5908,7 +6318,7 @@ func (s *PathContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *ApiParserParser) Path() (localctx IPathContext) {
 	localctx = NewPathContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 76, ApiParserParserRULE_path)
+	p.EnterRule(localctx, 80, ApiParserParserRULE_path)
 	var _la int
 
 	defer func() {
@@ -5928,65 +6338,65 @@ func (p *ApiParserParser) Path() (localctx IPathContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(363)
+	p.SetState(387)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for ok := true; ok; ok = _la == ApiParserParserT__11 || _la == ApiParserParserT__12 {
-		p.SetState(363)
+		p.SetState(387)
 		p.GetErrorHandler().Sync(p)
 
 		switch p.GetTokenStream().LA(1) {
 		case ApiParserParserT__11:
 			{
-				p.SetState(348)
+				p.SetState(372)
 				p.Match(ApiParserParserT__11)
 			}
 
 			{
-				p.SetState(349)
+				p.SetState(373)
 				p.Match(ApiParserParserID)
 			}
-			p.SetState(354)
+			p.SetState(378)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			for _la == ApiParserParserT__10 {
 				{
-					p.SetState(350)
+					p.SetState(374)
 					p.Match(ApiParserParserT__10)
 				}
 				{
-					p.SetState(351)
+					p.SetState(375)
 					p.Match(ApiParserParserID)
 				}
 
-				p.SetState(356)
+				p.SetState(380)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 			}
 
 		case ApiParserParserT__12:
 			{
-				p.SetState(357)
+				p.SetState(381)
 				p.Match(ApiParserParserT__12)
 			}
 
 			{
-				p.SetState(358)
+				p.SetState(382)
 				p.Match(ApiParserParserID)
 			}
-			p.SetState(361)
+			p.SetState(385)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == ApiParserParserT__10 {
 				{
-					p.SetState(359)
+					p.SetState(383)
 					p.Match(ApiParserParserT__10)
 				}
 				{
-					p.SetState(360)
+					p.SetState(384)
 					p.Match(ApiParserParserID)
 				}
 
@@ -5996,7 +6406,7 @@ func (p *ApiParserParser) Path() (localctx IPathContext) {
 			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 
-		p.SetState(365)
+		p.SetState(389)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -88,6 +88,12 @@ type ApiParserVisitor interface {
 	// Visit a parse tree produced by ApiParserParser#arrayType.
 	VisitArrayType(ctx *ArrayTypeContext) interface{}
 
+	// Visit a parse tree produced by ApiParserParser#enumSpec.
+	VisitEnumSpec(ctx *EnumSpecContext) interface{}
+
+	// Visit a parse tree produced by ApiParserParser#enumValue.
+	VisitEnumValue(ctx *EnumValueContext) interface{}
+
 	// Visit a parse tree produced by ApiParserParser#serviceSpec.
 	VisitServiceSpec(ctx *ServiceSpecContext) interface{}
 
@@ -519,3 +519,76 @@ func TestTypeUnExported(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestEnum(t *testing.T) {
+	t.Run("normal", func(t *testing.T) {
+		v, err := parser.ParseContent(`
+		// doc
+		enum Status {
+			// active
+			Active = 1 // comment
+			Disabled = 2; Deleted = -1
+		}
+		enum Gender { Male = "male"; Female = "female" }
+		type Foo {
+			Status Status
+		}`, "")
+		assert.Nil(t, err)
+		assert.Len(t, v.Type, 3)
+
+		status := v.Type[0].(*ast.TypeEnum)
+		assert.True(t, status.Equal(&ast.TypeEnum{
+			Name: ast.NewTextExpr("Status"),
+			Values: []*ast.EnumValue{
+				{
+					Name:        ast.NewTextExpr("Active"),
+					Value:       ast.NewTextExpr("1"),
+					DocExpr:     []ast.Expr{ast.NewTextExpr("// active")},
+					CommentExpr: ast.NewTextExpr("// comment"),
+				},
+				{Name: ast.NewTextExpr("Disabled"), Value: ast.NewTextExpr("2")},
+				{Name: ast.NewTextExpr("Deleted"), Value: ast.NewTextExpr("-1")},
+			},
+		}))
+		assert.False(t, status.IsString())
+		assert.True(t, v.Type[1].(*ast.TypeEnum).IsString())
+		_, ok := v.Type[2].(*ast.TypeStruct)
+		assert.True(t, ok)
+	})
+
+	t.Run("inline", func(t *testing.T) {
+		v, err := parser.ParseContent(`type Foo {} enum Status { Active = 1 }`, "")
+		assert.Nil(t, err)
+		assert.Len(t, v.Type, 2)
+		_, ok := v.Type[1].(*ast.TypeEnum)
+		assert.True(t, ok)
+	})
+
+	t.Run("wrong", func(t *testing.T) {
+		_, err := parser.ParseContent(`enum Status {}`, "")
+		assert.Error(t, err)
+
+		_, err = parser.ParseContent(`enum Status { Active = 1; Disabled = "disabled" }`, "")
+		assert.Error(t, err)
+
+		_, err = parser.ParseContent(`enum Status { Active = 1; Active = 2 }`, "")
+		assert.Error(t, err)
+
+		_, err = parser.ParseContent(`enum Status { Active = 1; Disabled = 1 }`, "")
+		assert.Error(t, err)
+
+		_, err = parser.ParseContent(`enum Status { Active = 1; Disabled = 01 }`, "")
+		assert.Error(t, err)
+
+		_, err = parser.ParseContent(`enum Status { Active }`, "")
+		assert.Error(t, err)
+
+		_, err = parser.ParseContent(`enum Status { Active = 99999999999999999999 }`, "")
+		assert.Error(t, err)
+
+		_, err = parser.ParseContent(`
+		enum Status { Active = 1 }
+		type Status {}`, "")
+		assert.Error(t, err)
+	})
+}
@@ -111,6 +111,8 @@ func (p parser) fillImport() error {
 									Members:  members,
 									Docs:     p.stringExprs(v.Doc()),
 								})
+							case *ast.TypeEnum:
+								types = append(types, p.enumToSpec(v))
 							default:
 								return fmt.Errorf("unknown type %+v", v)
 							}
@@ -142,6 +144,8 @@ func (p parser) fillTypes() error {
 				Members:  members,
 				Docs:     p.stringExprs(v.Doc()),
 			})
+		case *ast.TypeEnum:
+			p.spec.Types = append(p.spec.Types, p.enumToSpec(v))
 		default:
 			return fmt.Errorf("unknown type %+v", v)
 		}
@@ -153,6 +157,11 @@ func (p parser) fillTypes() error {
 		case spec.DefineStruct:
 			var members []spec.Member
 			for _, member := range v.Members {
+				if _, err := member.Rules(); err != nil {
+					return fmt.Errorf("type %s field %s: %v", v.Name(), member.Name, err)
+				}
+
+				member.Type = p.resolveEnum(member.Type)
 				switch v := member.Type.(type) {
 				case spec.DefineStruct:
 					// do not check if it's a import struct type,because it's checked while parsing
@@ -169,6 +178,8 @@ func (p parser) fillTypes() error {
 			}
 			v.Members = members
 			types = append(types, v)
+		case spec.EnumType:
+			types = append(types, v)
 		default:
 			return fmt.Errorf("unknown type %+v", v)
 		}
@@ -178,6 +189,57 @@ func (p parser) fillTypes() error {
 	return nil
 }
 
+func (p parser) enumToSpec(enum *ast.TypeEnum) spec.EnumType {
+	base := "int64"
+	if enum.IsString() {
+		base = "string"
+	}
+
+	var values []spec.EnumValue
+	for _, item := range enum.Values {
+		values = append(values, spec.EnumValue{
+			Name:    item.Name.Text(),
+			Value:   item.Parsed(),
+			Docs:    p.stringExprs(item.Doc()),
+			Comment: p.commentExprs(item.Comment()),
+		})
+	}
+
+	return spec.EnumType{
+		RawName: enum.Name.Text(),
+		Base:    base,
+		Values:  values,
+		Docs:    p.stringExprs(enum.Doc()),
+	}
+}
+
+// resolveEnum replaces the references of the enums defined in api with the enum types
+func (p parser) resolveEnum(tp spec.Type) spec.Type {
+	switch v := tp.(type) {
+	case spec.DefineStruct:
+		if len(v.Package) > 0 {
+			return tp
+		}
+
+		for _, item := range p.spec.Types {
+			if enum, ok := item.(spec.EnumType); ok && enum.Name() == v.Name() {
+				return enum
+			}
+		}
+	case spec.PointerType:
+		v.Type = p.resolveEnum(v.Type)
+		return v
+	case spec.ArrayType:
+		v.Value = p.resolveEnum(v.Value)
+		return v
+	case spec.MapType:
+		v.Value = p.resolveEnum(v.Value)
+		return v
+	}
+
+	return tp
+}
+
 func (p parser) findDefinedType(name string) (*spec.Type, error) {
 	for _, item := range p.spec.Types {
 		if _, ok := item.(spec.DefineStruct); ok {
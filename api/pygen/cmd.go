@@ -0,0 +1,40 @@
+package pygen
+
+import (
+	"errors"
+
+	"github.com/urfave/cli"
+	"github.com/zeromicro/goctl/api/parser"
+)
+
+// PythonCommand the generate python code command entrance
+func PythonCommand(c *cli.Context) error {
+	apiFile := c.String("api")
+	if apiFile == "" {
+		return errors.New("missing -api")
+	}
+	dir := c.String("dir")
+	if dir == "" {
+		return errors.New("missing -dir")
+	}
+
+	api, e := parser.Parse(apiFile)
+	if e != nil {
+		return e
+	}
+
+	g := newGenerator(dir, c.Bool("dataclass"), api)
+	e = g.genBase()
+	if e != nil {
+		return e
+	}
+	e = g.genTypes()
+	if e != nil {
+		return e
+	}
+	modules, e := g.genClients()
+	if e != nil {
+		return e
+	}
+	return g.genInit(modules)
+}
@@ -0,0 +1,265 @@
+package pygen
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/tal-tech/go-zero/core/stringx"
+	"github.com/zeromicro/goctl/api/spec"
+)
+
+const (
+	bodyTagKey   = "json"
+	pathTagKey   = "path"
+	formTagKey   = "form"
+	headerTagKey = "header"
+)
+
+var keywords = []string{
+	"False", "None", "True", "and", "as", "assert", "async", "await", "break", "class", "continue", "def",
+	"del", "elif", "else", "except", "finally", "for", "from", "global", "if", "import", "in", "is",
+	"lambda", "nonlocal", "not", "or", "pass", "raise", "return", "try", "while", "with", "yield",
+}
+
+// field describes a member of the model, the members of the inline structs are included
+type field struct {
+	// Name is the name of the python attribute
+	Name string
+	// Key is the name in the tag, such as name of json:"name"
+	Key string
+	// Location is the tag key of the member, json, path, form or header
+	Location string
+	Type     string
+	Optional bool
+	Docs     []string
+	Comment  string
+}
+
+// snakeCase converts the name into a python identifier, the keywords are suffixed with _
+func snakeCase(name string) string {
+	name = strcase.ToSnake(name)
+	if stringx.Contains(keywords, name) {
+		return name + "_"
+	}
+
+	return name
+}
+
+func className(name string) string {
+	return strcase.ToCamel(name)
+}
+
+// pyType converts the api type into the python type hint, the pointers are optional
+func pyType(tp spec.Type) (string, error) {
+	switch v := tp.(type) {
+	case spec.DefineStruct:
+		return className(v.TypeName), nil
+	case spec.EnumType:
+		return className(v.Name()), nil
+	case spec.PrimitiveType:
+		return primitiveType(v.Name())
+	case spec.PointerType:
+		value, err := pyType(v.Type)
+		if err != nil {
+			return "", err
+		}
+
+		return optional(value), nil
+	case spec.ArrayType:
+		// []byte is encoded as a base64 string
+		if v.Name() == "[]byte" {
+			return "str", nil
+		}
+
+		value, err := pyType(v.Value)
+		if err != nil {
+			return "", err
+		}
+
+		return "List[" + value + "]", nil
+	case spec.MapType:
+		key, err := primitiveType(v.Key)
+		if err != nil {
+			return "", err
+		}
+
+		value, err := pyType(v.Value)
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("Dict[%s, %s]", key, value), nil
+	case spec.InterfaceType:
+		return "Any", nil
+	}
+
+	return "", fmt.Errorf("unsupported type %s", tp.Name())
+}
+
+func primitiveType(tp string) (string, error) {
+	switch tp {
+	case "string":
+		return "str", nil
+	case "bool":
+		return "bool", nil
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "uintptr",
+		"byte", "rune":
+		return "int", nil
+	case "float", "float32", "float64":
+		return "float", nil
+	}
+
+	return "", fmt.Errorf("unsupported type %s", tp)
+}
+
+func optional(tp string) string {
+	if strings.HasPrefix(tp, "Optional[") {
+		return tp
+	}
+
+	return "Optional[" + tp + "]"
+}
+
+// resolve returns the declaration of the struct or the enum referenced by tp
+func resolve(types map[string]spec.Type, tp spec.Type) spec.Type {
+	if v, ok := tp.(spec.DefineStruct); ok {
+		if declared, ok := types[v.Name()]; ok {
+			return declared
+		}
+	}
+
+	return tp
+}
+
+// fields returns the members of the struct with the members of the inline structs,
+// the members ignored by json:"-" are excluded
+func fields(types map[string]spec.Type, tp spec.DefineStruct) ([]field, error) {
+	var result []field
+	for _, member := range tp.Members {
+		if member.IsInline {
+			memberType := member.Type
+			if pointer, ok := memberType.(spec.PointerType); ok {
+				memberType = pointer.Type
+			}
+
+			inline, ok := resolve(types, memberType).(spec.DefineStruct)
+			if !ok {
+				return nil, fmt.Errorf("inline type %s of %s is not a struct", member.Type.Name(), tp.Name())
+			}
+
+			values, err := fields(types, inline)
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, values...)
+			continue
+		}
+
+		item, ok, err := toField(member)
+		if err != nil {
+			return nil, fmt.Errorf("type %s field %s: %v", tp.Name(), member.Name, err)
+		}
+		if ok {
+			result = append(result, item)
+		}
+	}
+
+	return result, nil
+}
+
+func toField(member spec.Member) (field, bool, error) {
+	tp, err := pyType(member.Type)
+	if err != nil {
+		return field{}, false, err
+	}
+
+	item := field{
+		Name:     snakeCase(member.Name),
+		Key:      member.Name,
+		Location: bodyTagKey,
+		Type:     tp,
+		Docs:     member.Docs,
+		Comment:  member.GetComment(),
+	}
+	tags, err := spec.Parse(member.Tag)
+	if err != nil {
+		return field{}, false, err
+	}
+
+	for _, key := range []string{pathTagKey, formTagKey, headerTagKey, bodyTagKey} {
+		tag, err := tags.Get(key)
+		if err != nil {
+			continue
+		}
+		if tag.Name == "-" {
+			return field{}, false, nil
+		}
+
+		item.Key = tag.Name
+		item.Location = key
+		for _, option := range tag.Options {
+			if option == "optional" || option == "omitempty" || strings.HasPrefix(option, "default=") {
+				item.Type = optional(item.Type)
+			}
+		}
+		break
+	}
+	item.Optional = strings.HasPrefix(item.Type, "Optional[")
+
+	return item, true, nil
+}
+
+// handlerName returns the name of the client method, such as get_user of GetUserHandler
+func handlerName(route spec.Route) (string, error) {
+	handler := route.Handler
+	if len(handler) == 0 {
+		return "", fmt.Errorf("missing handler annotation for route %q", route.Path)
+	}
+
+	return snakeCase(strings.TrimSuffix(handler, "Handler")), nil
+}
+
+// comment converts the go comments into python comments
+func comment(text string) string {
+	return "# " + strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(text), "//"))
+}
+
+// dict returns the python dict literal of the fields in location, such as {"id": req.id}
+func dict(items []field, location string) string {
+	var pairs []string
+	for _, item := range items {
+		if item.Location == location {
+			pairs = append(pairs, fmt.Sprintf("%s: req.%s", strconv.Quote(item.Key), item.Name))
+		}
+	}
+	if len(pairs) == 0 {
+		return ""
+	}
+
+	return "{" + strings.Join(pairs, ", ") + "}"
+}
+
+// typeNames returns the names of the models referenced by the type hints
+func typeNames(hints []string, types map[string]spec.Type) []string {
+	set := make(map[string]bool)
+	for _, hint := range hints {
+		for _, word := range strings.FieldsFunc(hint, func(r rune) bool {
+			return r == '[' || r == ']' || r == ',' || r == ' '
+		}) {
+			if _, ok := types[word]; ok {
+				set[word] = true
+			}
+		}
+	}
+
+	var names []string
+	for name := range set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,556 @@
+package pygen
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/iancoleman/strcase"
+	"github.com/zeromicro/goctl/api/spec"
+	"github.com/zeromicro/goctl/util"
+)
+
+const (
+	baseTemplate = `# Code generated by goctl. DO NOT EDIT.
+import dataclasses
+import json
+import re
+import typing
+from enum import Enum
+from typing import Any, Dict, Optional, Union
+from urllib.parse import quote
+{{if not .}}
+from pydantic import BaseModel
+
+try:
+    from pydantic import ConfigDict
+
+    class Model(BaseModel):
+        model_config = ConfigDict(populate_by_name=True)
+
+except ImportError:
+
+    class Model(BaseModel):
+        class Config:
+            allow_population_by_field_name = True
+{{end}}
+
+class ApiError(Exception):
+    """ApiError is raised if the status code of the response is not 2xx, body is the parsed json
+    of the response, or the text if it is not a json"""
+
+    def __init__(self, status: int, text: str):
+        super().__init__("request failed with status {}".format(status))
+        self.status = status
+        self.text = text
+        try:
+            self.body = json.loads(text)
+        except ValueError:
+            self.body = text
+
+
+def to_json(value: Any) -> Any:
+    """to_json converts the models into the json values, the None values are omitted"""
+    if isinstance(value, Enum):
+        return value.value
+    if isinstance(value, dict):
+        return {str(key): to_json(item) for key, item in value.items() if item is not None}
+    if isinstance(value, (list, tuple)):
+        return [to_json(item) for item in value]
+    if hasattr(value, "model_dump"):
+        return value.model_dump(mode="json", by_alias=True, exclude_none=True)
+    if hasattr(value, "__fields__") and hasattr(value, "json"):
+        return json.loads(value.json(by_alias=True, exclude_none=True))
+    if dataclasses.is_dataclass(value):
+        result = {}
+        for item in dataclasses.fields(value):
+            attr = getattr(value, item.name)
+            if attr is not None:
+                result[item.metadata.get("json", item.name)] = to_json(attr)
+        return result
+    return value
+
+
+def from_json(tp: Any, data: Any) -> Any:
+    """from_json converts the json values into the values of the type hint tp"""
+    if data is None or tp is Any:
+        return data
+    origin = getattr(tp, "__origin__", None)
+    args = getattr(tp, "__args__", ())
+    if origin is Union:
+        return from_json([arg for arg in args if arg is not type(None)][0], data)
+    if origin in (list, typing.List):
+        return [from_json(args[0], item) for item in data]
+    if origin in (dict, typing.Dict):
+        return {from_json(args[0], key): from_json(args[1], item) for key, item in data.items()}
+    if isinstance(tp, type) and issubclass(tp, Enum):
+        return tp(data)
+    if hasattr(tp, "model_validate"):
+        return tp.model_validate(data)
+    if hasattr(tp, "parse_obj"):
+        return tp.parse_obj(data)
+    if dataclasses.is_dataclass(tp):
+        hints = typing.get_type_hints(tp)
+        kwargs = {}
+        for item in dataclasses.fields(tp):
+            key = item.metadata.get("json", item.name)
+            if key in data:
+                kwargs[item.name] = from_json(hints[item.name], data[key])
+        return tp(**kwargs)
+    if tp in (int, float) and isinstance(data, str):
+        return tp(data)
+    return data
+
+
+def query_value(value: Any) -> Any:
+    value = to_json(value)
+    if isinstance(value, bool):
+        return "true" if value else "false"
+    if isinstance(value, list):
+        return [query_value(item) for item in value]
+    return value
+
+
+def default_session() -> Any:
+    try:
+        import httpx
+
+        return httpx.Client()
+    except ImportError:
+        import requests
+
+        return requests.Session()
+
+
+class BaseClient:
+    """BaseClient sends the requests by session, session is a httpx.Client or a requests.Session,
+    httpx is used if it is installed, token is sent as the bearer token of the routes in the jwt groups"""
+
+    def __init__(
+        self,
+        base_url: str = "http://localhost:8888",
+        session: Any = None,
+        token: Optional[str] = None,
+        headers: Optional[Dict[str, str]] = None,
+        timeout: float = 10,
+    ):
+        self.base_url = base_url.rstrip("/")
+        self.session = session if session is not None else default_session()
+        self.token = token
+        self.headers = dict(headers or {})
+        self.timeout = timeout
+
+    def _send(
+        self,
+        method: str,
+        path: str,
+        path_params: Optional[Dict[str, Any]] = None,
+        query: Optional[Dict[str, Any]] = None,
+        headers: Optional[Dict[str, Any]] = None,
+        body: Optional[Dict[str, Any]] = None,
+        response: Any = None,
+        auth: bool = False,
+    ) -> Any:
+        params = path_params or {}
+        path = re.sub(r":(\w+)", lambda match: quote(str(query_value(params[match.group(1)])), safe=""), path)
+        all_headers = dict(self.headers)
+        for key, value in (headers or {}).items():
+            if value is not None:
+                all_headers[key] = str(query_value(value))
+        if auth and self.token:
+            all_headers["Authorization"] = "Bearer " + self.token
+
+        kwargs: Dict[str, Any] = {
+            "params": {key: query_value(value) for key, value in (query or {}).items() if value is not None},
+            "headers": all_headers,
+            "timeout": self.timeout,
+        }
+        if body is not None:
+            kwargs["json"] = to_json(body)
+
+        resp = self.session.request(method, self.base_url + path, **kwargs)
+        if resp.status_code < 200 or resp.status_code >= 300:
+            raise ApiError(resp.status_code, resp.text)
+        if response is None or not resp.content:
+            return None
+        return from_json(response, resp.json())
+`
+
+	typesTemplate = `# Code generated by goctl. DO NOT EDIT.
+from __future__ import annotations
+
+{{if .Dataclass}}from dataclasses import dataclass, field
+{{end}}from enum import Enum, IntEnum
+from typing import Any, Dict, List, Optional
+{{if not .Dataclass}}
+from pydantic import Field
+
+from .base import Model
+{{end}}{{range .Types}}
+
+{{.}}{{end}}{{if and (not .Dataclass) .Models}}
+
+for _model in [{{join .Models ", "}}]:
+    if hasattr(_model, "model_rebuild"):
+        _model.model_rebuild()
+    else:
+        _model.update_forward_refs()
+{{end}}`
+
+	clientTemplate = `# Code generated by goctl. DO NOT EDIT.
+from typing import Any, Dict, List, Optional
+
+from .base import BaseClient
+{{if .Imports}}from .{{.Types}} import {{join .Imports ", "}}
+{{end}}
+
+class {{.Name}}(BaseClient):
+    """{{.Name}} is the client of {{.Group}}"""
+{{range .Methods}}
+{{.}}{{end}}`
+
+	initTemplate = `# Code generated by goctl. DO NOT EDIT.
+from .base import ApiError, BaseClient
+{{range .}}from .{{.}} import *  # noqa: F401,F403
+{{end}}`
+)
+
+const indent = "    "
+
+type generator struct {
+	dir       string
+	dataclass bool
+	api       *spec.ApiSpec
+	types     map[string]spec.Type
+	// classes are the python names of the types
+	classes map[string]spec.Type
+}
+
+func newGenerator(dir string, dataclass bool, api *spec.ApiSpec) *generator {
+	g := &generator{
+		dir:       dir,
+		dataclass: dataclass,
+		api:       api,
+		types:     make(map[string]spec.Type),
+		classes:   make(map[string]spec.Type),
+	}
+	for _, tp := range g.allTypes() {
+		g.types[tp.Name()] = tp
+		g.classes[className(tp.Name())] = tp
+	}
+
+	return g
+}
+
+func (g *generator) allTypes() []spec.Type {
+	types := g.api.Types
+	for _, item := range g.api.Imports {
+		types = append(types, item.Types...)
+	}
+
+	return types
+}
+
+func (g *generator) typesModule() string {
+	return strcase.ToSnake(strings.TrimSuffix(g.api.Service.Name, "-api")) + "_types"
+}
+
+func (g *generator) genBase() error {
+	return g.writeFile("base.py", baseTemplate, g.dataclass)
+}
+
+func (g *generator) genTypes() error {
+	var items, models []string
+	for _, tp := range g.allTypes() {
+		switch v := tp.(type) {
+		case spec.DefineStruct:
+			item, e := g.buildModel(v)
+			if e != nil {
+				return e
+			}
+
+			items = append(items, item)
+			models = append(models, className(v.TypeName))
+		case spec.EnumType:
+			items = append(items, buildEnum(v))
+		default:
+			return fmt.Errorf("unsupported type %s", tp.Name())
+		}
+	}
+
+	return g.writeFile(g.typesModule()+".py", typesTemplate, map[string]interface{}{
+		"Dataclass": g.dataclass,
+		"Types":     items,
+		"Models":    models,
+	})
+}
+
+// genClients generates a client class for every group, the groups without the group annotation
+// are merged into the client named after the service
+func (g *generator) genClients() ([]string, error) {
+	var names []string
+	groups := make(map[string][]spec.Group)
+	for _, group := range g.api.Service.Groups {
+		name := group.GetAnnotation("group")
+		if len(name) == 0 {
+			name = strings.TrimSuffix(g.api.Service.Name, "-api")
+		}
+		name = strings.NewReplacer("/", "_", "-", "_").Replace(name)
+		if _, ok := groups[name]; !ok {
+			names = append(names, name)
+		}
+		groups[name] = append(groups[name], group)
+	}
+
+	var modules []string
+	for _, name := range names {
+		var methods, hints []string
+		desc := "the group " + name
+		if len(groups[name][0].GetAnnotation("group")) == 0 {
+			desc = "the service " + g.api.Service.Name
+		}
+		for _, group := range groups[name] {
+			for _, route := range group.Routes {
+				method, routeHints, e := g.buildMethod(group, route)
+				if e != nil {
+					return nil, e
+				}
+
+				methods = append(methods, method)
+				hints = append(hints, routeHints...)
+			}
+		}
+
+		module := strcase.ToSnake(name) + "_client"
+		e := g.writeFile(module+".py", clientTemplate, map[string]interface{}{
+			"Name":    strcase.ToCamel(name) + "Client",
+			"Group":   desc,
+			"Types":   g.typesModule(),
+			"Imports": typeNames(hints, g.classes),
+			"Methods": methods,
+		})
+		if e != nil {
+			return nil, e
+		}
+
+		modules = append(modules, module)
+	}
+
+	return modules, nil
+}
+
+func (g *generator) genInit(modules []string) error {
+	return g.writeFile("__init__.py", initTemplate, append([]string{g.typesModule()}, modules...))
+}
+
+func (g *generator) writeFile(name, text string, data interface{}) error {
+	e := util.MkdirIfNotExist(g.dir)
+	if e != nil {
+		return e
+	}
+
+	t, e := template.New(name).Funcs(template.FuncMap{"join": strings.Join}).Parse(text)
+	if e != nil {
+		return e
+	}
+
+	var buffer bytes.Buffer
+	e = t.Execute(&buffer, data)
+	if e != nil {
+		return e
+	}
+	return util.WriteFile(filepath.Join(g.dir, name), buffer.Bytes(), 0644)
+}
+
+func buildEnum(tp spec.EnumType) string {
+	base := "IntEnum"
+	if tp.IsString() {
+		base = "str, Enum"
+	}
+
+	var builder strings.Builder
+	for _, doc := range tp.Docs {
+		builder.WriteString(comment(doc) + "\n")
+	}
+	fmt.Fprintf(&builder, "class %s(%s):\n", className(tp.Name()), base)
+	for _, value := range tp.Values {
+		for _, doc := range value.Docs {
+			builder.WriteString(indent + comment(doc) + "\n")
+		}
+
+		literal := value.Value
+		if tp.IsString() {
+			literal = strconv.Quote(literal)
+		}
+		fmt.Fprintf(&builder, "%s%s = %s", indent, strings.ToUpper(strcase.ToSnake(value.Name)), literal)
+		if len(value.Comment) > 0 {
+			fmt.Fprintf(&builder, "  %s", comment(value.Comment))
+		}
+		builder.WriteString("\n")
+	}
+
+	return builder.String()
+}
+
+// buildModel builds the pydantic model or the dataclass of the struct, the attributes are
+// aliased with the names in the tags
+func (g *generator) buildModel(tp spec.DefineStruct) (string, error) {
+	items, e := fields(g.types, tp)
+	if e != nil {
+		return "", e
+	}
+
+	var builder strings.Builder
+	for _, doc := range tp.Docs {
+		builder.WriteString(comment(doc) + "\n")
+	}
+	if g.dataclass {
+		builder.WriteString("@dataclass\n")
+		fmt.Fprintf(&builder, "class %s:\n", className(tp.TypeName))
+		// the attributes with default values must follow the ones without
+		var required, optionals []field
+		for _, item := range items {
+			if item.Optional {
+				optionals = append(optionals, item)
+			} else {
+				required = append(required, item)
+			}
+		}
+		items = append(required, optionals...)
+	} else {
+		fmt.Fprintf(&builder, "class %s(Model):\n", className(tp.TypeName))
+	}
+	if len(items) == 0 {
+		builder.WriteString(indent + "pass\n")
+	}
+
+	for _, item := range items {
+		for _, doc := range item.Docs {
+			builder.WriteString(indent + comment(doc) + "\n")
+		}
+
+		fmt.Fprintf(&builder, "%s%s: %s%s", indent, item.Name, item.Type, g.defaultValue(item))
+		if len(item.Comment) > 0 {
+			fmt.Fprintf(&builder, "  %s", comment(item.Comment))
+		}
+		builder.WriteString("\n")
+	}
+
+	return builder.String(), nil
+}
+
+func (g *generator) defaultValue(item field) string {
+	aliased := item.Name != item.Key
+	if g.dataclass {
+		switch {
+		case aliased && item.Optional:
+			return fmt.Sprintf(` = field(default=None, metadata={"json": %s})`, strconv.Quote(item.Key))
+		case aliased:
+			return fmt.Sprintf(` = field(metadata={"json": %s})`, strconv.Quote(item.Key))
+		case item.Optional:
+			return " = None"
+		}
+
+		return ""
+	}
+
+	switch {
+	case aliased && item.Optional:
+		return fmt.Sprintf(" = Field(None, alias=%s)", strconv.Quote(item.Key))
+	case aliased:
+		return fmt.Sprintf(" = Field(..., alias=%s)", strconv.Quote(item.Key))
+	case item.Optional:
+		return " = None"
+	}
+
+	return ""
+}
+
+// buildMethod builds the client method of the route, it returns the type hints used by the method
+func (g *generator) buildMethod(group spec.Group, route spec.Route) (string, []string, error) {
+	name, e := handlerName(route)
+	if e != nil {
+		return "", nil, e
+	}
+
+	var hints, params []string
+	var items []field
+	params = append(params, "self")
+	if len(route.RequestTypeName()) > 0 {
+		tp, ok := resolve(g.types, route.RequestType).(spec.DefineStruct)
+		if !ok {
+			return "", nil, fmt.Errorf("request type %s of route %s is not a struct", route.RequestTypeName(), route.Path)
+		}
+
+		items, e = fields(g.types, tp)
+		if e != nil {
+			return "", nil, e
+		}
+
+		params = append(params, "req: "+className(tp.TypeName))
+		hints = append(hints, className(tp.TypeName))
+	}
+
+	response := "None"
+	if len(route.ResponseTypeName()) > 0 {
+		response, e = pyType(route.ResponseType)
+		if e != nil {
+			return "", nil, e
+		}
+
+		hints = append(hints, response)
+	}
+
+	path := route.Path
+	prefix := strings.Trim(group.GetAnnotation("pathPrefix"), `"`)
+	if len(prefix) > 0 {
+		path = strings.TrimSuffix(prefix, "/") + "/" + strings.TrimPrefix(path, "/")
+	}
+
+	args := []string{strconv.Quote(strings.ToUpper(route.Method)), strconv.Quote(path)}
+	for _, each := range []struct {
+		name     string
+		location string
+	}{
+		{name: "path_params", location: pathTagKey},
+		{name: "query", location: formTagKey},
+		{name: "headers", location: headerTagKey},
+		{name: "body", location: bodyTagKey},
+	} {
+		if value := dict(items, each.location); len(value) > 0 {
+			args = append(args, each.name+"="+value)
+		}
+	}
+	if response != "None" {
+		args = append(args, "response="+response)
+	}
+	if len(group.GetAnnotation("jwt")) > 0 {
+		args = append(args, "auth=True")
+	}
+
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "%sdef %s(%s) -> %s:\n", indent, name, strings.Join(params, ", "), response)
+	doc := strings.Trim(route.JoinedDoc(), `"`)
+	if len(doc) > 0 {
+		fmt.Fprintf(&builder, "%s%s\"\"\"%s\n\n%s%s%s %s\n%s%s\"\"\"\n", indent, indent, doc, indent, indent,
+			strings.ToUpper(route.Method), route.Path, indent, indent)
+	} else {
+		fmt.Fprintf(&builder, "%s%s\"\"\"%s %s\"\"\"\n", indent, indent, strings.ToUpper(route.Method), route.Path)
+	}
+
+	call := "self._send(\n"
+	for _, arg := range args {
+		call += indent + indent + indent + arg + ",\n"
+	}
+	call += indent + indent + ")"
+	if response == "None" {
+		fmt.Fprintf(&builder, "%s%s%s\n", indent, indent, call)
+	} else {
+		fmt.Fprintf(&builder, "%s%sreturn %s\n", indent, indent, call)
+	}
+
+	return builder.String(), hints, nil
+}
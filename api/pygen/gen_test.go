@@ -0,0 +1,133 @@
+package pygen
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zeromicro/goctl/api/apitest"
+	"github.com/zeromicro/goctl/api/parser"
+)
+
+const testApi = `
+enum Gender { Male = "male"; Female = "female" }
+
+type Base {
+	Code int ` + "`" + `json:"code"` + "`" + `
+}
+
+type UserReq {
+	Id     int64    ` + "`" + `path:"id"` + "`" + `
+	Fields []string ` + "`" + `form:"fields,optional"` + "`" + `
+}
+
+type User {
+	Name   string            ` + "`" + `json:"user_name"` + "`" + `
+	Gender Gender            ` + "`" + `json:"gender"` + "`" + `
+	Tags   map[string]string ` + "`" + `json:"tags,omitempty"` + "`" + `
+	Friend *User             ` + "`" + `json:"friend"` + "`" + `
+	From   string            ` + "`" + `json:"from"` + "`" + `
+}
+
+type UserReply {
+	Base
+	User User ` + "`" + `json:"user"` + "`" + `
+}
+
+@server(
+	group: user
+	jwt: Auth
+)
+service user-api {
+	@doc "get user"
+	@handler GetUser
+	get /user/:id (UserReq) returns (UserReply)
+
+	@handler UpdateUser
+	put /user (User)
+}
+
+service user-api {
+	@handler Ping
+	get /ping returns ([]User)
+}
+`
+
+func TestPython(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "user.api")
+	err := ioutil.WriteFile(filename, []byte(testApi), os.ModePerm)
+	assert.Nil(t, err)
+
+	api, err := parser.Parse(filename)
+	assert.Nil(t, err)
+
+	t.Run("pydantic", func(t *testing.T) {
+		out := filepath.Join(dir, "pydantic")
+		modules := generate(t, newGenerator(out, false, api))
+		assert.Equal(t, []string{"user_client"}, modules)
+
+		types := readFile(t, filepath.Join(out, "user_types.py"))
+		assert.Contains(t, types, "class Gender(str, Enum):\n    MALE = \"male\"")
+		assert.Contains(t, types, "class UserReply(Model):\n    code: int\n    user: User")
+		assert.Contains(t, types, `name: str = Field(..., alias="user_name")`)
+		assert.Contains(t, types, "tags: Optional[Dict[str, str]] = None")
+		assert.Contains(t, types, "friend: Optional[User] = None")
+		assert.Contains(t, types, "from_: str = Field(..., alias=\"from\")")
+		assert.Contains(t, readFile(t, filepath.Join(out, "base.py")), "from pydantic import BaseModel")
+
+		client := readFile(t, filepath.Join(out, "user_client.py"))
+		assert.Contains(t, client, "from .user_types import User, UserReply, UserReq")
+		assert.Contains(t, client, "def get_user(self, req: UserReq) -> UserReply:")
+		assert.Contains(t, client, `path_params={"id": req.id},`)
+		assert.Contains(t, client, `query={"fields": req.fields},`)
+		assert.Contains(t, client, `body={"user_name": req.name, "gender": req.gender, "tags": req.tags, "friend": req.friend, "from": req.from_},`)
+		assert.Contains(t, client, "auth=True,")
+		assert.Contains(t, client, "def ping(self) -> List[User]:")
+		assert.Contains(t, readFile(t, filepath.Join(out, "__init__.py")), "from .user_client import *")
+	})
+
+	t.Run("dataclass", func(t *testing.T) {
+		out := filepath.Join(dir, "dataclass")
+		generate(t, newGenerator(out, true, api))
+
+		types := readFile(t, filepath.Join(out, "user_types.py"))
+		assert.Contains(t, types, "@dataclass\nclass User:\n    name: str = field(metadata={\"json\": \"user_name\"})")
+		assert.Contains(t, types, "    from_: str = field(metadata={\"json\": \"from\"})\n    tags: Optional[Dict[str, str]] = None")
+		assert.NotContains(t, readFile(t, filepath.Join(out, "base.py")), "pydantic")
+	})
+}
+
+func TestPythonCommandDryRun(t *testing.T) {
+	apitest.AssertDryRun(t, testApi, []apitest.DryRunCase{
+		{
+			Name:   "pydantic",
+			Action: PythonCommand,
+		},
+		{
+			Name:   "dataclass",
+			Action: PythonCommand,
+			Flags: func(set *flag.FlagSet) {
+				set.Bool("dataclass", true, "")
+			},
+		},
+	})
+}
+
+func generate(t *testing.T, g *generator) []string {
+	assert.Nil(t, g.genBase())
+	assert.Nil(t, g.genTypes())
+	modules, err := g.genClients()
+	assert.Nil(t, err)
+	assert.Nil(t, g.genInit(modules))
+	return modules
+}
+
+func readFile(t *testing.T, filename string) string {
+	data, err := ioutil.ReadFile(filename)
+	assert.Nil(t, err)
+	return string(data)
+}
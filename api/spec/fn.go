@@ -198,3 +198,8 @@ func (r Route) RequestTypeName() string {
 
 	return r.RequestType.Name()
 }
+
+// IsString returns true if the values of the enum are strings
+func (t EnumType) IsString() bool {
+	return t.Base == "string"
+}
@@ -29,3 +29,8 @@ func (t PointerType) Name() string {
 func (t InterfaceType) Name() string {
 	return t.RawName
 }
+
+// Name returns an enum string, such as Status
+func (t EnumType) Name() string {
+	return t.RawName
+}
@@ -0,0 +1,127 @@
+package spec
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tal-tech/go-zero/core/stringx"
+)
+
+// ValidateTagKey is the tag key of the validation rules, such as `validate:"required,min=1,max=20"`
+const ValidateTagKey = "validate"
+
+const (
+	// RuleRequired requires a non-zero value, a non-empty string, slice or map, or a non-nil pointer
+	RuleRequired = "required"
+	// RuleMin limits the minimum length of a string, slice or map, or the minimum number
+	RuleMin = "min"
+	// RuleMax limits the maximum length of a string, slice or map, or the maximum number
+	RuleMax = "max"
+	// RuleEmail requires a string to be an email address
+	RuleEmail = "email"
+	// RuleRequiredIf requires the field if the other field equals to the value, such as required_if=Type|admin
+	RuleRequiredIf = "required_if"
+	// RuleDive validates the nested struct, or the structs in a slice or map
+	RuleDive = "dive"
+	// RuleRegex requires a string to match the regular expression, it must be the last rule
+	// because the expression may contain commas, such as regex=^[a-z]{1,3}$
+	RuleRegex = "regex"
+)
+
+var ruleNames = []string{RuleRequired, RuleMin, RuleMax, RuleEmail, RuleRequiredIf, RuleDive, RuleRegex}
+
+// Rule describes a validation rule of the member
+type Rule struct {
+	// Name is the name of the rule, such as min
+	Name string
+	// Value is the argument of the rule, such as 1 of min=1
+	Value string
+}
+
+// String returns the rule as written in the tag
+func (r Rule) String() string {
+	if len(r.Value) == 0 {
+		return r.Name
+	}
+
+	return r.Name + "=" + r.Value
+}
+
+// RequiredIf returns the field and the value of the required_if rule
+func (r Rule) RequiredIf() (string, string) {
+	index := strings.Index(r.Value, "|")
+	if index < 0 {
+		return r.Value, ""
+	}
+
+	return r.Value[:index], r.Value[index+1:]
+}
+
+// ParseRules converts the value of the validate tag into rules
+func ParseRules(value string) ([]Rule, error) {
+	var rules []Rule
+	for len(value) > 0 {
+		var item string
+		if strings.HasPrefix(value, RuleRegex+"=") {
+			item, value = value, ""
+		} else if index := strings.Index(value, ","); index >= 0 {
+			item, value = value[:index], value[index+1:]
+		} else {
+			item, value = value, ""
+		}
+
+		item = strings.TrimSpace(item)
+		if len(item) == 0 {
+			continue
+		}
+
+		var rule Rule
+		if index := strings.Index(item, "="); index >= 0 {
+			rule = Rule{Name: item[:index], Value: item[index+1:]}
+		} else {
+			rule = Rule{Name: item}
+		}
+
+		if !stringx.Contains(ruleNames, rule.Name) {
+			return nil, fmt.Errorf("unknown validate rule %q", rule.Name)
+		}
+
+		switch rule.Name {
+		case RuleRequired, RuleEmail, RuleDive:
+			if len(rule.Value) > 0 {
+				return nil, fmt.Errorf("validate rule %q takes no value", rule.Name)
+			}
+		case RuleRequiredIf:
+			if strings.Count(rule.Value, "|") != 1 || strings.HasPrefix(rule.Value, "|") {
+				return nil, fmt.Errorf("validate rule %q expects field|value, but got %q", rule.Name, rule.Value)
+			}
+		default:
+			if len(rule.Value) == 0 {
+				return nil, fmt.Errorf("validate rule %q expects a value", rule.Name)
+			}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// Rules returns the validation rules of the member
+func (m Member) Rules() ([]Rule, error) {
+	if m.IsInline || len(m.Tag) == 0 {
+		return nil, nil
+	}
+
+	tags, err := Parse(m.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := tags.Get(ValidateTagKey)
+	if err != nil {
+		return nil, nil
+	}
+
+	return ParseRules(strings.Join(append([]string{tag.Name}, tag.Options...), ","))
+}
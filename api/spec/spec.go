@@ -98,6 +98,24 @@ type (
 		Docs    Doc
 	}
 
+	// EnumType describes an enum for api, such as enum Status { Active = 1 }
+	EnumType struct {
+		RawName string
+		// Base is the type of the values, int64 or string
+		Base   string
+		Values []EnumValue
+		Docs   Doc
+	}
+
+	// EnumValue describes a value of the enum
+	EnumValue struct {
+		Name string
+		// Value is the literal of the value, the string value is unquoted
+		Value   string
+		Docs    Doc
+		Comment string
+	}
+
 	// PrimitiveType describes the basic golang type, such as bool,int32,int64, ...
 	PrimitiveType struct {
 		RawName string
@@ -0,0 +1,372 @@
+package swaggergen
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/urfave/cli"
+	"github.com/zeromicro/goctl/api/parser"
+	"github.com/zeromicro/goctl/api/spec"
+	"github.com/zeromicro/goctl/util"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	bodyTagKey      = "json"
+	formTagKey      = "form"
+	pathTagKey      = "path"
+	groupProperty   = "group"
+	jwtProperty     = "jwt"
+	summaryProperty = "summary"
+	defaultVersion  = "1.0"
+)
+
+type generator struct {
+	api   *spec.ApiSpec
+	types map[string]spec.DefineStruct
+}
+
+// SwaggerCommand generates the OpenAPI 3 document of the api file
+func SwaggerCommand(c *cli.Context) error {
+	apiFile := c.String("api")
+	dir := c.String("dir")
+	isYaml := c.Bool("yaml")
+	if len(apiFile) == 0 {
+		return errors.New("missing -api")
+	}
+
+	if len(dir) == 0 {
+		return errors.New("missing -dir")
+	}
+
+	api, err := parser.Parse(apiFile)
+	if err != nil {
+		return err
+	}
+
+	content, err := Generate(api, isYaml)
+	if err != nil {
+		return err
+	}
+
+	ext := ".json"
+	if isYaml {
+		ext = ".yaml"
+	}
+
+	err = util.MkdirIfNotExist(dir)
+	if err != nil {
+		return err
+	}
+
+	filename := filepath.Join(dir, util.FileNameWithoutExt(filepath.Base(apiFile))+ext)
+	err = util.WriteFile(filename, content, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(aurora.Green("Done."))
+	return nil
+}
+
+// Generate converts api into an OpenAPI 3 document in json, or yaml if isYaml is true
+func Generate(api *spec.ApiSpec, isYaml bool) ([]byte, error) {
+	doc, err := NewDocument(api)
+	if err != nil {
+		return nil, err
+	}
+
+	if isYaml {
+		return yaml.Marshal(doc)
+	}
+
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+// NewDocument converts api into an OpenAPI 3 document, the routes and their path and form parameters
+// come from the tags of the members, @doc becomes the summary and the jwt groups become the security schemes
+func NewDocument(api *spec.ApiSpec) (*Document, error) {
+	g := &generator{
+		api:   api,
+		types: make(map[string]spec.DefineStruct),
+	}
+	for _, item := range api.Types {
+		if tp, ok := item.(spec.DefineStruct); ok {
+			g.types[tp.RawName] = tp
+		}
+	}
+	for _, imp := range api.Imports {
+		for _, item := range imp.Types {
+			if tp, ok := item.(spec.DefineStruct); ok {
+				g.types[tp.RawName] = tp
+			}
+		}
+	}
+
+	return g.document()
+}
+
+func (g *generator) document() (*Document, error) {
+	doc := &Document{
+		OpenAPI: openAPIVersion,
+		Info:    g.info(),
+		Paths:   make(map[string]*PathItem),
+	}
+
+	schemas, err := g.schemas()
+	if err != nil {
+		return nil, err
+	}
+
+	doc.Components.Schemas = schemas
+	tags := make(map[string]bool)
+	for _, group := range g.api.Service.Groups {
+		jwt := group.GetAnnotation(jwtProperty)
+		if len(jwt) > 0 {
+			if doc.Components.SecuritySchemes == nil {
+				doc.Components.SecuritySchemes = make(map[string]*SecurityScheme)
+			}
+
+			doc.Components.SecuritySchemes[jwt] = &SecurityScheme{
+				Type:         "http",
+				Scheme:       "bearer",
+				BearerFormat: "JWT",
+			}
+		}
+
+		tag := group.GetAnnotation(groupProperty)
+		if len(tag) > 0 && !tags[tag] {
+			tags[tag] = true
+			doc.Tags = append(doc.Tags, Tag{Name: tag})
+		}
+
+		for _, route := range group.Routes {
+			operation, err := g.operation(route)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(tag) > 0 {
+				operation.Tags = []string{tag}
+			}
+			if len(jwt) > 0 {
+				operation.Security = []map[string][]string{{jwt: {}}}
+			}
+
+			path := convertPath(route.Path)
+			item, ok := doc.Paths[path]
+			if !ok {
+				item = new(PathItem)
+				doc.Paths[path] = item
+			}
+
+			err = item.set(route.Method, operation)
+			if err != nil {
+				return nil, fmt.Errorf("route %s %s: %v", route.Method, route.Path, err)
+			}
+		}
+	}
+
+	return doc, nil
+}
+
+func (g *generator) info() Info {
+	properties := g.api.Info.Properties
+	info := Info{
+		Title:       unquote(properties["title"]),
+		Description: unquote(properties["desc"]),
+		Version:     unquote(properties["version"]),
+	}
+	if len(info.Title) == 0 {
+		info.Title = g.api.Service.Name
+	}
+	if len(info.Version) == 0 {
+		info.Version = defaultVersion
+	}
+
+	return info
+}
+
+func (g *generator) schemas() (map[string]*Schema, error) {
+	var names []string
+	for name := range g.types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	schemas := make(map[string]*Schema)
+	for _, name := range names {
+		tp := g.types[name]
+		schema, err := g.structSchema(tp)
+		if err != nil {
+			return nil, err
+		}
+
+		schemas[schemaName(tp)] = schema
+	}
+
+	return schemas, nil
+}
+
+func (g *generator) operation(route spec.Route) (*Operation, error) {
+	operation := &Operation{
+		Summary:     routeSummary(route),
+		Description: joinDocs(route.Docs),
+		OperationID: route.Handler,
+		Responses:   make(map[string]*Response),
+	}
+
+	if tp, ok := route.RequestType.(spec.DefineStruct); ok {
+		req, err := g.findType(tp.RawName)
+		if err != nil {
+			return nil, err
+		}
+
+		members, err := g.expandMembers(req)
+		if err != nil {
+			return nil, err
+		}
+
+		var hasBody bool
+		for _, member := range members {
+			parameter, err := g.parameter(member)
+			if err != nil {
+				return nil, err
+			}
+
+			if parameter != nil {
+				operation.Parameters = append(operation.Parameters, parameter)
+			}
+			if tag, ok := memberTag(member, bodyTagKey); ok && tag.Name != "-" {
+				hasBody = true
+			}
+		}
+
+		if hasBody {
+			operation.RequestBody = &RequestBody{
+				Required: true,
+				Content: map[string]*MediaType{
+					jsonContentType: {Schema: &Schema{Ref: refPrefix + schemaName(req)}},
+				},
+			}
+		}
+	}
+
+	response := &Response{Description: "OK"}
+	if route.ResponseType != nil {
+		schema, err := g.typeToSchema(route.ResponseType)
+		if err != nil {
+			return nil, err
+		}
+
+		response.Content = map[string]*MediaType{
+			jsonContentType: {Schema: schema},
+		}
+	}
+	operation.Responses["200"] = response
+
+	return operation, nil
+}
+
+// parameter converts the path and form members into parameters, form members are
+// query parameters, it returns nil if member is neither a path member nor a form member
+func (g *generator) parameter(member spec.Member) (*Parameter, error) {
+	in := "path"
+	tag, ok := memberTag(member, pathTagKey)
+	if !ok {
+		in = "query"
+		tag, ok = memberTag(member, formTagKey)
+		if !ok {
+			return nil, nil
+		}
+	}
+
+	schema, required, err := g.memberSchema(member, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	description := schema.Description
+	schema.Description = ""
+	return &Parameter{
+		Name:        tag.Name,
+		In:          in,
+		Description: description,
+		Required:    required || in == "path",
+		Schema:      schema,
+	}, nil
+}
+
+func (g *generator) findType(name string) (spec.DefineStruct, error) {
+	tp, ok := g.types[name]
+	if !ok {
+		return spec.DefineStruct{}, fmt.Errorf("type %s not defined", name)
+	}
+
+	return tp, nil
+}
+
+func (p *PathItem) set(method string, operation *Operation) error {
+	var target **Operation
+	switch strings.ToLower(method) {
+	case "get":
+		target = &p.Get
+	case "put":
+		target = &p.Put
+	case "post":
+		target = &p.Post
+	case "delete":
+		target = &p.Delete
+	case "options":
+		target = &p.Options
+	case "head":
+		target = &p.Head
+	case "patch":
+		target = &p.Patch
+	case "trace":
+		target = &p.Trace
+	default:
+		return fmt.Errorf("unsupported method %s", method)
+	}
+
+	if *target != nil {
+		return errors.New("duplicate route")
+	}
+
+	*target = operation
+	return nil
+}
+
+func routeSummary(route spec.Route) string {
+	summary := unquote(route.AtDoc.Text)
+	if len(summary) == 0 && route.AtDoc.Properties != nil {
+		summary = unquote(route.AtDoc.Properties[summaryProperty])
+	}
+
+	return summary
+}
+
+// convertPath converts the path parameters such as :id into {id}
+func convertPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") {
+			segments[i] = "{" + segment[1:] + "}"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+func unquote(s string) string {
+	s = strings.TrimSpace(s)
+	s = strings.TrimPrefix(s, `"`)
+	s = strings.TrimSuffix(s, `"`)
+	return strings.TrimSpace(s)
+}
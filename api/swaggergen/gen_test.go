@@ -0,0 +1,157 @@
+package swaggergen
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zeromicro/goctl/api/parser"
+	"github.com/zeromicro/goctl/api/spec"
+	"gopkg.in/yaml.v2"
+)
+
+const testApi = `
+info(
+    title: "user api"
+    desc: "user management"
+    version: "1.1"
+)
+
+type Base {
+  Code int ` + "`" + `json:"code"` + "`" + `
+}
+
+type UserReq {
+  Id int64 ` + "`" + `path:"id"` + "`" + `
+  Fields []string ` + "`" + `form:"fields,optional"` + "`" + `
+}
+
+type User {
+  Id int64 ` + "`" + `json:"id"` + "`" + `
+  Name string ` + "`" + `json:"name"` + "`" + ` // the name of user
+  Gender string ` + "`" + `json:"gender,options=male|female"` + "`" + `
+  Age int ` + "`" + `json:"age,range=[0:150),optional"` + "`" + `
+  Score float64 ` + "`" + `json:"score,default=60"` + "`" + `
+  Tags map[string]string ` + "`" + `json:"tags"` + "`" + `
+  Friends []*User ` + "`" + `json:"friends"` + "`" + `
+  Extra interface{} ` + "`" + `json:"extra"` + "`" + `
+}
+
+type UserReply {
+  Base
+  User User ` + "`" + `json:"user"` + "`" + `
+}
+
+type UpdateReq {
+  Id int64 ` + "`" + `path:"id"` + "`" + `
+  Name string ` + "`" + `json:"name"` + "`" + `
+}
+
+@server(
+  group: user
+)
+service user-api {
+  @doc "get user"
+  @handler GetUser
+  get /user/:id (UserReq) returns (UserReply)
+}
+
+@server(
+  jwt: Auth
+  group: user
+)
+service user-api {
+  @doc(
+    summary: "update user"
+  )
+  @handler UpdateUser
+  put /user/:id (UpdateReq)
+}
+`
+
+func TestNewDocument(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "user.api")
+	err := ioutil.WriteFile(filename, []byte(testApi), 0644)
+	assert.Nil(t, err)
+
+	api, err := parser.Parse(filename)
+	assert.Nil(t, err)
+
+	doc, err := NewDocument(api)
+	assert.Nil(t, err)
+	assert.Equal(t, Info{Title: "user api", Description: "user management", Version: "1.1"}, doc.Info)
+	assert.Equal(t, []Tag{{Name: "user"}}, doc.Tags)
+	assert.Equal(t, map[string]*SecurityScheme{
+		"Auth": {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+	}, doc.Components.SecuritySchemes)
+
+	item := doc.Paths["/user/{id}"]
+	assert.NotNil(t, item)
+
+	get := item.Get
+	assert.Equal(t, "get user", get.Summary)
+	assert.Equal(t, "GetUser", get.OperationID)
+	assert.Equal(t, []string{"user"}, get.Tags)
+	assert.Nil(t, get.Security)
+	assert.Nil(t, get.RequestBody)
+	assert.Equal(t, []*Parameter{
+		{Name: "id", In: "path", Required: true, Schema: &Schema{Type: "integer", Format: "int64"}},
+		{Name: "fields", In: "query", Schema: &Schema{Type: "array", Items: &Schema{Type: "string"}}},
+	}, get.Parameters)
+	assert.Equal(t, refPrefix+"UserReply", get.Responses["200"].Content[jsonContentType].Schema.Ref)
+
+	put := item.Put
+	assert.Equal(t, "update user", put.Summary)
+	assert.Equal(t, []map[string][]string{{"Auth": {}}}, put.Security)
+	assert.Equal(t, refPrefix+"UpdateReq", put.RequestBody.Content[jsonContentType].Schema.Ref)
+	assert.Nil(t, put.Responses["200"].Content)
+
+	reply := doc.Components.Schemas["UserReply"]
+	assert.Equal(t, []string{"code", "user"}, reply.Required)
+	assert.Equal(t, &Schema{Type: "integer", Format: "int64"}, reply.Properties["code"])
+
+	update := doc.Components.Schemas["UpdateReq"]
+	assert.Equal(t, []string{"name"}, update.Required)
+	assert.Len(t, update.Properties, 1)
+
+	min, max := float64(0), float64(150)
+	user := doc.Components.Schemas["User"]
+	assert.Equal(t, []string{"id", "name", "gender", "tags", "friends", "extra"}, user.Required)
+	assert.Equal(t, "the name of user", user.Properties["name"].Description)
+	assert.Equal(t, []interface{}{"male", "female"}, user.Properties["gender"].Enum)
+	assert.Equal(t, &Schema{Type: "integer", Format: "int64", Minimum: &min, Maximum: &max, ExclusiveMaximum: true},
+		user.Properties["age"])
+	assert.Equal(t, float64(60), user.Properties["score"].Default)
+	assert.Equal(t, &Schema{Type: "object", AdditionalProperties: &Schema{Type: "string"}}, user.Properties["tags"])
+	assert.Equal(t, &Schema{Type: "array", Items: &Schema{Ref: refPrefix + "User"}}, user.Properties["friends"])
+	assert.Equal(t, &Schema{}, user.Properties["extra"])
+
+	content, err := Generate(api, false)
+	assert.Nil(t, err)
+	assert.True(t, json.Valid(content))
+
+	content, err = Generate(api, true)
+	assert.Nil(t, err)
+	var v map[string]interface{}
+	assert.Nil(t, yaml.Unmarshal(content, &v))
+	assert.Equal(t, openAPIVersion, v["openapi"])
+}
+
+func TestNewDocumentError(t *testing.T) {
+	_, err := NewDocument(&spec.ApiSpec{
+		Types: []spec.Type{
+			spec.DefineStruct{
+				RawName: "Request",
+				Members: []spec.Member{
+					{Name: "Value", Type: spec.PrimitiveType{RawName: "complex64"}, Tag: `json:"value"`},
+				},
+			},
+		},
+	})
+	assert.NotNil(t, err)
+
+	assert.Equal(t, "/user/{id}/book/{name}", convertPath("/user/:id/book/:name"))
+	assert.NotNil(t, fillRange(&Schema{}, "1:10"))
+}
@@ -0,0 +1,298 @@
+package swaggergen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/zeromicro/goctl/api/spec"
+)
+
+const (
+	optionalOption = "optional"
+	optionsOption  = "options="
+	defaultOption  = "default="
+	rangeOption    = "range="
+	optionsSep     = "|"
+	rangeSep       = ":"
+)
+
+func (g *generator) typeToSchema(tp spec.Type) (*Schema, error) {
+	switch v := tp.(type) {
+	case spec.DefineStruct:
+		name := schemaName(v)
+		if _, err := g.findType(v.RawName); err != nil {
+			return nil, err
+		}
+
+		return &Schema{Ref: refPrefix + name}, nil
+	case spec.PrimitiveType:
+		schema, ok := primitiveSchema(v.RawName)
+		if !ok {
+			return nil, fmt.Errorf("unsupported primitive type %s", v.RawName)
+		}
+
+		return schema, nil
+	case spec.EnumType:
+		schema, _ := primitiveSchema(v.Base)
+		for _, item := range v.Values {
+			schema.Enum = append(schema.Enum, schemaValue(schema, item.Value))
+		}
+
+		return schema, nil
+	case spec.MapType:
+		value, err := g.typeToSchema(v.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Schema{Type: "object", AdditionalProperties: value}, nil
+	case spec.ArrayType:
+		if v.RawName == "[]byte" {
+			return &Schema{Type: "string", Format: "byte"}, nil
+		}
+
+		value, err := g.typeToSchema(v.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Schema{Type: "array", Items: value}, nil
+	case spec.InterfaceType:
+		return &Schema{}, nil
+	case spec.PointerType:
+		return g.typeToSchema(v.Type)
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", tp.Name())
+}
+
+// structSchema converts the json members of tp into an object schema,
+// the path and form members are converted into parameters instead
+func (g *generator) structSchema(tp spec.DefineStruct) (*Schema, error) {
+	members, err := g.expandMembers(tp)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := &Schema{
+		Type:        "object",
+		Description: joinDocs(tp.Docs),
+		Properties:  make(map[string]*Schema),
+	}
+	for _, member := range members {
+		tag, ok := memberTag(member, bodyTagKey)
+		if !ok || tag.Name == "-" {
+			continue
+		}
+
+		property, required, err := g.memberSchema(member, tag)
+		if err != nil {
+			return nil, err
+		}
+
+		schema.Properties[tag.Name] = property
+		if required {
+			schema.Required = append(schema.Required, tag.Name)
+		}
+	}
+
+	return schema, nil
+}
+
+// memberSchema converts member into a schema with the options in tag,
+// such as optional, options, default and range
+func (g *generator) memberSchema(member spec.Member, tag *spec.Tag) (*Schema, bool, error) {
+	schema, err := g.typeToSchema(member.Type)
+	if err != nil {
+		return nil, false, err
+	}
+
+	description := memberDescription(member)
+	if len(schema.Ref) > 0 {
+		// the siblings of $ref are ignored, so the description is dropped
+		return schema, !isOptional(tag), nil
+	}
+
+	schema.Description = description
+	if _, ok := member.Type.(spec.PointerType); ok {
+		schema.Nullable = true
+	}
+
+	for _, option := range tag.Options {
+		switch {
+		case strings.HasPrefix(option, optionsOption):
+			for _, item := range strings.Split(strings.TrimPrefix(option, optionsOption), optionsSep) {
+				schema.Enum = append(schema.Enum, schemaValue(schema, item))
+			}
+		case strings.HasPrefix(option, defaultOption):
+			schema.Default = schemaValue(schema, strings.TrimPrefix(option, defaultOption))
+		case strings.HasPrefix(option, rangeOption):
+			err = fillRange(schema, strings.TrimPrefix(option, rangeOption))
+			if err != nil {
+				return nil, false, fmt.Errorf("member %s: %v", member.Name, err)
+			}
+		}
+	}
+
+	return schema, !isOptional(tag), nil
+}
+
+// expandMembers returns the members of tp with the inline structures expanded
+func (g *generator) expandMembers(tp spec.DefineStruct) ([]spec.Member, error) {
+	var members []spec.Member
+	for _, member := range tp.Members {
+		if !member.IsInline {
+			members = append(members, member)
+			continue
+		}
+
+		inline, err := g.findType(member.Type.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		list, err := g.expandMembers(inline)
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, list...)
+	}
+
+	return members, nil
+}
+
+func primitiveSchema(tp string) (*Schema, bool) {
+	switch tp {
+	case "string":
+		return &Schema{Type: "string"}, true
+	case "bool":
+		return &Schema{Type: "boolean"}, true
+	case "int", "int64", "uint", "uint64":
+		return &Schema{Type: "integer", Format: "int64"}, true
+	case "int8", "int16", "int32", "uint8", "uint16", "uint32", "byte", "rune":
+		return &Schema{Type: "integer", Format: "int32"}, true
+	case "float32":
+		return &Schema{Type: "number", Format: "float"}, true
+	case "float64":
+		return &Schema{Type: "number", Format: "double"}, true
+	}
+
+	return nil, false
+}
+
+func schemaValue(schema *Schema, value string) interface{} {
+	switch schema.Type {
+	case "integer":
+		if v, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return v
+		}
+	case "number":
+		if v, err := strconv.ParseFloat(value, 64); err == nil {
+			return v
+		}
+	case "boolean":
+		if v, err := strconv.ParseBool(value); err == nil {
+			return v
+		}
+	}
+
+	return value
+}
+
+// fillRange fills the bounds of the range option, such as [1:10], (0:100] and [1:]
+func fillRange(schema *Schema, value string) error {
+	if len(value) < 2 {
+		return fmt.Errorf("invalid range %q", value)
+	}
+
+	left, right := value[0], value[len(value)-1]
+	if (left != '[' && left != '(') || (right != ']' && right != ')') {
+		return fmt.Errorf("invalid range %q", value)
+	}
+
+	bounds := strings.Split(value[1:len(value)-1], rangeSep)
+	if len(bounds) != 2 {
+		return fmt.Errorf("invalid range %q", value)
+	}
+
+	if len(bounds[0]) > 0 {
+		min, err := strconv.ParseFloat(bounds[0], 64)
+		if err != nil {
+			return err
+		}
+
+		schema.Minimum = &min
+		schema.ExclusiveMinimum = left == '('
+	}
+
+	if len(bounds[1]) > 0 {
+		max, err := strconv.ParseFloat(bounds[1], 64)
+		if err != nil {
+			return err
+		}
+
+		schema.Maximum = &max
+		schema.ExclusiveMaximum = right == ')'
+	}
+
+	return nil
+}
+
+func memberTag(member spec.Member, key string) (*spec.Tag, bool) {
+	for _, tag := range member.Tags() {
+		if tag.Key == key {
+			return tag, true
+		}
+	}
+
+	return nil, false
+}
+
+func isOptional(tag *spec.Tag) bool {
+	for _, option := range tag.Options {
+		if option == optionalOption || option == "omitempty" || strings.HasPrefix(option, defaultOption) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func memberDescription(member spec.Member) string {
+	var docs []string
+	for _, doc := range member.Docs {
+		docs = append(docs, trimComment(doc))
+	}
+
+	if comment := trimComment(member.GetComment()); len(comment) > 0 {
+		docs = append(docs, comment)
+	}
+
+	return strings.Join(docs, " ")
+}
+
+func joinDocs(docs spec.Doc) string {
+	var list []string
+	for _, doc := range docs {
+		list = append(list, trimComment(doc))
+	}
+
+	return strings.Join(list, " ")
+}
+
+func trimComment(comment string) string {
+	comment = strings.TrimSpace(comment)
+	if strings.HasPrefix(comment, "//") {
+		return strings.TrimSpace(strings.TrimPrefix(comment, "//"))
+	}
+
+	comment = strings.TrimPrefix(comment, "/*")
+	comment = strings.TrimSuffix(comment, "*/")
+	return strings.TrimSpace(comment)
+}
+
+func schemaName(tp spec.DefineStruct) string {
+	return strings.ReplaceAll(tp.RawName, ".", "")
+}
@@ -0,0 +1,112 @@
+package swaggergen
+
+const (
+	openAPIVersion  = "3.0.3"
+	jsonContentType = "application/json"
+	refPrefix       = "#/components/schemas/"
+)
+
+type (
+	// Document describes an OpenAPI 3 document
+	Document struct {
+		OpenAPI    string               `json:"openapi" yaml:"openapi"`
+		Info       Info                 `json:"info" yaml:"info"`
+		Tags       []Tag                `json:"tags,omitempty" yaml:"tags,omitempty"`
+		Paths      map[string]*PathItem `json:"paths" yaml:"paths"`
+		Components Components           `json:"components,omitempty" yaml:"components,omitempty"`
+	}
+
+	// Info describes the metadata of the api
+	Info struct {
+		Title       string `json:"title" yaml:"title"`
+		Description string `json:"description,omitempty" yaml:"description,omitempty"`
+		Version     string `json:"version" yaml:"version"`
+	}
+
+	// Tag describes a group of operations
+	Tag struct {
+		Name string `json:"name" yaml:"name"`
+	}
+
+	// PathItem describes the operations available on a single path
+	PathItem struct {
+		Get     *Operation `json:"get,omitempty" yaml:"get,omitempty"`
+		Put     *Operation `json:"put,omitempty" yaml:"put,omitempty"`
+		Post    *Operation `json:"post,omitempty" yaml:"post,omitempty"`
+		Delete  *Operation `json:"delete,omitempty" yaml:"delete,omitempty"`
+		Options *Operation `json:"options,omitempty" yaml:"options,omitempty"`
+		Head    *Operation `json:"head,omitempty" yaml:"head,omitempty"`
+		Patch   *Operation `json:"patch,omitempty" yaml:"patch,omitempty"`
+		Trace   *Operation `json:"trace,omitempty" yaml:"trace,omitempty"`
+	}
+
+	// Operation describes a single api operation on a path
+	Operation struct {
+		Tags        []string              `json:"tags,omitempty" yaml:"tags,omitempty"`
+		Summary     string                `json:"summary,omitempty" yaml:"summary,omitempty"`
+		Description string                `json:"description,omitempty" yaml:"description,omitempty"`
+		OperationID string                `json:"operationId,omitempty" yaml:"operationId,omitempty"`
+		Parameters  []*Parameter          `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+		RequestBody *RequestBody          `json:"requestBody,omitempty" yaml:"requestBody,omitempty"`
+		Responses   map[string]*Response  `json:"responses" yaml:"responses"`
+		Security    []map[string][]string `json:"security,omitempty" yaml:"security,omitempty"`
+	}
+
+	// Parameter describes a path or query parameter
+	Parameter struct {
+		Name        string  `json:"name" yaml:"name"`
+		In          string  `json:"in" yaml:"in"`
+		Description string  `json:"description,omitempty" yaml:"description,omitempty"`
+		Required    bool    `json:"required,omitempty" yaml:"required,omitempty"`
+		Schema      *Schema `json:"schema" yaml:"schema"`
+	}
+
+	// RequestBody describes the request body of an operation
+	RequestBody struct {
+		Required bool                  `json:"required,omitempty" yaml:"required,omitempty"`
+		Content  map[string]*MediaType `json:"content" yaml:"content"`
+	}
+
+	// Response describes a response of an operation
+	Response struct {
+		Description string                `json:"description" yaml:"description"`
+		Content     map[string]*MediaType `json:"content,omitempty" yaml:"content,omitempty"`
+	}
+
+	// MediaType describes the schema of a content type
+	MediaType struct {
+		Schema *Schema `json:"schema" yaml:"schema"`
+	}
+
+	// Components holds the reusable schemas and security schemes
+	Components struct {
+		Schemas         map[string]*Schema         `json:"schemas,omitempty" yaml:"schemas,omitempty"`
+		SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes,omitempty" yaml:"securitySchemes,omitempty"`
+	}
+
+	// SecurityScheme describes a security scheme, the jwt of api is a http bearer scheme
+	SecurityScheme struct {
+		Type         string `json:"type" yaml:"type"`
+		Scheme       string `json:"scheme,omitempty" yaml:"scheme,omitempty"`
+		BearerFormat string `json:"bearerFormat,omitempty" yaml:"bearerFormat,omitempty"`
+	}
+
+	// Schema describes a data type
+	Schema struct {
+		Ref                  string             `json:"$ref,omitempty" yaml:"$ref,omitempty"`
+		Type                 string             `json:"type,omitempty" yaml:"type,omitempty"`
+		Format               string             `json:"format,omitempty" yaml:"format,omitempty"`
+		Description          string             `json:"description,omitempty" yaml:"description,omitempty"`
+		Nullable             bool               `json:"nullable,omitempty" yaml:"nullable,omitempty"`
+		Default              interface{}        `json:"default,omitempty" yaml:"default,omitempty"`
+		Enum                 []interface{}      `json:"enum,omitempty" yaml:"enum,omitempty"`
+		Minimum              *float64           `json:"minimum,omitempty" yaml:"minimum,omitempty"`
+		ExclusiveMinimum     bool               `json:"exclusiveMinimum,omitempty" yaml:"exclusiveMinimum,omitempty"`
+		Maximum              *float64           `json:"maximum,omitempty" yaml:"maximum,omitempty"`
+		ExclusiveMaximum     bool               `json:"exclusiveMaximum,omitempty" yaml:"exclusiveMaximum,omitempty"`
+		Items                *Schema            `json:"items,omitempty" yaml:"items,omitempty"`
+		Properties           map[string]*Schema `json:"properties,omitempty" yaml:"properties,omitempty"`
+		AdditionalProperties *Schema            `json:"additionalProperties,omitempty" yaml:"additionalProperties,omitempty"`
+		Required             []string           `json:"required,omitempty" yaml:"required,omitempty"`
+	}
+)
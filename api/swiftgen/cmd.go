@@ -0,0 +1,35 @@
+package swiftgen
+
+import (
+	"errors"
+
+	"github.com/urfave/cli"
+	"github.com/zeromicro/goctl/api/parser"
+)
+
+// SwiftCommand the generate swift code command entrance
+func SwiftCommand(c *cli.Context) error {
+	apiFile := c.String("api")
+	if apiFile == "" {
+		return errors.New("missing -api")
+	}
+	dir := c.String("dir")
+	if dir == "" {
+		return errors.New("missing -dir")
+	}
+
+	api, e := parser.Parse(apiFile)
+	if e != nil {
+		return e
+	}
+
+	e = genBase(dir)
+	if e != nil {
+		return e
+	}
+	e = genTypes(dir, api)
+	if e != nil {
+		return e
+	}
+	return genGroups(dir, api)
+}
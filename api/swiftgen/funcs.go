@@ -0,0 +1,311 @@
+package swiftgen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/tal-tech/go-zero/core/stringx"
+	"github.com/zeromicro/goctl/api/spec"
+	"github.com/zeromicro/goctl/api/util"
+)
+
+const (
+	bodyTagKey   = "json"
+	pathTagKey   = "path"
+	formTagKey   = "form"
+	headerTagKey = "header"
+)
+
+var keywords = []string{
+	"Any", "Self", "Type", "as", "associatedtype", "break", "case", "catch", "class", "continue", "default",
+	"defer", "deinit", "do", "else", "enum", "extension", "fallthrough", "false", "fileprivate", "for", "func",
+	"guard", "if", "import", "in", "init", "inout", "internal", "is", "let", "nil", "open", "operator",
+	"private", "protocol", "public", "repeat", "rethrows", "return", "self", "static", "struct", "subscript",
+	"super", "switch", "throw", "throws", "true", "try", "typealias", "var", "where", "while",
+}
+
+// property describes a member of the struct, the members of the inline structs are included
+type property struct {
+	// Name is the name of the swift property
+	Name string
+	// Key is the name in the tag, such as name of json:"name"
+	Key string
+	// Location is the tag key of the member, json, path, form or header
+	Location string
+	Type     string
+	Optional bool
+	Enum     bool
+	Docs     []string
+	Comment  string
+}
+
+func lowCamelCase(s string) string {
+	if len(s) < 1 {
+		return ""
+	}
+
+	s = util.ToCamelCase(util.ToSnakeCase(s))
+	return escape(util.ToLower(s[:1]) + s[1:])
+}
+
+// escape quotes the swift keywords with backticks
+func escape(name string) string {
+	if stringx.Contains(keywords, name) {
+		return "`" + name + "`"
+	}
+
+	return name
+}
+
+func typeName(name string) string {
+	return strcase.ToCamel(name)
+}
+
+// swiftType converts the api type into the swift type, the pointers are optional
+func swiftType(tp spec.Type) (string, error) {
+	switch v := tp.(type) {
+	case spec.DefineStruct:
+		return typeName(v.TypeName), nil
+	case spec.EnumType:
+		return typeName(v.Name()), nil
+	case spec.PrimitiveType:
+		return primitiveType(v.Name())
+	case spec.PointerType:
+		value, err := swiftType(v.Type)
+		if err != nil {
+			return "", err
+		}
+
+		return value + "?", nil
+	case spec.ArrayType:
+		if v.Name() == "[]byte" {
+			return "Data", nil
+		}
+
+		value, err := swiftType(v.Value)
+		if err != nil {
+			return "", err
+		}
+
+		return "[" + value + "]", nil
+	case spec.MapType:
+		value, err := swiftType(v.Value)
+		if err != nil {
+			return "", err
+		}
+
+		// only the dictionaries keyed by String or Int are encoded as json objects
+		key := "String"
+		if k, err := primitiveType(v.Key); err == nil && strings.Contains(k, "Int") {
+			key = "Int"
+		}
+
+		return fmt.Sprintf("[%s: %s]", key, value), nil
+	case spec.InterfaceType:
+		return "JSONValue", nil
+	}
+
+	return "", fmt.Errorf("unsupported type %s", tp.Name())
+}
+
+func primitiveType(tp string) (string, error) {
+	switch tp {
+	case "string":
+		return "String", nil
+	case "bool":
+		return "Bool", nil
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+		return strcase.ToCamel(strings.Replace(tp, "uint", "UInt", 1)), nil
+	case "byte":
+		return "UInt8", nil
+	case "rune":
+		return "Int32", nil
+	case "float32":
+		return "Float", nil
+	case "float", "float64":
+		return "Double", nil
+	}
+
+	return "", fmt.Errorf("unsupported type %s", tp)
+}
+
+// zeroValue returns the default value of the swift type, it returns empty if the type has no zero value
+func zeroValue(tp string) string {
+	switch {
+	case strings.HasSuffix(tp, "?"):
+		return "nil"
+	case tp == "String":
+		return `""`
+	case tp == "Bool":
+		return "false"
+	case tp == "Data":
+		return "Data()"
+	case tp == "Float" || tp == "Double" || strings.Contains(tp, "Int") && !strings.HasPrefix(tp, "["):
+		return "0"
+	case strings.HasPrefix(tp, "[") && strings.Contains(tp, ":"):
+		return "[:]"
+	case strings.HasPrefix(tp, "["):
+		return "[]"
+	}
+
+	return ""
+}
+
+// resolve returns the declaration of the struct or the enum referenced by tp
+func resolve(types map[string]spec.Type, tp spec.Type) spec.Type {
+	if v, ok := tp.(spec.DefineStruct); ok {
+		if declared, ok := types[v.Name()]; ok {
+			return declared
+		}
+	}
+
+	return tp
+}
+
+// properties returns the members of the struct with the members of the inline structs,
+// the members ignored by json:"-" are excluded
+func properties(types map[string]spec.Type, tp spec.DefineStruct) ([]property, error) {
+	var result []property
+	for _, member := range tp.Members {
+		if member.IsInline {
+			memberType := member.Type
+			if pointer, ok := memberType.(spec.PointerType); ok {
+				memberType = pointer.Type
+			}
+
+			inline, ok := resolve(types, memberType).(spec.DefineStruct)
+			if !ok {
+				return nil, fmt.Errorf("inline type %s of %s is not a struct", member.Type.Name(), tp.Name())
+			}
+
+			values, err := properties(types, inline)
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, values...)
+			continue
+		}
+
+		item, ok, err := toProperty(types, member)
+		if err != nil {
+			return nil, fmt.Errorf("type %s field %s: %v", tp.Name(), member.Name, err)
+		}
+		if ok {
+			result = append(result, item)
+		}
+	}
+
+	return result, nil
+}
+
+func toProperty(types map[string]spec.Type, member spec.Member) (property, bool, error) {
+	tp, err := swiftType(member.Type)
+	if err != nil {
+		return property{}, false, err
+	}
+
+	item := property{
+		Name:     lowCamelCase(member.Name),
+		Key:      member.Name,
+		Location: bodyTagKey,
+		Type:     tp,
+		Docs:     member.Docs,
+		Comment:  member.GetComment(),
+	}
+	memberType := member.Type
+	if pointer, ok := memberType.(spec.PointerType); ok {
+		memberType = pointer.Type
+	}
+	_, item.Enum = resolve(types, memberType).(spec.EnumType)
+
+	tags, err := spec.Parse(member.Tag)
+	if err != nil {
+		return property{}, false, err
+	}
+
+	for _, key := range []string{pathTagKey, formTagKey, headerTagKey, bodyTagKey} {
+		tag, err := tags.Get(key)
+		if err != nil {
+			continue
+		}
+		if tag.Name == "-" {
+			return property{}, false, nil
+		}
+
+		item.Key = tag.Name
+		item.Location = key
+		for _, option := range tag.Options {
+			if option == "optional" || option == "omitempty" || strings.HasPrefix(option, "default=") {
+				item.Optional = true
+			}
+		}
+		break
+	}
+
+	if item.Optional && !strings.HasSuffix(item.Type, "?") {
+		item.Type += "?"
+	}
+	// the members out of the json body are not decoded, so they need the default values
+	if item.Location != bodyTagKey && len(zeroValue(item.Type)) == 0 {
+		item.Type += "?"
+	}
+	item.Optional = strings.HasSuffix(item.Type, "?")
+
+	return item, true, nil
+}
+
+// isRecursive returns true if the struct contains itself without an array or a map between,
+// such structs are declared as classes because a swift struct can't contain itself
+func isRecursive(types map[string]spec.Type, tp spec.DefineStruct) bool {
+	return containsStruct(types, tp.Name(), tp, make(map[string]bool))
+}
+
+func containsStruct(types map[string]spec.Type, name string, tp spec.Type, visited map[string]bool) bool {
+	if pointer, ok := tp.(spec.PointerType); ok {
+		tp = pointer.Type
+	}
+
+	v, ok := resolve(types, tp).(spec.DefineStruct)
+	if !ok || visited[v.Name()] {
+		return false
+	}
+
+	visited[v.Name()] = true
+	for _, member := range v.Members {
+		memberType := member.Type
+		if pointer, ok := memberType.(spec.PointerType); ok {
+			memberType = pointer.Type
+		}
+		if _, ok := memberType.(spec.DefineStruct); ok && memberType.Name() == name {
+			return true
+		}
+		if containsStruct(types, name, memberType, visited) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// handlerName returns the name of the client function, such as getUser of GetUserHandler
+func handlerName(route spec.Route) (string, error) {
+	handler := route.Handler
+	if len(handler) == 0 {
+		return "", fmt.Errorf("missing handler annotation for route %q", route.Path)
+	}
+
+	return lowCamelCase(strings.TrimSuffix(handler, "Handler")), nil
+}
+
+// docs converts the comments into swift documentation comments
+func docs(lines []string, indent string) string {
+	var builder strings.Builder
+	for _, line := range lines {
+		line = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "//"))
+		builder.WriteString(indent + "/// " + line + "\n")
+	}
+
+	return builder.String()
+}
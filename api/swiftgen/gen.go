@@ -0,0 +1,520 @@
+package swiftgen
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/iancoleman/strcase"
+	"github.com/zeromicro/goctl/api/spec"
+	"github.com/zeromicro/goctl/util"
+)
+
+const (
+	apiBaseTemplate = `import Foundation
+
+public enum ApiError: Error {
+    case invalidUrl(String)
+    case invalidResponse
+    // status is thrown if the status code of the response is not 2xx
+    case status(code: Int, body: Data)
+}
+
+// Empty is the body of the requests and the responses without types
+public struct Empty: Codable {
+    public init() {}
+}
+
+// QueryValue is the value of the path and form parameters
+public protocol QueryValue {
+    var queryValue: String { get }
+}
+
+extension QueryValue where Self: CustomStringConvertible {
+    public var queryValue: String { description }
+}
+
+extension QueryValue where Self: RawRepresentable, Self.RawValue: QueryValue {
+    public var queryValue: String { rawValue.queryValue }
+}
+
+extension String: QueryValue {}
+extension Bool: QueryValue {}
+extension Int: QueryValue {}
+extension Int8: QueryValue {}
+extension Int16: QueryValue {}
+extension Int32: QueryValue {}
+extension Int64: QueryValue {}
+extension UInt: QueryValue {}
+extension UInt8: QueryValue {}
+extension UInt16: QueryValue {}
+extension UInt32: QueryValue {}
+extension UInt64: QueryValue {}
+extension Float: QueryValue {}
+extension Double: QueryValue {}
+
+public struct Query {
+    public private(set) var items: [URLQueryItem] = []
+
+    public init() {}
+
+    public mutating func add<T: QueryValue>(_ name: String, _ value: T?) {
+        if let value = value {
+            items.append(URLQueryItem(name: name, value: value.queryValue))
+        }
+    }
+
+    public mutating func add<T: QueryValue>(_ name: String, _ values: [T]?) {
+        values?.forEach { items.append(URLQueryItem(name: name, value: $0.queryValue)) }
+    }
+}
+
+// JSONValue is the value of interface{}
+public enum JSONValue: Codable {
+    case null
+    case bool(Bool)
+    case number(Double)
+    case string(String)
+    case array([JSONValue])
+    case object([String: JSONValue])
+
+    public init(from decoder: Decoder) throws {
+        let container = try decoder.singleValueContainer()
+        if container.decodeNil() {
+            self = .null
+        } else if let value = try? container.decode(Bool.self) {
+            self = .bool(value)
+        } else if let value = try? container.decode(Double.self) {
+            self = .number(value)
+        } else if let value = try? container.decode(String.self) {
+            self = .string(value)
+        } else if let value = try? container.decode([JSONValue].self) {
+            self = .array(value)
+        } else {
+            self = .object(try container.decode([String: JSONValue].self))
+        }
+    }
+
+    public func encode(to encoder: Encoder) throws {
+        var container = encoder.singleValueContainer()
+        switch self {
+        case .null:
+            try container.encodeNil()
+        case .bool(let value):
+            try container.encode(value)
+        case .number(let value):
+            try container.encode(value)
+        case .string(let value):
+            try container.encode(value)
+        case .array(let value):
+            try container.encode(value)
+        case .object(let value):
+            try container.encode(value)
+        }
+    }
+}
+
+@available(iOS 15.0, macOS 12.0, tvOS 15.0, watchOS 8.0, *)
+public final class ApiClient {
+    public let baseUrl: String
+    public let session: URLSession
+    // token is sent as the bearer token of the routes in the jwt groups
+    public var token: String?
+    // headers are sent with every request
+    public var headers: [String: String] = [:]
+    public var encoder = JSONEncoder()
+    public var decoder = JSONDecoder()
+
+    public init(baseUrl: String = "http://localhost:8888", session: URLSession = .shared, token: String? = nil) {
+        self.baseUrl = baseUrl
+        self.session = session
+        self.token = token
+    }
+
+    public static func escape<T: QueryValue>(_ value: T?) -> String {
+        guard let value = value else {
+            return ""
+        }
+
+        var allowed = CharacterSet.urlPathAllowed
+        allowed.remove(charactersIn: "/")
+        return value.queryValue.addingPercentEncoding(withAllowedCharacters: allowed) ?? value.queryValue
+    }
+
+    public func send<Body: Encodable, Response: Decodable>(_ method: String, _ path: String, query: Query = Query(),
+        headers: [String: String] = [:], body: Body?, auth: Bool = false) async throws -> Response {
+        guard var components = URLComponents(string: baseUrl + path) else {
+            throw ApiError.invalidUrl(baseUrl + path)
+        }
+        if !query.items.isEmpty {
+            components.queryItems = query.items
+            components.percentEncodedQuery = components.percentEncodedQuery?.replacingOccurrences(of: "+", with: "%2B")
+        }
+        guard let url = components.url else {
+            throw ApiError.invalidUrl(baseUrl + path)
+        }
+
+        var request = URLRequest(url: url)
+        request.httpMethod = method
+        for (key, value) in self.headers.merging(headers, uniquingKeysWith: { $1 }) {
+            request.setValue(value, forHTTPHeaderField: key)
+        }
+        if auth, let token = token {
+            request.setValue("Bearer \(token)", forHTTPHeaderField: "Authorization")
+        }
+        if let body = body {
+            request.setValue("application/json", forHTTPHeaderField: "Content-Type")
+            request.httpBody = try encoder.encode(body)
+        }
+
+        let (data, response) = try await session.data(for: request)
+        guard let httpResponse = response as? HTTPURLResponse else {
+            throw ApiError.invalidResponse
+        }
+        guard (200..<300).contains(httpResponse.statusCode) else {
+            throw ApiError.status(code: httpResponse.statusCode, body: data)
+        }
+        if let empty = Empty() as? Response {
+            return empty
+        }
+
+        return try decoder.decode(Response.self, from: data)
+    }
+}
+`
+
+	typesTemplate = `// Code generated by goctl. DO NOT EDIT.
+import Foundation
+{{range .}}
+{{.}}{{end}}`
+
+	groupTemplate = `// Code generated by goctl. DO NOT EDIT.
+import Foundation
+
+@available(iOS 15.0, macOS 12.0, tvOS 15.0, watchOS 8.0, *)
+public final class {{.Name}} {
+    private let client: ApiClient
+
+    public init(client: ApiClient) {
+        self.client = client
+    }
+{{range .Methods}}
+{{.}}{{end}}}
+`
+)
+
+const indent = "    "
+
+func genBase(dir string) error {
+	e := util.MkdirIfNotExist(dir)
+	if e != nil {
+		return e
+	}
+	path := filepath.Join(dir, "BaseApi.swift")
+	if _, e := os.Stat(path); e == nil {
+		if util.IsDryRun() {
+			util.SkipFile(path)
+		} else {
+			fmt.Println("BaseApi.swift already exists, skipped it.")
+		}
+		return nil
+	}
+
+	return writeFile(path, apiBaseTemplate, nil)
+}
+
+func genTypes(dir string, api *spec.ApiSpec) error {
+	types := typeMap(api)
+	var items []string
+	for _, tp := range allTypes(api) {
+		var item string
+		var e error
+		switch v := tp.(type) {
+		case spec.DefineStruct:
+			item, e = buildStruct(types, v)
+		case spec.EnumType:
+			item = buildEnum(v)
+		default:
+			e = fmt.Errorf("unsupported type %s", tp.Name())
+		}
+		if e != nil {
+			return e
+		}
+
+		items = append(items, item)
+	}
+
+	name := strcase.ToCamel(strings.TrimSuffix(api.Service.Name, "-api")) + "Types.swift"
+	return writeFile(filepath.Join(dir, name), typesTemplate, items)
+}
+
+func genGroups(dir string, api *spec.ApiSpec) error {
+	types := typeMap(api)
+	var names []string
+	methodsM := make(map[string][]string)
+	for _, group := range api.Service.Groups {
+		name := group.GetAnnotation("group")
+		if len(name) == 0 {
+			name = strings.TrimSuffix(api.Service.Name, "-api")
+		}
+		name = strcase.ToCamel(strings.NewReplacer("/", "_", "-", "_").Replace(name)) + "Api"
+		if _, ok := methodsM[name]; !ok {
+			names = append(names, name)
+		}
+
+		auth := len(group.GetAnnotation("jwt")) > 0
+		for _, route := range group.Routes {
+			method, e := buildMethod(types, group, route, auth)
+			if e != nil {
+				return e
+			}
+
+			methodsM[name] = append(methodsM[name], method)
+		}
+	}
+
+	for _, name := range names {
+		e := writeFile(filepath.Join(dir, name+".swift"), groupTemplate, map[string]interface{}{
+			"Name":    name,
+			"Methods": methodsM[name],
+		})
+		if e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+func writeFile(path, text string, data interface{}) error {
+	t, e := template.New(filepath.Base(path)).Parse(text)
+	if e != nil {
+		return e
+	}
+
+	var buffer bytes.Buffer
+	e = t.Execute(&buffer, data)
+	if e != nil {
+		return e
+	}
+	return util.WriteFile(path, buffer.Bytes(), 0644)
+}
+
+func allTypes(api *spec.ApiSpec) []spec.Type {
+	types := api.Types
+	for _, item := range api.Imports {
+		types = append(types, item.Types...)
+	}
+
+	return types
+}
+
+func typeMap(api *spec.ApiSpec) map[string]spec.Type {
+	types := make(map[string]spec.Type)
+	for _, tp := range allTypes(api) {
+		types[tp.Name()] = tp
+	}
+
+	return types
+}
+
+func buildEnum(tp spec.EnumType) string {
+	base := "Int64"
+	if tp.IsString() {
+		base = "String"
+	}
+
+	var builder strings.Builder
+	builder.WriteString(docs(tp.Docs, ""))
+	fmt.Fprintf(&builder, "public enum %s: %s, Codable, QueryValue {\n", typeName(tp.Name()), base)
+	for _, value := range tp.Values {
+		literal := value.Value
+		if tp.IsString() {
+			literal = strconv.Quote(literal)
+		}
+
+		builder.WriteString(docs(value.Docs, indent))
+		fmt.Fprintf(&builder, "%scase %s = %s", indent, lowCamelCase(value.Name), literal)
+		if len(value.Comment) > 0 {
+			fmt.Fprintf(&builder, " %s", value.Comment)
+		}
+		builder.WriteString("\n")
+	}
+	builder.WriteString("}\n")
+	return builder.String()
+}
+
+func buildStruct(types map[string]spec.Type, tp spec.DefineStruct) (string, error) {
+	props, e := properties(types, tp)
+	if e != nil {
+		return "", e
+	}
+
+	kind := "struct"
+	if isRecursive(types, tp) {
+		kind = "final class"
+	}
+
+	var builder strings.Builder
+	builder.WriteString(docs(tp.Docs, ""))
+	fmt.Fprintf(&builder, "public %s %s: Codable {\n", kind, typeName(tp.TypeName))
+	var body, params []string
+	for _, prop := range props {
+		builder.WriteString(docs(prop.Docs, indent))
+		fmt.Fprintf(&builder, "%spublic var %s: %s", indent, prop.Name, prop.Type)
+		param := fmt.Sprintf("%s: %s", prop.Name, prop.Type)
+		if prop.Location != bodyTagKey {
+			fmt.Fprintf(&builder, " = %s", zeroValue(prop.Type))
+		}
+		if prop.Location != bodyTagKey || prop.Optional {
+			param += " = " + zeroValue(prop.Type)
+		}
+		if len(prop.Comment) > 0 {
+			fmt.Fprintf(&builder, " %s", prop.Comment)
+		}
+		builder.WriteString("\n")
+
+		params = append(params, param)
+		if prop.Location == bodyTagKey {
+			body = append(body, prop.key())
+		}
+	}
+
+	builder.WriteString("\n")
+	if len(body) > 0 {
+		fmt.Fprintf(&builder, "%senum CodingKeys: String, CodingKey {\n", indent)
+		for _, item := range body {
+			fmt.Fprintf(&builder, "%s%scase %s\n", indent, indent, item)
+		}
+		fmt.Fprintf(&builder, "%s}\n\n", indent)
+	} else {
+		// nothing is encoded or decoded if there is no json member
+		fmt.Fprintf(&builder, "%spublic init(from decoder: Decoder) throws {}\n\n", indent)
+		fmt.Fprintf(&builder, "%spublic func encode(to encoder: Encoder) throws {}\n\n", indent)
+	}
+
+	fmt.Fprintf(&builder, "%spublic init(%s) {\n", indent, strings.Join(params, ", "))
+	for _, prop := range props {
+		fmt.Fprintf(&builder, "%s%sself.%s = %s\n", indent, indent, prop.Name, prop.Name)
+	}
+	fmt.Fprintf(&builder, "%s}\n", indent)
+	builder.WriteString("}\n")
+	return builder.String(), nil
+}
+
+// key returns the case of CodingKeys, such as name or userName = "user_name"
+func (p property) key() string {
+	if p.Name == p.Key || p.Name == "`"+p.Key+"`" {
+		return p.Name
+	}
+
+	return fmt.Sprintf("%s = %s", p.Name, strconv.Quote(p.Key))
+}
+
+func buildMethod(types map[string]spec.Type, group spec.Group, route spec.Route, auth bool) (string, error) {
+	name, e := handlerName(route)
+	if e != nil {
+		return "", e
+	}
+
+	var props []property
+	request := ""
+	if len(route.RequestTypeName()) > 0 {
+		tp, ok := resolve(types, route.RequestType).(spec.DefineStruct)
+		if !ok {
+			return "", fmt.Errorf("request type %s of route %s is not a struct", route.RequestTypeName(), route.Path)
+		}
+
+		props, e = properties(types, tp)
+		if e != nil {
+			return "", e
+		}
+
+		request = "_ req: " + typeName(tp.TypeName)
+	}
+
+	response := ""
+	responseType := "Empty"
+	if len(route.ResponseTypeName()) > 0 {
+		responseType, e = swiftType(route.ResponseType)
+		if e != nil {
+			return "", e
+		}
+
+		response = " -> " + responseType
+	}
+
+	var builder strings.Builder
+	doc := strings.Trim(route.JoinedDoc(), `"`)
+	if len(doc) > 0 {
+		builder.WriteString(docs([]string{doc}, indent))
+	}
+	fmt.Fprintf(&builder, "%s/// %s %s\n", indent, strings.ToUpper(route.Method), route.Path)
+	fmt.Fprintf(&builder, "%spublic func %s(%s) async throws%s {\n", indent, name, request, response)
+
+	path := route.Path
+	prefix := strings.Trim(group.GetAnnotation("pathPrefix"), `"`)
+	if len(prefix) > 0 {
+		path = strings.TrimSuffix(prefix, "/") + "/" + strings.TrimPrefix(path, "/")
+	}
+
+	var hasQuery, hasHeader, hasBody bool
+	for _, prop := range props {
+		switch prop.Location {
+		case pathTagKey:
+			path = strings.Replace(path, ":"+prop.Key, fmt.Sprintf(`\(ApiClient.escape(req.%s))`, prop.Name), 1)
+		case formTagKey:
+			if !hasQuery {
+				fmt.Fprintf(&builder, "%s%svar query = Query()\n", indent, indent)
+				hasQuery = true
+			}
+			fmt.Fprintf(&builder, "%s%squery.add(%s, req.%s)\n", indent, indent, strconv.Quote(prop.Key), prop.Name)
+		case bodyTagKey:
+			hasBody = true
+		}
+	}
+	for _, prop := range props {
+		if prop.Location != headerTagKey {
+			continue
+		}
+		if !hasHeader {
+			fmt.Fprintf(&builder, "%s%svar headers: [String: String] = [:]\n", indent, indent)
+			hasHeader = true
+		}
+
+		value := "req." + prop.Name
+		if prop.Optional {
+			value += "?"
+		}
+		fmt.Fprintf(&builder, "%s%sheaders[%s] = %s.queryValue\n", indent, indent, strconv.Quote(prop.Key), value)
+	}
+
+	args := []string{strconv.Quote(strings.ToUpper(route.Method)), `"` + path + `"`}
+	if hasQuery {
+		args = append(args, "query: query")
+	}
+	if hasHeader {
+		args = append(args, "headers: headers")
+	}
+	if hasBody {
+		args = append(args, "body: req")
+	} else {
+		args = append(args, "body: Empty?.none")
+	}
+	if auth {
+		args = append(args, "auth: true")
+	}
+
+	call := fmt.Sprintf("try await client.send(%s)", strings.Join(args, ", "))
+	if len(response) > 0 {
+		fmt.Fprintf(&builder, "%s%sreturn %s\n", indent, indent, call)
+	} else {
+		fmt.Fprintf(&builder, "%s%slet _: Empty = %s\n", indent, indent, call)
+	}
+	fmt.Fprintf(&builder, "%s}\n", indent)
+	return builder.String(), nil
+}
@@ -0,0 +1,103 @@
+package swiftgen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zeromicro/goctl/api/apitest"
+	"github.com/zeromicro/goctl/api/parser"
+)
+
+const testApi = `
+enum Status { Active = 1; Disabled = 2 }
+
+type Base {
+	Code int ` + "`" + `json:"code"` + "`" + `
+}
+
+type UserReq {
+	Id     int64    ` + "`" + `path:"id"` + "`" + `
+	Fields []string ` + "`" + `form:"fields,optional"` + "`" + `
+}
+
+type User {
+	Name    string          ` + "`" + `json:"user_name"` + "`" + `
+	Status  Status          ` + "`" + `json:"status"` + "`" + `
+	Scores  map[int]float64 ` + "`" + `json:"scores,optional"` + "`" + `
+	Friend  *User           ` + "`" + `json:"friend"` + "`" + `
+	Default bool            ` + "`" + `json:"default"` + "`" + `
+}
+
+type UserReply {
+	Base
+	User User ` + "`" + `json:"user"` + "`" + `
+}
+
+@server(
+	group: user
+	jwt: Auth
+)
+service user-api {
+	@handler GetUser
+	get /user/:id (UserReq) returns (UserReply)
+
+	@handler UpdateUser
+	put /user (User)
+}
+
+service user-api {
+	@handler Ping
+	get /ping
+}
+`
+
+func TestSwift(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "user.api")
+	err := ioutil.WriteFile(filename, []byte(testApi), os.ModePerm)
+	assert.Nil(t, err)
+
+	api, err := parser.Parse(filename)
+	assert.Nil(t, err)
+
+	assert.Nil(t, genBase(dir))
+	assert.Nil(t, genTypes(dir, api))
+	assert.Nil(t, genGroups(dir, api))
+	assert.FileExists(t, filepath.Join(dir, "BaseApi.swift"))
+
+	types := readFile(t, filepath.Join(dir, "UserTypes.swift"))
+	assert.Contains(t, types, "public enum Status: Int64, Codable, QueryValue {")
+	assert.Contains(t, types, "case active = 1")
+	assert.Contains(t, types, "public final class User: Codable {")
+	assert.Contains(t, types, "public var scores: [Int: Double]?")
+	assert.Contains(t, types, `case name = "user_name"`)
+	assert.Contains(t, types, "case `default`")
+	assert.Contains(t, types, "public struct UserReply: Codable {")
+	assert.Contains(t, types, "public var code: Int")
+	assert.Contains(t, types, "public var id: Int64 = 0")
+
+	client := readFile(t, filepath.Join(dir, "UserApi.swift"))
+	assert.Contains(t, client, "public func getUser(_ req: UserReq) async throws -> UserReply {")
+	assert.Contains(t, client, `query.add("fields", req.fields)`)
+	assert.Contains(t, client, `client.send("GET", "/user/\(ApiClient.escape(req.id))", query: query, body: Empty?.none, auth: true)`)
+	assert.Contains(t, client, `let _: Empty = try await client.send("PUT", "/user", body: req, auth: true)`)
+	assert.Contains(t, client, `client.send("GET", "/ping", body: Empty?.none)`)
+}
+
+func TestSwiftCommandDryRun(t *testing.T) {
+	apitest.AssertDryRun(t, testApi, []apitest.DryRunCase{
+		{
+			Name:   "swift",
+			Action: SwiftCommand,
+		},
+	})
+}
+
+func readFile(t *testing.T, filename string) string {
+	data, err := ioutil.ReadFile(filename)
+	assert.Nil(t, err)
+	return string(data)
+}
@@ -18,6 +18,7 @@ func TsCommand(c *cli.Context) error {
 	webAPI := c.String("webapi")
 	caller := c.String("caller")
 	unwrapAPI := c.Bool("unwrap")
+	client := c.Bool("client")
 	if len(apiFile) == 0 {
 		return errors.New("missing -api")
 	}
@@ -33,6 +34,12 @@ func TsCommand(c *cli.Context) error {
 	}
 
 	logx.Must(util.MkdirIfNotExist(dir))
+	if client {
+		logx.Must(genClient(dir, api))
+		fmt.Println(aurora.Green("Done."))
+		return nil
+	}
+
 	logx.Must(genHandler(dir, webAPI, caller, api, unwrapAPI))
 	logx.Must(genComponents(dir, api))
 
@@ -0,0 +1,35 @@
+package tsgen
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/zeromicro/goctl/api/apitest"
+)
+
+const userApi = `
+type User {
+	Name string ` + "`" + `json:"name"` + "`" + `
+}
+
+service user-api {
+	@handler GetUser
+	get /user returns (User)
+}
+`
+
+func TestTsCommandDryRun(t *testing.T) {
+	apitest.AssertDryRun(t, userApi, []apitest.DryRunCase{
+		{
+			Name:   "ts",
+			Action: TsCommand,
+		},
+		{
+			Name:   "client",
+			Action: TsCommand,
+			Flags: func(set *flag.FlagSet) {
+				set.Bool("client", true, "")
+			},
+		},
+	})
+}
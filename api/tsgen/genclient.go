@@ -0,0 +1,670 @@
+package tsgen
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"strings"
+	"text/template"
+
+	"github.com/zeromicro/goctl/api/spec"
+	apiutil "github.com/zeromicro/goctl/api/util"
+	"github.com/zeromicro/goctl/util"
+	"github.com/zeromicro/goctl/util/stringx"
+)
+
+const (
+	transportFile = "transport"
+	indexFile     = "index"
+
+	clientTemplate = `// Code generated by goctl. DO NOT EDIT.
+{{if .hasTypes}}import * as components from "./{{.components}}"
+{{end}}import { BaseClient, RequestOptions } from "./{{.transport}}"
+
+/**
+ * {{.name}} is the client of {{.group}}
+ */
+export class {{.name}} extends BaseClient {
+{{.methods}}}
+`
+
+	indexTemplate = `// Code generated by goctl. DO NOT EDIT.
+{{range .}}export * from "./{{.}}"
+{{end}}`
+
+	packageTemplate = `{
+  "name": "{{.name}}",
+  "version": "0.0.1",
+  "description": "the typescript client of {{.service}}",
+  "main": "dist/index.js",
+  "types": "dist/index.d.ts",
+  "files": [
+    "dist"
+  ],
+  "scripts": {
+    "build": "tsc"
+  },
+  "devDependencies": {
+    "typescript": "^4.2.0"
+  }
+}
+`
+
+	tsconfigTemplate = `{
+  "compilerOptions": {
+    "target": "es2017",
+    "module": "commonjs",
+    "lib": ["es2017", "dom"],
+    "declaration": true,
+    "strict": true,
+    "outDir": "dist"
+  },
+  "include": ["*.ts"]
+}
+`
+
+	transportTemplate = `// Code generated by goctl. DO NOT EDIT.
+
+export interface HttpRequest {
+	method: string
+	url: string
+	headers: { [key: string]: string }
+	body?: string
+	signal?: AbortSignal
+}
+
+export interface HttpResponse {
+	status: number
+	headers: { [key: string]: string }
+	body: string
+}
+
+// Transport sends the http requests, fetchTransport and axiosTransport are provided,
+// implement it to use any other http library
+export interface Transport {
+	request(req: HttpRequest): Promise<HttpResponse>
+}
+
+export interface ClientOptions {
+	// baseUrl is prepended to the path of every request, such as https://example.com
+	baseUrl?: string
+	// headers are sent with every request
+	headers?: { [key: string]: string }
+	// token is sent as the bearer token of the routes in the jwt groups
+	token?: string
+}
+
+export interface RequestOptions {
+	signal?: AbortSignal
+	headers?: { [key: string]: string }
+}
+
+export interface ApiRequest {
+	method: string
+	path: string
+	params?: { [key: string]: unknown }
+	query?: { [key: string]: unknown }
+	headers?: { [key: string]: unknown }
+	body?: unknown
+	auth?: boolean
+}
+
+// ApiError is thrown if the status of the response is not 2xx, body is the parsed json
+// of the response, or the text if it is not a json
+export class ApiError extends Error {
+	readonly status: number
+	readonly body: unknown
+	readonly response: HttpResponse
+
+	constructor(response: HttpResponse) {
+		super("request failed with status " + response.status)
+		Object.setPrototypeOf(this, ApiError.prototype)
+		this.name = "ApiError"
+		this.status = response.status
+		this.body = parseBody(response.body)
+		this.response = response
+	}
+}
+
+export function fetchTransport(fetchFn?: typeof fetch): Transport {
+	return {
+		async request(req: HttpRequest): Promise<HttpResponse> {
+			const doFetch = fetchFn || fetch
+			const resp = await doFetch(req.url, {
+				method: req.method,
+				headers: req.headers,
+				body: req.body,
+				signal: req.signal,
+			})
+			const headers: { [key: string]: string } = {}
+			resp.headers.forEach((value, key) => {
+				headers[key] = value
+			})
+			return { status: resp.status, headers, body: await resp.text() }
+		},
+	}
+}
+
+// AxiosLike is the part of the axios instance used by axiosTransport, so that axios is not
+// a dependency of the client
+export interface AxiosLike {
+	request(config: {
+		method: string
+		url: string
+		headers: { [key: string]: string }
+		data?: string
+		signal?: AbortSignal
+		responseType: "text"
+		transformResponse: Array<(data: string) => string>
+		validateStatus: (status: number) => boolean
+	}): Promise<{ status: number; headers: unknown; data: string }>
+}
+
+export function axiosTransport(axios: AxiosLike): Transport {
+	return {
+		async request(req: HttpRequest): Promise<HttpResponse> {
+			const resp = await axios.request({
+				method: req.method,
+				url: req.url,
+				headers: req.headers,
+				data: req.body,
+				signal: req.signal,
+				responseType: "text",
+				transformResponse: [(data: string) => data],
+				validateStatus: () => true,
+			})
+			const headers: { [key: string]: string } = {}
+			const raw = (resp.headers || {}) as { [key: string]: unknown }
+			Object.keys(raw).forEach((key) => {
+				headers[key.toLowerCase()] = String(raw[key])
+			})
+			return { status: resp.status, headers, body: resp.data }
+		},
+	}
+}
+
+export class BaseClient {
+	protected readonly transport: Transport
+	protected readonly options: ClientOptions
+	// token is sent as the bearer token of the routes in the jwt groups, set it after login
+	token?: string
+
+	constructor(transport: Transport = fetchTransport(), options: ClientOptions = {}) {
+		this.transport = transport
+		this.options = options
+		this.token = options.token
+	}
+
+	protected async send<T>(req: ApiRequest, options: RequestOptions = {}): Promise<T> {
+		const headers: { [key: string]: string } = Object.assign({}, this.options.headers || {})
+		const reqHeaders = req.headers || {}
+		Object.keys(reqHeaders).forEach((key) => {
+			const value = reqHeaders[key]
+			if (value !== undefined && value !== null) {
+				headers[key] = String(value)
+			}
+		})
+		if (req.auth && this.token) {
+			headers["Authorization"] = "Bearer " + this.token
+		}
+		Object.assign(headers, options.headers)
+
+		let body: string | undefined
+		if (req.body !== undefined) {
+			headers["Content-Type"] = "application/json"
+			body = JSON.stringify(req.body)
+		}
+
+		const resp = await this.transport.request({
+			method: req.method,
+			url: (this.options.baseUrl || "") + buildPath(req.path, req.params || {}) + buildQuery(req.query || {}),
+			headers,
+			body,
+			signal: options.signal,
+		})
+		if (resp.status < 200 || resp.status >= 300) {
+			throw new ApiError(resp)
+		}
+
+		return parseBody(resp.body) as T
+	}
+}
+
+function buildPath(path: string, params: { [key: string]: unknown }): string {
+	return path.replace(/:([^/]+)/g, (_: string, name: string) => encodeURIComponent(String(params[name])))
+}
+
+function buildQuery(query: { [key: string]: unknown }): string {
+	const pairs: string[] = []
+	Object.keys(query).forEach((key) => {
+		const value = query[key]
+		if (value === undefined || value === null) {
+			return
+		}
+
+		const values: unknown[] = Array.isArray(value) ? value : [value]
+		values.forEach((item) => {
+			pairs.push(encodeURIComponent(key) + "=" + encodeURIComponent(String(item)))
+		})
+	})
+
+	return pairs.length > 0 ? "?" + pairs.join("&") : ""
+}
+
+function parseBody(body: string): unknown {
+	if (body.length === 0) {
+		return undefined
+	}
+
+	try {
+		return JSON.parse(body)
+	} catch (e) {
+		return body
+	}
+}
+`
+)
+
+// clientGroup is the routes of the group whose client class is named name
+type clientGroup struct {
+	name   string
+	group  string
+	routes []spec.Route
+	groups []spec.Group
+}
+
+// clientField is a member of the request type with the location where it is sent
+type clientField struct {
+	name     string
+	location string
+}
+
+// genClient generates a self-contained client package, it contains the types, the transports
+// and a client class for every group of the service
+func genClient(dir string, api *spec.ApiSpec) error {
+	types := api.Types
+	for _, item := range api.Imports {
+		types = append(types, item.Types...)
+	}
+
+	components := apiutil.ComponentName(api)
+	if len(types) > 0 {
+		val, err := buildClientTypes(types)
+		if err != nil {
+			return err
+		}
+
+		if err := writeClientFile(dir, components+".ts", componentsTemplate, map[string]string{
+			"componentTypes": val,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := writeClientFile(dir, transportFile+".ts", transportTemplate, nil); err != nil {
+		return err
+	}
+
+	exports := []string{transportFile}
+	if len(types) > 0 {
+		exports = append(exports, components)
+	}
+	for _, group := range clientGroups(api) {
+		methods, err := genClientMethods(group)
+		if err != nil {
+			return err
+		}
+
+		filename := util.Untitle(group.name)
+		if err := writeClientFile(dir, filename+".ts", clientTemplate, map[string]interface{}{
+			"hasTypes":   len(types) > 0,
+			"components": components,
+			"transport":  transportFile,
+			"name":       group.name,
+			"group":      group.group,
+			"methods":    methods,
+		}); err != nil {
+			return err
+		}
+
+		exports = append(exports, filename)
+	}
+
+	if err := writeClientFile(dir, indexFile+".ts", indexTemplate, exports); err != nil {
+		return err
+	}
+
+	name := strings.TrimSuffix(api.Service.Name, "-api")
+	if err := createClientFile(dir, "package.json", packageTemplate, map[string]string{
+		"name":    strings.ToLower(name) + "-client",
+		"service": api.Service.Name,
+	}); err != nil {
+		return err
+	}
+
+	return createClientFile(dir, "tsconfig.json", tsconfigTemplate, nil)
+}
+
+// writeClientFile overwrites the generated file
+func writeClientFile(dir, filename, text string, data interface{}) error {
+	var buffer bytes.Buffer
+	t := template.Must(template.New(filename).Parse(text))
+	if err := t.Execute(&buffer, data); err != nil {
+		return err
+	}
+
+	return writeFile(dir, filename, buffer.Bytes())
+}
+
+// createClientFile creates the file if it does not exist, so that the changes are kept
+func createClientFile(dir, filename, text string, data interface{}) error {
+	if util.FileExists(path.Join(dir, filename)) {
+		util.SkipFile(path.Join(dir, filename))
+		return nil
+	}
+
+	return writeClientFile(dir, filename, text, data)
+}
+
+// clientGroups groups the routes by the group annotation, the groups without annotation
+// are merged into the client named after the service
+func clientGroups(api *spec.ApiSpec) []*clientGroup {
+	var groups []*clientGroup
+	groupM := make(map[string]*clientGroup)
+	for _, group := range api.Service.Groups {
+		name := group.GetAnnotation(groupProperty)
+		desc := "the group " + name
+		if len(name) == 0 {
+			name = strings.TrimSuffix(api.Service.Name, "-api")
+			desc = "the service " + api.Service.Name
+		}
+
+		name = stringx.From(strings.NewReplacer("/", "_", "-", "_").Replace(name)).ToCamel() + "Client"
+		item, ok := groupM[name]
+		if !ok {
+			item = &clientGroup{name: name, group: desc}
+			groupM[name] = item
+			groups = append(groups, item)
+		}
+
+		for _, route := range group.Routes {
+			item.routes = append(item.routes, route)
+			item.groups = append(item.groups, group)
+		}
+	}
+
+	return groups
+}
+
+func genClientMethods(group *clientGroup) (string, error) {
+	var builder strings.Builder
+	for index, route := range group.routes {
+		handler := route.Handler
+		if len(handler) == 0 {
+			return "", fmt.Errorf("missing handler annotation for route %q", route.Path)
+		}
+
+		handler = util.Untitle(strings.Replace(handler, "Handler", "", 1))
+		responseType := "void"
+		if len(route.ResponseTypeName()) > 0 {
+			val, err := goTypeToTs(route.ResponseType, true)
+			if err != nil {
+				return "", err
+			}
+
+			responseType = val
+		}
+
+		var params []string
+		var fields []clientField
+		if len(route.RequestTypeName()) > 0 {
+			requestType, err := goTypeToTs(route.RequestType, true)
+			if err != nil {
+				return "", err
+			}
+
+			fields, err = clientFields(route.RequestType)
+			if err != nil {
+				return "", apiutil.WrapErr(err, "route "+route.Path)
+			}
+
+			params = append(params, "req: "+requestType)
+		}
+		params = append(params, "options?: RequestOptions")
+
+		if index > 0 {
+			builder.WriteString("\n")
+		}
+		writeIndent(&builder, 1)
+		builder.WriteString("/**\n")
+		if doc := strings.Trim(route.JoinedDoc(), `"`); len(doc) > 0 {
+			writeIndent(&builder, 1)
+			fmt.Fprintf(&builder, " * @description %s\n", doc)
+		}
+		writeIndent(&builder, 1)
+		fmt.Fprintf(&builder, " * @see %s %s\n", strings.ToUpper(route.Method), route.Path)
+		writeIndent(&builder, 1)
+		builder.WriteString(" */\n")
+		writeIndent(&builder, 1)
+		fmt.Fprintf(&builder, "%s(%s): Promise<%s> {\n", handler, strings.Join(params, ", "), responseType)
+		writeIndent(&builder, 2)
+		fmt.Fprintf(&builder, "return this.send<%s>({\n", responseType)
+		writeIndent(&builder, 3)
+		fmt.Fprintf(&builder, "method: %q,\n", strings.ToUpper(route.Method))
+		writeIndent(&builder, 3)
+		fmt.Fprintf(&builder, "path: %s,\n", pathForRoute(route, group.groups[index]))
+		writeClientFields(&builder, fields, "path", "params")
+		writeClientFields(&builder, fields, "query", "query")
+		writeClientFields(&builder, fields, "header", "headers")
+		writeClientFields(&builder, fields, "body", "body")
+		if len(group.groups[index].GetAnnotation("jwt")) > 0 {
+			writeIndent(&builder, 3)
+			builder.WriteString("auth: true,\n")
+		}
+		writeIndent(&builder, 2)
+		builder.WriteString("}, options)\n")
+		writeIndent(&builder, 1)
+		builder.WriteString("}\n")
+	}
+
+	return builder.String(), nil
+}
+
+func writeClientFields(builder *strings.Builder, fields []clientField, location, key string) {
+	var matched []clientField
+	for _, field := range fields {
+		if field.location == location {
+			matched = append(matched, field)
+		}
+	}
+	if len(matched) == 0 {
+		return
+	}
+
+	writeIndent(builder, 3)
+	fmt.Fprintf(builder, "%s: {\n", key)
+	for _, field := range matched {
+		writeIndent(builder, 4)
+		fmt.Fprintf(builder, "%q: req%s,\n", field.name, propertyAccess(field.name))
+	}
+	writeIndent(builder, 3)
+	builder.WriteString("},\n")
+}
+
+// clientFields returns the members of the request type, the members of the inline types
+// are included, path members are path params, form members are query params
+func clientFields(tp spec.Type) ([]clientField, error) {
+	var fields []clientField
+	switch v := tp.(type) {
+	case spec.PointerType:
+		return clientFields(v.Type)
+	case spec.DefineStruct:
+		for _, member := range v.Members {
+			if member.IsInline {
+				inline, err := clientFields(member.Type)
+				if err != nil {
+					return nil, err
+				}
+
+				fields = append(fields, inline...)
+				continue
+			}
+
+			name, location, err := memberLocation(member)
+			if err != nil {
+				return nil, err
+			}
+			if len(location) == 0 {
+				continue
+			}
+
+			fields = append(fields, clientField{name: name, location: location})
+		}
+	default:
+		return nil, fmt.Errorf("type %s not supported", tp.Name())
+	}
+
+	return fields, nil
+}
+
+// memberLocation returns the property name of member, and where the member is sent,
+// the location is empty if the member is ignored, such as json:"-"
+func memberLocation(member spec.Member) (string, string, error) {
+	tags, err := spec.Parse(member.Tag)
+	if err != nil {
+		return "", "", err
+	}
+
+	for _, each := range []struct {
+		key      string
+		location string
+	}{
+		{key: "path", location: "path"},
+		{key: "form", location: "query"},
+		{key: "header", location: "header"},
+		{key: "json", location: "body"},
+	} {
+		tag, err := tags.Get(each.key)
+		if err != nil {
+			continue
+		}
+		if tag.Name == "-" {
+			return "", "", nil
+		}
+
+		return tag.Name, each.location, nil
+	}
+
+	return member.Name, "body", nil
+}
+
+// propertyAccess returns .name if name is an identifier, otherwise ["name"]
+func propertyAccess(name string) string {
+	for index, ch := range name {
+		if ch == '_' || ch == '$' || ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z' || index > 0 && ch >= '0' && ch <= '9' {
+			continue
+		}
+
+		return fmt.Sprintf("[%q]", name)
+	}
+
+	return "." + name
+}
+
+func buildClientTypes(types []spec.Type) (string, error) {
+	var builder strings.Builder
+	for index, tp := range types {
+		if index > 0 {
+			builder.WriteString("\n")
+		}
+		if err := writeClientType(&builder, tp); err != nil {
+			return "", apiutil.WrapErr(err, "Type "+tp.Name()+" generate error")
+		}
+	}
+
+	return builder.String(), nil
+}
+
+// writeClientType writes all members of the type into one interface, inline types are extended
+func writeClientType(builder *strings.Builder, tp spec.Type) error {
+	switch v := tp.(type) {
+	case spec.EnumType:
+		return writeEnum(builder, v)
+	case spec.DefineStruct:
+		var extends []string
+		var members []spec.Member
+		for _, member := range v.Members {
+			if !member.IsInline {
+				members = append(members, member)
+				continue
+			}
+
+			name, err := goTypeToTs(member.Type, false)
+			if err != nil {
+				return err
+			}
+
+			extends = append(extends, name)
+		}
+
+		for _, doc := range v.Docs {
+			fmt.Fprintf(builder, "%s\n", doc)
+		}
+		fmt.Fprintf(builder, "export interface %s ", util.Title(v.TypeName))
+		if len(extends) > 0 {
+			fmt.Fprintf(builder, "extends %s ", strings.Join(extends, ", "))
+		}
+		builder.WriteString("{\n")
+		for _, member := range members {
+			if err := writeClientProperty(builder, member); err != nil {
+				return apiutil.WrapErr(err, " type "+tp.Name())
+			}
+		}
+		builder.WriteString("}\n")
+		return nil
+	}
+
+	return fmt.Errorf("type %s not supported", tp.Name())
+}
+
+func writeClientProperty(builder *strings.Builder, member spec.Member) error {
+	name, location, err := memberLocation(member)
+	if err != nil {
+		return err
+	}
+	if len(location) == 0 {
+		return nil
+	}
+
+	ty, err := goTypeToTs(member.Type, false)
+	if err != nil {
+		return err
+	}
+
+	optionalTag := ""
+	for _, tag := range member.Tags() {
+		for _, option := range tag.Options {
+			if option == "optional" || option == "omitempty" || strings.HasPrefix(option, "default=") {
+				optionalTag = "?"
+			}
+		}
+	}
+	if _, ok := member.Type.(spec.PointerType); ok {
+		optionalTag = "?"
+	}
+
+	for _, doc := range member.Docs {
+		writeIndent(builder, 1)
+		fmt.Fprintf(builder, "%s\n", doc)
+	}
+	if strings.HasPrefix(propertyAccess(name), "[") {
+		name = fmt.Sprintf("%q", name)
+	}
+	writeIndent(builder, 1)
+	fmt.Fprintf(builder, "%s%s: %s", name, optionalTag, ty)
+	if comment := member.GetComment(); len(comment) > 0 {
+		fmt.Fprintf(builder, " // %s", strings.TrimSpace(strings.TrimPrefix(comment, "//")))
+	}
+	builder.WriteString("\n")
+	return nil
+}
@@ -0,0 +1,107 @@
+package tsgen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zeromicro/goctl/api/parser"
+)
+
+const clientApi = `
+enum Status { Active = 1; Disabled = 2 }
+
+type Base {
+	Code int ` + "`" + `json:"code"` + "`" + `
+}
+
+type UserReq {
+	Id     int64    ` + "`" + `path:"id"` + "`" + `
+	Fields []string ` + "`" + `form:"fields,optional"` + "`" + `
+	Token  string   ` + "`" + `header:"X-Token"` + "`" + `
+}
+
+type User {
+	Name   string          ` + "`" + `json:"user-name"` + "`" + `
+	Status Status          ` + "`" + `json:"status"` + "`" + `
+	Scores map[int]float64 ` + "`" + `json:"scores"` + "`" + `
+	Friend *User           ` + "`" + `json:"friend"` + "`" + `
+}
+
+type UserReply {
+	Base
+	User User ` + "`" + `json:"user"` + "`" + `
+}
+
+@server(
+	group: user
+	jwt: Auth
+)
+service user-api {
+	@doc "get user"
+	@handler GetUser
+	get /user/:id (UserReq) returns (UserReply)
+
+	@handler UpdateUser
+	put /user (User)
+}
+
+service user-api {
+	@handler Ping
+	get /ping
+}
+`
+
+func TestGenClient(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "user.api")
+	err := ioutil.WriteFile(filename, []byte(clientApi), os.ModePerm)
+	assert.Nil(t, err)
+
+	api, err := parser.Parse(filename)
+	assert.Nil(t, err)
+
+	assert.Nil(t, genClient(dir, api))
+	components := readFile(t, filepath.Join(dir, "userComponents.ts"))
+	assert.Contains(t, components, "export interface UserReply extends Base {")
+	assert.Contains(t, components, `"X-Token": string`)
+	assert.Contains(t, components, `"user-name": string`)
+	assert.Contains(t, components, "scores: { [key: number]: number }")
+	assert.Contains(t, components, "friend?: User")
+
+	client := readFile(t, filepath.Join(dir, "userClient.ts"))
+	assert.Contains(t, client, "export class UserClient extends BaseClient {")
+	assert.Contains(t, client, "getUser(req: components.UserReq, options?: RequestOptions): Promise<components.UserReply> {")
+	assert.Contains(t, client, `"id": req.id,`)
+	assert.Contains(t, client, `"fields": req.fields,`)
+	assert.Contains(t, client, `"X-Token": req["X-Token"],`)
+	assert.Contains(t, client, `"user-name": req["user-name"],`)
+	assert.Contains(t, client, "ping(options?: RequestOptions): Promise<void> {")
+	assert.Equal(t, 2, strings.Count(client, "auth: true,"))
+	assert.Contains(t, client, "ping(options?: RequestOptions): Promise<void> {\n\t\treturn this.send<void>({\n"+
+		"\t\t\tmethod: \"GET\",\n\t\t\tpath: \"/ping\",\n\t\t}, options)")
+	assert.Contains(t, readFile(t, filepath.Join(dir, "transport.ts")),
+		`headers["Authorization"] = "Bearer " + this.token`)
+
+	index := readFile(t, filepath.Join(dir, "index.ts"))
+	assert.Contains(t, index, `export * from "./transport"`)
+	assert.Contains(t, index, `export * from "./userClient"`)
+	assert.FileExists(t, filepath.Join(dir, "transport.ts"))
+	assert.FileExists(t, filepath.Join(dir, "tsconfig.json"))
+
+	packageFile := filepath.Join(dir, "package.json")
+	assert.Contains(t, readFile(t, packageFile), `"types": "dist/index.d.ts"`)
+	err = ioutil.WriteFile(packageFile, []byte("{}"), os.ModePerm)
+	assert.Nil(t, err)
+	assert.Nil(t, genClient(dir, api))
+	assert.Equal(t, "{}", readFile(t, packageFile))
+}
+
+func readFile(t *testing.T, filename string) string {
+	data, err := ioutil.ReadFile(filename)
+	assert.Nil(t, err)
+	return string(data)
+}
@@ -1,13 +1,12 @@
 package tsgen
 
 import (
-	"path"
+	"bytes"
 	"strings"
 	"text/template"
 
 	"github.com/zeromicro/goctl/api/spec"
 	apiutil "github.com/zeromicro/goctl/api/util"
-	"github.com/zeromicro/goctl/util"
 )
 
 const (
@@ -31,25 +30,16 @@ func genComponents(dir string, api *spec.ApiSpec) error {
 		return err
 	}
 
-	outputFile := apiutil.ComponentName(api) + ".ts"
-	filename := path.Join(dir, outputFile)
-	if err := util.RemoveIfExist(filename); err != nil {
-		return err
-	}
-
-	fp, created, err := apiutil.MaybeCreateFile(dir, ".", outputFile)
+	t := template.Must(template.New("componentsTemplate").Parse(componentsTemplate))
+	var buffer bytes.Buffer
+	err = t.Execute(&buffer, map[string]string{
+		"componentTypes": val,
+	})
 	if err != nil {
 		return err
 	}
-	if !created {
-		return nil
-	}
-	defer fp.Close()
 
-	t := template.Must(template.New("componentsTemplate").Parse(componentsTemplate))
-	return t.Execute(fp, map[string]string{
-		"componentTypes": val,
-	})
+	return writeFile(dir, apiutil.ComponentName(api)+".ts", buffer.Bytes())
 }
 
 func buildTypes(types []spec.Type) (string, error) {
@@ -1,8 +1,8 @@
 package tsgen
 
 import (
+	"bytes"
 	"fmt"
-	"path"
 	"strings"
 	"text/template"
 
@@ -20,18 +20,6 @@ const (
 
 func genHandler(dir, webAPI, caller string, api *spec.ApiSpec, unwrapAPI bool) error {
 	filename := strings.Replace(api.Service.Name, "-api", "", 1) + ".ts"
-	if err := util.RemoveIfExist(path.Join(dir, filename)); err != nil {
-		return err
-	}
-	fp, created, err := apiutil.MaybeCreateFile(dir, "", filename)
-	if err != nil {
-		return err
-	}
-	if !created {
-		return nil
-	}
-	defer fp.Close()
-
 	imports := ""
 	if len(caller) == 0 {
 		caller = "webapi"
@@ -59,10 +47,16 @@ func genHandler(dir, webAPI, caller string, api *spec.ApiSpec, unwrapAPI bool) e
 	}
 
 	t := template.Must(template.New("handlerTemplate").Parse(handlerTemplate))
-	return t.Execute(fp, map[string]string{
+	var buffer bytes.Buffer
+	err = t.Execute(&buffer, map[string]string{
 		"imports": imports,
 		"apis":    strings.TrimSpace(apis),
 	})
+	if err != nil {
+		return err
+	}
+
+	return writeFile(dir, filename, buffer.Bytes())
 }
 
 func genAPI(api *spec.ApiSpec, caller string) (string, error) {
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"path"
+	"strconv"
 	"strings"
 
 	"github.com/zeromicro/goctl/api/spec"
@@ -11,6 +13,16 @@ import (
 	"github.com/zeromicro/goctl/util"
 )
 
+// writeFile overwrites the file in dir with data, which is only reported in the dry run mode
+func writeFile(dir, filename string, data []byte) error {
+	err := util.MkdirIfNotExist(dir)
+	if err != nil {
+		return err
+	}
+
+	return util.WriteFile(path.Join(dir, filename), data, 0666)
+}
+
 func writeProperty(writer io.Writer, member spec.Member, indent int) error {
 	writeIndent(writer, indent)
 	ty, err := goTypeToTs(member.Type, false)
@@ -48,7 +60,7 @@ func writeIndent(writer io.Writer, indent int) {
 
 func goTypeToTs(tp spec.Type, fromPacket bool) (string, error) {
 	switch v := tp.(type) {
-	case spec.DefineStruct:
+	case spec.DefineStruct, spec.EnumType:
 		return addPrefix(tp, fromPacket), nil
 	case spec.PrimitiveType:
 		r, ok := primitiveType(tp.Name())
@@ -63,7 +75,12 @@ func goTypeToTs(tp spec.Type, fromPacket bool) (string, error) {
 			return "", err
 		}
 
-		return fmt.Sprintf("{ [key: string]: %s }", valueType), nil
+		keyType, ok := primitiveType(v.Key)
+		if !ok || keyType != "number" {
+			keyType = "string"
+		}
+
+		return fmt.Sprintf("{ [key: %s]: %s }", keyType, valueType), nil
 	case spec.ArrayType:
 		if tp.Name() == "[]byte" {
 			return "Blob", nil
@@ -100,7 +117,8 @@ func primitiveType(tp string) (string, bool) {
 	switch tp {
 	case "string":
 		return "string", true
-	case "int", "int8", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "uintptr",
+		"byte", "rune":
 		return "number", true
 	case "float", "float32", "float64":
 		return "number", true
@@ -115,6 +133,10 @@ func primitiveType(tp string) (string, bool) {
 }
 
 func writeType(writer io.Writer, tp spec.Type) error {
+	if enumType, ok := tp.(spec.EnumType); ok {
+		return writeEnum(writer, enumType)
+	}
+
 	fmt.Fprintf(writer, "export interface %s {\n", util.Title(tp.Name()))
 	if err := writeMembers(writer, tp, false); err != nil {
 		return err
@@ -124,6 +146,32 @@ func writeType(writer io.Writer, tp spec.Type) error {
 	return genParamsTypesIfNeed(writer, tp)
 }
 
+func writeEnum(writer io.Writer, tp spec.EnumType) error {
+	for _, doc := range tp.Docs {
+		fmt.Fprintf(writer, "%s\n", doc)
+	}
+	fmt.Fprintf(writer, "export enum %s {\n", util.Title(tp.Name()))
+	for _, value := range tp.Values {
+		for _, doc := range value.Docs {
+			writeIndent(writer, 1)
+			fmt.Fprintf(writer, "%s\n", doc)
+		}
+
+		literal := value.Value
+		if tp.IsString() {
+			literal = strconv.Quote(literal)
+		}
+		writeIndent(writer, 1)
+		fmt.Fprintf(writer, "%s = %s,", util.Title(value.Name), literal)
+		if len(value.Comment) > 0 {
+			fmt.Fprintf(writer, " %s", value.Comment)
+		}
+		fmt.Fprint(writer, "\n")
+	}
+	_, err := fmt.Fprintf(writer, "}\n")
+	return err
+}
+
 func genParamsTypesIfNeed(writer io.Writer, tp spec.Type) error {
 	definedType, ok := tp.(spec.DefineStruct)
 	if !ok {
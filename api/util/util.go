@@ -27,6 +27,26 @@ func MaybeCreateFile(dir, subdir, file string) (fp *os.File, created bool, err e
 	return
 }
 
+// MaybeWriteFile writes data to the file like MaybeCreateFile, the existing file is skipped
+func MaybeWriteFile(dir, subdir, file string, data []byte) error {
+	fpath := path.Join(dir, subdir, file)
+	if util.FileExists(fpath) {
+		if util.IsDryRun() {
+			util.SkipFile(fpath)
+		} else {
+			fmt.Printf("%s exists, ignored generation\n", fpath)
+		}
+		return nil
+	}
+
+	err := util.MkdirIfNotExist(path.Join(dir, subdir))
+	if err != nil {
+		return err
+	}
+
+	return util.WriteFile(fpath, data, 0666)
+}
+
 // WrapErr wraps an error with message
 func WrapErr(err error, message string) error {
 	return errors.New(message + ", " + err.Error())
@@ -21,6 +21,9 @@ type Config struct {
 	// of each operating system file name.
 	// Note: NamingFormat is based on snake or camel string
 	NamingFormat string `yaml:"namingFormat"`
+	// Merge is true if the generated codes are merged into the existing go files, the missing declarations,
+	// struct fields and imports are added and the function bodies are kept, otherwise the existing files are skipped
+	Merge bool `yaml:"-"`
 }
 
 // NewConfig creates an instance for Config
@@ -0,0 +1,326 @@
+package docker
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/urfave/cli"
+	"github.com/zeromicro/goctl/util"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	composeFileName = "docker-compose.yml"
+	composeDir      = "compose"
+	etcdService     = "etcd"
+	redisService    = "redis"
+	mysqlService    = "mysql"
+	etcdPort        = 2379
+	redisPort       = 6379
+	mysqlPort       = 3306
+)
+
+type (
+	// Compose describes the docker-compose.yml of the services and the infrastructure they use
+	Compose struct {
+		Etcd     *Infra
+		Redis    *Infra
+		Mysql    *Mysql
+		Services []*ComposeService
+	}
+
+	// Infra describes an infrastructure container, Port is the port exposed on the host
+	Infra struct {
+		Port int
+	}
+
+	// Mysql describes the mysql container with the first datasource of the services,
+	// the other databases are created by InitScript
+	Mysql struct {
+		Infra
+		User       string
+		Password   string
+		Database   string
+		Databases  []string
+		InitScript string
+	}
+
+	// ComposeService describes an api or rpc service built from its Dockerfile
+	ComposeService struct {
+		Name       string
+		Context    string
+		Dockerfile string
+		Port       int
+		Config     string
+		ConfigFile string
+		DependsOn  []string
+
+		dir  string
+		conf yaml.MapSlice
+		key  string
+	}
+)
+
+// ComposeCommand discovers the api and rpc services in the directory and generates the docker-compose.yml
+// with them and the etcd, redis and mysql they use, the hosts in their configs are rewritten into
+// compose/${service} to refer to the containers, which are mounted to the services.
+func ComposeCommand(c *cli.Context) error {
+	dir, err := filepath.Abs(c.String("dir"))
+	if err != nil {
+		return err
+	}
+
+	services, err := discoverServices(dir)
+	if err != nil {
+		return err
+	}
+	if len(services) == 0 {
+		return fmt.Errorf("no api or rpc service found in %s", dir)
+	}
+
+	compose := &Compose{Services: services}
+	ports := make(map[int]string)
+	keys := make(map[string]string)
+	for _, item := range services {
+		if item.Port > 0 {
+			ports[item.Port] = item.Name
+		}
+		if len(item.key) > 0 {
+			keys[item.key] = item.Name
+		}
+	}
+
+	for _, item := range services {
+		r := &rewriter{compose: compose, ports: ports, keys: keys, depends: make(map[string]bool)}
+		item.conf = r.rewrite(item.conf, nil).(yaml.MapSlice)
+		delete(r.depends, item.Name)
+		for name := range r.depends {
+			item.DependsOn = append(item.DependsOn, name)
+		}
+		sort.Strings(item.DependsOn)
+
+		data, err := yaml.Marshal(item.conf)
+		if err != nil {
+			return err
+		}
+
+		filename := filepath.Join(dir, composeDir, item.Name, item.ConfigFile)
+		if err = util.MkdirIfNotExist(filepath.Dir(filename)); err != nil {
+			return err
+		}
+		if err = util.WriteFile(filename, data, 0666); err != nil {
+			return err
+		}
+
+		item.Config = "./" + filepath.ToSlash(filepath.Join(composeDir, item.Name, item.ConfigFile))
+		if !util.FileExists(filepath.Join(item.dir, dockerfileName)) {
+			fmt.Println(aurora.Yellow(fmt.Sprintf("%s not found in %s, run goctl docker in it first",
+				dockerfileName, item.dir)))
+		}
+	}
+
+	if compose.Mysql != nil && len(compose.Mysql.Databases) > 1 {
+		if err = writeInitScript(dir, compose.Mysql); err != nil {
+			return err
+		}
+	}
+
+	text, err := util.LoadTemplate(category, composeTemplateFile, composeTemplate)
+	if err != nil {
+		return err
+	}
+
+	err = util.With("compose").Parse(text).SaveTo(compose, filepath.Join(dir, composeFileName), true)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(aurora.Green("Done."))
+	return nil
+}
+
+// writeInitScript writes the sql to create the databases other than the first one
+func writeInitScript(dir string, m *Mysql) error {
+	var builder strings.Builder
+	for _, item := range m.Databases[1:] {
+		fmt.Fprintf(&builder, "CREATE DATABASE IF NOT EXISTS `%s`;\n", item)
+	}
+	if len(m.User) > 0 && m.User != "root" {
+		fmt.Fprintf(&builder, "GRANT ALL PRIVILEGES ON *.* TO '%s'@'%%';\n", m.User)
+	}
+
+	filename := filepath.Join(dir, composeDir, mysqlService, "init.sql")
+	if err := util.MkdirIfNotExist(filepath.Dir(filename)); err != nil {
+		return err
+	}
+
+	m.InitScript = "./" + filepath.ToSlash(filepath.Join(composeDir, mysqlService, "init.sql"))
+	return util.WriteFile(filename, []byte(builder.String()), 0666)
+}
+
+// discoverServices finds the directories with a main go file and the etc/*.yaml config
+func discoverServices(dir string) ([]*ComposeService, error) {
+	var services []*ComposeService
+	names := make(map[string]string)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		if path != dir && (strings.HasPrefix(info.Name(), ".") || info.Name() == "vendor" ||
+			info.Name() == composeDir) {
+			return filepath.SkipDir
+		}
+
+		service, err := newComposeService(dir, path)
+		if err != nil || service == nil {
+			return err
+		}
+
+		if other, ok := names[service.Name]; ok {
+			return fmt.Errorf("duplicated service %s in %s and %s", service.Name, other, path)
+		}
+
+		names[service.Name] = path
+		services = append(services, service)
+		return nil
+	})
+
+	return services, err
+}
+
+func newComposeService(root, dir string) (*ComposeService, error) {
+	etc := filepath.Join(dir, etcDir)
+	if !util.FileExists(etc) {
+		return nil, nil
+	}
+
+	goFile, err := findMainFile(dir)
+	if err != nil || len(goFile) == 0 {
+		return nil, err
+	}
+
+	cfg, err := findConfig(goFile, etc)
+	if err != nil {
+		fmt.Println(aurora.Yellow(fmt.Sprintf("%s: %v, the service is skipped", etc, err)))
+		return nil, nil
+	}
+
+	content, err := ioutil.ReadFile(filepath.Join(etc, cfg))
+	if err != nil {
+		return nil, err
+	}
+
+	var conf yaml.MapSlice
+	if err = yaml.Unmarshal(content, &conf); err != nil {
+		return nil, fmt.Errorf("%s: %v", filepath.Join(etc, cfg), err)
+	}
+
+	service := &ComposeService{
+		ConfigFile: cfg,
+		dir:        dir,
+		conf:       conf,
+	}
+	name, _ := lookup(conf, "Name").(string)
+	if len(name) == 0 {
+		name = filepath.Base(dir)
+	}
+	service.Name = strings.ToLower(strings.NewReplacer(".", "-", "_", "-").Replace(name))
+
+	if port, ok := lookup(conf, "Port").(int); ok {
+		service.Port = port
+	} else if listenOn, ok := lookup(conf, "ListenOn").(string); ok {
+		service.Port = hostPort(listenOn, 0)
+	}
+	if etcd, ok := lookup(conf, "Etcd").(yaml.MapSlice); ok {
+		service.key, _ = lookup(etcd, "Key").(string)
+	}
+
+	context := root
+	if project, ok := util.FindProjectPath(dir); ok {
+		context = project
+	}
+	service.Context, err = composePath(root, context)
+	if err != nil {
+		return nil, err
+	}
+
+	service.Dockerfile, err = filepath.Rel(context, filepath.Join(dir, dockerfileName))
+	if err != nil {
+		return nil, err
+	}
+	service.Dockerfile = filepath.ToSlash(service.Dockerfile)
+
+	return service, nil
+}
+
+// findMainFile returns the go file of package main with the main function in dir
+func findMainFile(dir string) (string, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return "", err
+	}
+
+	sort.Strings(matches)
+	for _, item := range matches {
+		content, err := ioutil.ReadFile(item)
+		if err != nil {
+			return "", err
+		}
+
+		text := string(content)
+		if strings.Contains(text, "package main") && strings.Contains(text, "func main()") {
+			return item, nil
+		}
+	}
+
+	return "", nil
+}
+
+func composePath(root, path string) (string, error) {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return "", err
+	}
+
+	rel = filepath.ToSlash(rel)
+	if rel == "." || strings.HasPrefix(rel, "..") {
+		return rel, nil
+	}
+
+	return "./" + rel, nil
+}
+
+func lookup(conf yaml.MapSlice, key string) interface{} {
+	for _, item := range conf {
+		if item.Key == key {
+			return item.Value
+		}
+	}
+
+	return nil
+}
+
+// hostPort returns the port in the address, or fallback if not found
+func hostPort(addr string, fallback int) int {
+	_, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return fallback
+	}
+
+	value, err := strconv.Atoi(port)
+	if err != nil {
+		return fallback
+	}
+
+	return value
+}
@@ -0,0 +1,181 @@
+package docker
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	anyHost    = "0.0.0.0"
+	mysqlProto = "@tcp("
+)
+
+// rewriter rewrites the config of a service to refer to the containers in the compose,
+// and collects the infrastructure and the services it depends on
+type rewriter struct {
+	compose *Compose
+	// ports maps the ports to the services
+	ports map[int]string
+	// keys maps the etcd keys to the rpc services
+	keys    map[string]string
+	depends map[string]bool
+}
+
+func (r *rewriter) rewrite(value interface{}, path []string) interface{} {
+	switch v := value.(type) {
+	case yaml.MapSlice:
+		for i, item := range v {
+			key := fmt.Sprint(item.Key)
+			v[i].Value = r.rewriteValue(key, item.Value, append(path, key))
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = r.rewrite(item, path)
+		}
+		return v
+	default:
+		return value
+	}
+}
+
+func (r *rewriter) rewriteValue(key string, value interface{}, path []string) interface{} {
+	switch {
+	case len(path) == 1 && key == "Host":
+		return anyHost
+	case len(path) == 1 && key == "ListenOn":
+		if addr, ok := value.(string); ok {
+			return fmt.Sprintf("%s:%d", anyHost, hostPort(addr, 0))
+		}
+	case key == "Etcd":
+		if etcd, ok := value.(yaml.MapSlice); ok {
+			return r.rewriteEtcd(etcd, len(path) > 1)
+		}
+	case key == "Host" && inRedis(path):
+		if addr, ok := value.(string); ok {
+			return r.useRedis(addr)
+		}
+	case strings.EqualFold(key, "DataSource"):
+		if dsn, ok := value.(string); ok {
+			return r.rewriteDataSource(dsn)
+		}
+	case key == "Endpoints":
+		if endpoints, ok := value.([]interface{}); ok {
+			return r.rewriteEndpoints(endpoints)
+		}
+	}
+
+	return r.rewrite(value, path)
+}
+
+// rewriteEtcd rewrites the hosts to the etcd container, the client depends on the service with the same key
+func (r *rewriter) rewriteEtcd(etcd yaml.MapSlice, client bool) yaml.MapSlice {
+	for i, item := range etcd {
+		switch item.Key {
+		case "Hosts":
+			port := etcdPort
+			if hosts, ok := item.Value.([]interface{}); ok && len(hosts) > 0 {
+				port = hostPort(fmt.Sprint(hosts[0]), etcdPort)
+			}
+			if r.compose.Etcd == nil {
+				r.compose.Etcd = &Infra{Port: port}
+			}
+
+			etcd[i].Value = []interface{}{fmt.Sprintf("%s:%d", etcdService, etcdPort)}
+			r.depends[etcdService] = true
+		case "Key":
+			if name, ok := r.keys[fmt.Sprint(item.Value)]; ok && client {
+				r.depends[name] = true
+			}
+		}
+	}
+
+	return etcd
+}
+
+func (r *rewriter) useRedis(addr string) string {
+	if r.compose.Redis == nil {
+		r.compose.Redis = &Infra{Port: hostPort(addr, redisPort)}
+	}
+
+	r.depends[redisService] = true
+	return fmt.Sprintf("%s:%d", redisService, redisPort)
+}
+
+// rewriteDataSource rewrites the address in the mysql dsn like user:password@tcp(host:port)/database?params
+func (r *rewriter) rewriteDataSource(dsn string) string {
+	pos := strings.LastIndex(dsn, mysqlProto)
+	if pos < 0 {
+		return dsn
+	}
+
+	rest := dsn[pos+len(mysqlProto):]
+	end := strings.Index(rest, ")")
+	if end < 0 {
+		return dsn
+	}
+
+	user, password := dsn[:pos], ""
+	if i := strings.Index(user, ":"); i >= 0 {
+		user, password = user[:i], user[i+1:]
+	}
+
+	database := strings.TrimPrefix(rest[end+1:], "/")
+	if i := strings.Index(database, "?"); i >= 0 {
+		database = database[:i]
+	}
+
+	if r.compose.Mysql == nil {
+		r.compose.Mysql = &Mysql{
+			Infra:    Infra{Port: hostPort(rest[:end], mysqlPort)},
+			User:     user,
+			Password: password,
+			Database: database,
+		}
+	}
+
+	m := r.compose.Mysql
+	if len(database) > 0 && !contains(m.Databases, database) {
+		m.Databases = append(m.Databases, database)
+	}
+
+	r.depends[mysqlService] = true
+	return fmt.Sprintf("%s%s%s:%d)%s", dsn[:pos], mysqlProto, mysqlService, mysqlPort, rest[end+1:])
+}
+
+// rewriteEndpoints rewrites the direct endpoints of the rpc clients to the services with the same ports
+func (r *rewriter) rewriteEndpoints(endpoints []interface{}) []interface{} {
+	for i, item := range endpoints {
+		port := hostPort(fmt.Sprint(item), 0)
+		if name, ok := r.ports[port]; ok {
+			endpoints[i] = fmt.Sprintf("%s:%d", name, port)
+			r.depends[name] = true
+		}
+	}
+
+	return endpoints
+}
+
+// inRedis returns true if the key is in the redis or cache configs, such as Redis.Host and CacheRedis[0].Host
+func inRedis(path []string) bool {
+	for _, item := range path[:len(path)-1] {
+		lower := strings.ToLower(item)
+		if strings.Contains(lower, "redis") || strings.Contains(lower, "cache") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
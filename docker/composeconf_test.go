@@ -0,0 +1,79 @@
+package docker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestRewriter(t *testing.T) {
+	var conf yaml.MapSlice
+	err := yaml.Unmarshal([]byte(`Name: order.rpc
+ListenOn: 127.0.0.1:8081
+Etcd:
+  Hosts:
+  - 127.0.0.1:2379
+  Key: order.rpc
+Mysql:
+  DataSource: foo:bar@tcp(localhost:3307)/order?parseTime=true
+CacheRedis:
+- Host: 127.0.0.1:6380
+  Type: node
+UserRpc:
+  Etcd:
+    Hosts:
+    - 127.0.0.1:2379
+    Key: user.rpc
+PayRpc:
+  Endpoints:
+  - 127.0.0.1:8082
+`), &conf)
+	assert.Nil(t, err)
+
+	compose := new(Compose)
+	r := &rewriter{
+		compose: compose,
+		ports:   map[int]string{8082: "pay-rpc"},
+		keys:    map[string]string{"user.rpc": "user-rpc", "order.rpc": "order-rpc"},
+		depends: make(map[string]bool),
+	}
+	data, err := yaml.Marshal(r.rewrite(conf, nil))
+	assert.Nil(t, err)
+	assert.Equal(t, `Name: order.rpc
+ListenOn: 0.0.0.0:8081
+Etcd:
+  Hosts:
+  - etcd:2379
+  Key: order.rpc
+Mysql:
+  DataSource: foo:bar@tcp(mysql:3306)/order?parseTime=true
+CacheRedis:
+- Host: redis:6379
+  Type: node
+UserRpc:
+  Etcd:
+    Hosts:
+    - etcd:2379
+    Key: user.rpc
+PayRpc:
+  Endpoints:
+  - pay-rpc:8082
+`, string(data))
+	assert.Equal(t, map[string]bool{
+		"etcd":     true,
+		"mysql":    true,
+		"redis":    true,
+		"user-rpc": true,
+		"pay-rpc":  true,
+	}, r.depends)
+	assert.Equal(t, &Infra{Port: 2379}, compose.Etcd)
+	assert.Equal(t, &Infra{Port: 6380}, compose.Redis)
+	assert.Equal(t, &Mysql{
+		Infra:     Infra{Port: 3307},
+		User:      "foo",
+		Password:  "bar",
+		Database:  "order",
+		Databases: []string{"order"},
+	}, compose.Mysql)
+}
@@ -1,8 +1,11 @@
 package docker
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"go/token"
 	"os"
 	"path/filepath"
 	"strings"
@@ -16,10 +19,18 @@ import (
 )
 
 const (
-	dockerfileName = "Dockerfile"
-	etcDir         = "etc"
-	yamlEtx        = ".yaml"
-	cstOffset      = 60 * 60 * 8 // 8 hours offset for Chinese Standard Time
+	dockerfileName  = "Dockerfile"
+	etcDir          = "etc"
+	yamlEtx         = ".yaml"
+	cstOffset       = 60 * 60 * 8 // 8 hours offset for Chinese Standard Time
+	chineseGoProxy  = "https://goproxy.cn,direct"
+	alpineBase      = "alpine"
+	distrolessBase  = "distroless"
+	scratchBase     = "scratch"
+	distrolessImage = "gcr.io/distroless/static"
+	alpineUser      = "app"
+	distrolessUser  = "nonroot:nonroot"
+	scratchUser     = "65534:65534"
 )
 
 // Docker describes a dockerfile
@@ -31,6 +42,28 @@ type Docker struct {
 	HasPort   bool
 	Port      int
 	Argument  string
+	// GoVersion is the version of the golang image to build, such as 1.16, the latest if empty
+	GoVersion string
+	// Base is the runtime base, which is alpine, distroless or scratch
+	Base      string
+	BaseImage string
+	Timezone  string
+	GoProxy   string
+	// BuildArgs are declared by ARG in the builder and set to the string variables of package main by -ldflags -X
+	BuildArgs []BuildArg
+	Cgo       bool
+	MultiArch bool
+	// User is the non-root user to run, empty for root
+	User        string
+	HealthCheck string
+}
+
+// BuildArg describes a build arg, such as VERSION=v1.0.0
+type BuildArg struct {
+	// Name is the name of the arg and the variable in package main
+	Name string
+	// Decl is the declaration of the arg with the optional default value
+	Decl string
 }
 
 // DockerCommand provides the entry for goctl docker
@@ -50,9 +83,13 @@ func DockerCommand(c *cli.Context) (err error) {
 		return fmt.Errorf("file %q not found", goFile)
 	}
 
-	port := c.Int("port")
+	docker, err := newDocker(c)
+	if err != nil {
+		return err
+	}
+
 	if _, err := os.Stat(etcDir); os.IsNotExist(err) {
-		return generateDockerfile(goFile, port)
+		return generateDockerfile(goFile, docker)
 	}
 
 	cfg, err := findConfig(goFile, etcDir)
@@ -60,7 +97,7 @@ func DockerCommand(c *cli.Context) (err error) {
 		return err
 	}
 
-	if err := generateDockerfile(goFile, port, "-f", "etc/"+cfg); err != nil {
+	if err := generateDockerfile(goFile, docker, "-f", "etc/"+cfg); err != nil {
 		return err
 	}
 
@@ -72,6 +109,90 @@ func DockerCommand(c *cli.Context) (err error) {
 	return nil
 }
 
+// newDocker returns the options of the dockerfile from the flags
+func newDocker(c *cli.Context) (Docker, error) {
+	_, offset := time.Now().Zone()
+	docker := Docker{
+		Chinese:     offset == cstOffset,
+		Port:        c.Int("port"),
+		HasPort:     c.Int("port") > 0,
+		GoVersion:   c.String("goVersion"),
+		Base:        c.String("base"),
+		Timezone:    c.String("tz"),
+		GoProxy:     c.String("goproxy"),
+		Cgo:         c.Bool("cgo"),
+		MultiArch:   c.Bool("multiArch"),
+		HealthCheck: c.String("healthcheck"),
+	}
+	if len(strings.TrimSpace(docker.Timezone)) == 0 {
+		return docker, errors.New("-tz can't be empty")
+	}
+
+	for _, item := range c.StringSlice("buildArg") {
+		name := strings.SplitN(item, "=", 2)[0]
+		if !token.IsIdentifier(name) {
+			return docker, fmt.Errorf("invalid build arg %q, expected NAME or NAME=value with NAME a go identifier", item)
+		}
+
+		docker.BuildArgs = append(docker.BuildArgs, BuildArg{
+			Name: name,
+			Decl: item,
+		})
+	}
+
+	if !c.IsSet("goproxy") && docker.Chinese {
+		docker.GoProxy = chineseGoProxy
+	}
+
+	nonRoot := c.Bool("nonroot")
+	switch docker.Base {
+	case alpineBase:
+		docker.BaseImage = alpineBase
+		if nonRoot {
+			docker.User = alpineUser
+		}
+	case distrolessBase:
+		docker.BaseImage = distrolessImage
+		if nonRoot {
+			docker.BaseImage += ":nonroot"
+			docker.User = distrolessUser
+		}
+	case scratchBase:
+		docker.BaseImage = scratchBase
+		if nonRoot {
+			docker.User = scratchUser
+		}
+	default:
+		return docker, fmt.Errorf("unsupported base %q, expected alpine, distroless or scratch", docker.Base)
+	}
+
+	if docker.Cgo && docker.Base != alpineBase {
+		return docker, errors.New("cgo is only supported with the alpine base, which has the musl libc")
+	}
+	if docker.Cgo && docker.MultiArch {
+		return docker, errors.New("cgo can't be enabled with multiArch, which cross compiles on the build platform")
+	}
+
+	if len(docker.HealthCheck) > 0 && docker.Base != alpineBase && !isExecForm(docker.HealthCheck) {
+		return docker, fmt.Errorf(`there is no shell in the %s base, -healthcheck should be in the exec form `+
+			`with a binary in the image, such as ["/app/%s", "-health"]`, docker.Base,
+			util.FileNameWithoutExt(filepath.Base(c.String("go"))))
+	}
+
+	return docker, nil
+}
+
+// isExecForm returns true if the command is a json array of strings, such as ["/app/user", "-health"],
+// which is run without a shell
+func isExecForm(command string) bool {
+	var args []string
+	if err := json.Unmarshal([]byte(command), &args); err != nil {
+		return false
+	}
+
+	return len(args) > 0 && len(args[0]) > 0
+}
+
 func findConfig(file, dir string) (string, error) {
 	var files []string
 	err := filepath.Walk(dir, func(path string, f os.FileInfo, _ error) error {
@@ -101,7 +222,7 @@ func findConfig(file, dir string) (string, error) {
 	return files[0], nil
 }
 
-func generateDockerfile(goFile string, port int, args ...string) error {
+func generateDockerfile(goFile string, docker Docker, args ...string) error {
 	projPath, err := getFilePath(filepath.Dir(goFile))
 	if err != nil {
 		return err
@@ -116,12 +237,6 @@ func generateDockerfile(goFile string, port int, args ...string) error {
 		}
 	}
 
-	out, err := util.CreateIfNotExist(dockerfileName)
-	if err != nil {
-		return err
-	}
-	defer out.Close()
-
 	text, err := ctlutil.LoadTemplate(category, dockerTemplateFile, dockerTemplate)
 	if err != nil {
 		return err
@@ -132,17 +247,18 @@ func generateDockerfile(goFile string, port int, args ...string) error {
 		builder.WriteString(`, "` + arg + `"`)
 	}
 
-	_, offset := time.Now().Zone()
+	docker.GoRelPath = projPath
+	docker.GoFile = goFile
+	docker.ExeFile = util.FileNameWithoutExt(filepath.Base(goFile))
+	docker.Argument = builder.String()
 	t := template.Must(template.New("dockerfile").Parse(text))
-	return t.Execute(out, Docker{
-		Chinese:   offset == cstOffset,
-		GoRelPath: projPath,
-		GoFile:    goFile,
-		ExeFile:   util.FileNameWithoutExt(filepath.Base(goFile)),
-		HasPort:   port > 0,
-		Port:      port,
-		Argument:  builder.String(),
-	})
+	var buffer bytes.Buffer
+	err = t.Execute(&buffer, docker)
+	if err != nil {
+		return err
+	}
+
+	return util.CreateFile(dockerfileName, buffer.Bytes())
 }
 
 func getFilePath(file string) (string, error) {
@@ -0,0 +1,61 @@
+package docker
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+func TestIsExecForm(t *testing.T) {
+	assert.True(t, isExecForm(`["/app/user", "-health"]`))
+	assert.False(t, isExecForm(`[]`))
+	assert.False(t, isExecForm(`[""]`))
+	assert.False(t, isExecForm(`wget -qO- http://localhost:8888/ping || exit 1`))
+	assert.False(t, isExecForm(`["/app/user", 1]`))
+}
+
+func TestNewDocker(t *testing.T) {
+	_, err := newDocker(newContext(t, "-tz", ""))
+	assert.EqualError(t, err, "-tz can't be empty")
+
+	_, err = newDocker(newContext(t, "-buildArg", "APP-VERSION=v1"))
+	assert.Error(t, err)
+
+	docker, err := newDocker(newContext(t, "-buildArg", "VERSION=v1.0.0", "-buildArg", "COMMIT"))
+	assert.Nil(t, err)
+	assert.Equal(t, []BuildArg{
+		{Name: "VERSION", Decl: "VERSION=v1.0.0"},
+		{Name: "COMMIT", Decl: "COMMIT"},
+	}, docker.BuildArgs)
+
+	docker.GoRelPath = "service/user"
+	docker.GoFile = "user.go"
+	docker.ExeFile = "user"
+	var buffer bytes.Buffer
+	assert.Nil(t, template.Must(template.New("dockerfile").Parse(dockerTemplate)).Execute(&buffer, docker))
+	assert.Contains(t, buffer.String(), "ARG VERSION=v1.0.0\nARG COMMIT\n")
+	assert.Contains(t, buffer.String(),
+		`RUN go build -ldflags="-s -w -X main.VERSION=$VERSION -X main.COMMIT=$COMMIT" -o /app/user service/user/user.go`)
+}
+
+func newContext(t *testing.T, args ...string) *cli.Context {
+	set := flag.NewFlagSet("docker", flag.ContinueOnError)
+	set.String("go", "user.go", "")
+	set.String("base", alpineBase, "")
+	set.String("tz", "Asia/Shanghai", "")
+	set.String("goproxy", "", "")
+	set.String("goVersion", "", "")
+	set.String("healthcheck", "", "")
+	set.Int("port", 0, "")
+	set.Bool("cgo", false, "")
+	set.Bool("multiArch", false, "")
+	set.Bool("nonroot", false, "")
+	set.Var(&cli.StringSlice{}, "buildArg", "")
+	assert.Nil(t, set.Parse(args))
+
+	return cli.NewContext(nil, set, nil)
+}
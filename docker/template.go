@@ -1,20 +1,32 @@
 package docker
 
 import (
+	"fmt"
+
 	"github.com/urfave/cli"
 	"github.com/zeromicro/goctl/util"
 )
 
 const (
-	category           = "docker"
-	dockerTemplateFile = "docker.tpl"
-	dockerTemplate     = `FROM golang:alpine AS builder
+	category            = "docker"
+	dockerTemplateFile  = "docker.tpl"
+	composeTemplateFile = "compose.tpl"
+	dockerTemplate      = `FROM {{if .MultiArch}}--platform=$BUILDPLATFORM {{end}}golang:{{if .GoVersion}}{{.GoVersion}}-{{end}}alpine AS builder
 
 LABEL stage=gobuilder
 
-ENV CGO_ENABLED 0
-ENV GOOS linux
-{{if .Chinese}}ENV GOPROXY https://goproxy.cn,direct
+ENV CGO_ENABLED {{if .Cgo}}1{{else}}0{{end}}
+{{if .MultiArch}}ARG TARGETOS
+ARG TARGETARCH
+ENV GOOS $TARGETOS
+ENV GOARCH $TARGETARCH
+{{else}}ENV GOOS linux
+{{end}}{{if .GoProxy}}ENV GOPROXY {{.GoProxy}}
+{{end}}{{range .BuildArgs}}ARG {{.Decl}}
+{{end}}{{if .Cgo}}
+RUN apk update --no-cache && apk add --no-cache gcc musl-dev
+{{end}}{{if eq .Base "scratch"}}
+RUN apk update --no-cache && apk add --no-cache ca-certificates tzdata
 {{end}}
 WORKDIR /build/zero
 
@@ -23,24 +35,75 @@ ADD go.sum .
 RUN go mod download
 COPY . .
 {{if .Argument}}COPY {{.GoRelPath}}/etc /app/etc
-{{end}}RUN go build -ldflags="-s -w" -o /app/{{.ExeFile}} {{.GoRelPath}}/{{.GoFile}}
+{{end}}RUN go build -ldflags="-s -w{{range .BuildArgs}} -X main.{{.Name}}=${{.Name}}{{end}}" -o /app/{{.ExeFile}} {{.GoRelPath}}/{{.GoFile}}
 
 
-FROM alpine
-
-RUN apk update --no-cache && apk add --no-cache ca-certificates tzdata
-ENV TZ Asia/Shanghai
+FROM {{.BaseImage}}
 
+{{if eq .Base "alpine"}}RUN apk update --no-cache && apk add --no-cache ca-certificates tzdata
+{{else if eq .Base "scratch"}}COPY --from=builder /etc/ssl/certs/ca-certificates.crt /etc/ssl/certs/ca-certificates.crt
+COPY --from=builder /usr/share/zoneinfo/{{.Timezone}} /usr/share/zoneinfo/{{.Timezone}}
+{{end}}ENV TZ {{.Timezone}}
+{{if and .User (eq .Base "alpine")}}RUN addgroup -S {{.User}} && adduser -S -G {{.User}} {{.User}}
+{{end}}
 WORKDIR /app
 COPY --from=builder /app/{{.ExeFile}} /app/{{.ExeFile}}{{if .Argument}}
 COPY --from=builder /app/etc /app/etc{{end}}
 {{if .HasPort}}
 EXPOSE {{.Port}}
+{{end}}{{if .User}}
+USER {{.User}}
+{{end}}{{if .HealthCheck}}
+HEALTHCHECK --interval=30s --timeout=3s --retries=3 CMD {{.HealthCheck}}
 {{end}}
 CMD ["./{{.ExeFile}}"{{.Argument}}]
 `
+	composeTemplate = `version: "3"
+
+services:{{with .Etcd}}
+  etcd:
+    image: bitnami/etcd:3.4.15
+    environment:
+      - ALLOW_NONE_AUTHENTICATION=yes
+      - ETCD_ADVERTISE_CLIENT_URLS=http://etcd:2379
+    ports:
+      - "{{.Port}}:2379"
+{{end}}{{with .Redis}}
+  redis:
+    image: redis:6.2-alpine
+    ports:
+      - "{{.Port}}:6379"
+{{end}}{{with .Mysql}}
+  mysql:
+    image: mysql:8.0
+    environment:
+      {{if .Password}}MYSQL_ROOT_PASSWORD: "{{.Password}}"{{else}}MYSQL_ALLOW_EMPTY_PASSWORD: "yes"{{end}}{{if and .User (ne .User "root")}}
+      MYSQL_USER: "{{.User}}"
+      MYSQL_PASSWORD: "{{.Password}}"{{end}}{{if .Database}}
+      MYSQL_DATABASE: "{{.Database}}"{{end}}
+    ports:
+      - "{{.Port}}:3306"{{if .InitScript}}
+    volumes:
+      - {{.InitScript}}:/docker-entrypoint-initdb.d/init.sql{{end}}
+{{end}}{{range .Services}}
+  {{.Name}}:
+    build:
+      context: {{.Context}}
+      dockerfile: {{.Dockerfile}}{{if .Port}}
+    ports:
+      - "{{.Port}}:{{.Port}}"{{end}}
+    volumes:
+      - {{.Config}}:/app/etc/{{.ConfigFile}}{{if .DependsOn}}
+    depends_on:{{range .DependsOn}}
+      - {{.}}{{end}}{{end}}
+{{end}}`
 )
 
+var templates = map[string]string{
+	dockerTemplateFile:  dockerTemplate,
+	composeTemplateFile: composeTemplate,
+}
+
 // Clean deletes all templates files
 func Clean() error {
 	return util.Clean(category)
@@ -58,7 +121,11 @@ func Category() string {
 
 // RevertTemplate recovers the deleted template files
 func RevertTemplate(name string) error {
-	return util.CreateTemplate(category, name, dockerTemplate)
+	content, ok := templates[name]
+	if !ok {
+		return fmt.Errorf("%s: no such file name", name)
+	}
+	return util.CreateTemplate(category, name, content)
 }
 
 // Update deletes and creates new template files
@@ -72,7 +139,5 @@ func Update() error {
 }
 
 func initTemplate() error {
-	return util.InitTemplates(category, map[string]string{
-		dockerTemplateFile: dockerTemplate,
-	})
+	return util.InitTemplates(category, templates)
 }
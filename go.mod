@@ -9,10 +9,16 @@ require (
 	github.com/fatih/structtag v1.2.0
 	github.com/go-sql-driver/mysql v1.5.0
 	github.com/go-xorm/builder v0.3.4
+	github.com/golang/protobuf v1.5.2 // indirect
 	github.com/iancoleman/strcase v0.1.3
+	github.com/lib/pq v1.10.0
 	github.com/logrusorgru/aurora v2.0.3+incompatible
+	github.com/mattn/go-sqlite3 v1.14.6
+	github.com/pmezard/go-difflib v1.0.0
 	github.com/stretchr/testify v1.7.0
 	github.com/tal-tech/go-zero v1.1.5
 	github.com/urfave/cli v1.22.5
 	github.com/xwb1989/sqlparser v0.0.0-20180606152119-120387863bf2
+	google.golang.org/protobuf v1.27.1 // pinned for rpc/compiler, see rpc/compiler/gengo.go
+	gopkg.in/yaml.v2 v2.4.0
 )
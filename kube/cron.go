@@ -0,0 +1,147 @@
+package kube
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+	// blank is true if ? is allowed for no specific value
+	blank bool
+}
+
+var (
+	cronFields = []cronField{
+		{name: "minute", min: 0, max: 59},
+		{name: "hour", min: 0, max: 23},
+		{name: "day of month", min: 1, max: 31, blank: true},
+		{name: "month", min: 1, max: 12, names: map[string]int{
+			"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+			"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+		}},
+		{name: "day of week", min: 0, max: 6, names: map[string]int{
+			"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+		}, blank: true},
+	}
+	cronDescriptors = map[string]bool{
+		"@yearly":   true,
+		"@annually": true,
+		"@monthly":  true,
+		"@weekly":   true,
+		"@daily":    true,
+		"@midnight": true,
+		"@hourly":   true,
+	}
+)
+
+// validateSchedule checks the schedule in the cron format which the kubernetes cronjob accepts,
+// that is the standard five fields or the predefined descriptors such as @daily and @every 1h
+func validateSchedule(schedule string) error {
+	schedule = strings.TrimSpace(schedule)
+	if len(schedule) == 0 {
+		return errors.New("empty schedule")
+	}
+
+	if strings.HasPrefix(schedule, "@") {
+		return validateDescriptor(schedule)
+	}
+
+	fields := strings.Fields(schedule)
+	if len(fields) != len(cronFields) {
+		return fmt.Errorf("invalid schedule %q: expected %d fields, but got %d",
+			schedule, len(cronFields), len(fields))
+	}
+
+	for i, field := range fields {
+		if err := cronFields[i].validate(field); err != nil {
+			return fmt.Errorf("invalid schedule %q: %v", schedule, err)
+		}
+	}
+
+	return nil
+}
+
+func validateDescriptor(schedule string) error {
+	if cronDescriptors[schedule] {
+		return nil
+	}
+
+	const every = "@every "
+	if strings.HasPrefix(schedule, every) {
+		duration, err := time.ParseDuration(strings.TrimSpace(schedule[len(every):]))
+		if err != nil {
+			return fmt.Errorf("invalid schedule %q: %v", schedule, err)
+		}
+		if duration <= 0 {
+			return fmt.Errorf("invalid schedule %q: the duration should be positive", schedule)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("invalid schedule %q: unknown descriptor", schedule)
+}
+
+// validate checks the field like 1,5-10,*/15 with the allowed range and names
+func (f cronField) validate(field string) error {
+	for _, item := range strings.Split(field, ",") {
+		expr := item
+		if pos := strings.Index(item, "/"); pos >= 0 {
+			step, err := strconv.Atoi(item[pos+1:])
+			if err != nil || step <= 0 {
+				return fmt.Errorf("invalid step of %s in %q", f.name, item)
+			}
+
+			expr = item[:pos]
+		}
+
+		if expr == "*" || expr == "?" && f.blank {
+			continue
+		}
+
+		bounds := strings.Split(expr, "-")
+		if len(bounds) > 2 {
+			return fmt.Errorf("invalid range of %s in %q", f.name, item)
+		}
+
+		var values []int
+		for _, bound := range bounds {
+			value, err := f.value(bound)
+			if err != nil {
+				return fmt.Errorf("%v in %q", err, item)
+			}
+
+			values = append(values, value)
+		}
+		if len(values) == 2 && values[0] > values[1] {
+			return fmt.Errorf("invalid range of %s in %q: %d is greater than %d", f.name, item,
+				values[0], values[1])
+		}
+	}
+
+	return nil
+}
+
+func (f cronField) value(text string) (int, error) {
+	if value, ok := f.names[strings.ToLower(text)]; ok {
+		return value, nil
+	}
+
+	value, err := strconv.Atoi(text)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value of %s %q", f.name, text)
+	}
+
+	if value < f.min || value > f.max {
+		return 0, fmt.Errorf("%s %d out of range [%d, %d]", f.name, value, f.min, f.max)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,41 @@
+package kube
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSchedule(t *testing.T) {
+	for _, schedule := range []string{
+		"* * * * *",
+		"*/15 0-6,22 1 jan-jun MON-FRI",
+		"0 0 ? * 0",
+		"5 4 * * sun",
+		"@daily",
+		"@every 1h30m",
+	} {
+		assert.Nil(t, validateSchedule(schedule), schedule)
+	}
+
+	for _, schedule := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 7",
+		"? * * * *",
+		"*/0 * * * *",
+		"10-5 * * * *",
+		"1-2-3 * * * *",
+		"* * * foo *",
+		"@fortnightly",
+		"@every 0s",
+		"@every tomorrow",
+	} {
+		assert.NotNil(t, validateSchedule(schedule), schedule)
+	}
+}
@@ -0,0 +1,154 @@
+package kube
+
+var (
+	helmChartTemplate = `apiVersion: v2
+name: {{.Name}}
+description: A Helm chart for {{.Name}}
+type: application
+version: 0.1.0
+appVersion: "{{.ImageTag}}"
+`
+
+	helmValuesTemplate = `replicaCount: {{.Replicas}}
+revisionHistoryLimit: {{.Revisions}}
+
+image:
+  repository: {{.ImageRepo}}
+  tag: "{{.ImageTag}}"
+  # the digest takes precedence over the tag if not empty
+  digest: "{{.ImageDigest}}"
+  pullPolicy: IfNotPresent
+
+imagePullSecrets:{{if .Secret}}
+  - name: {{.Secret}}{{else}} []{{end}}
+
+service:
+  port: {{.Port}}
+  # 0 to disable the NodePort type
+  nodePort: {{.NodePort}}
+
+resources:
+  requests:
+    cpu: {{.RequestCpu}}m
+    memory: {{.RequestMem}}Mi
+  limits:
+    cpu: {{.LimitCpu}}m
+    memory: {{.LimitMem}}Mi
+
+autoscaling:
+  enabled: true
+  minReplicas: {{.MinReplicas}}
+  maxReplicas: {{.MaxReplicas}}
+  targetCPUUtilizationPercentage: 80
+  targetMemoryUtilizationPercentage: 80
+
+timezone: {{.Timezone}}
+`
+
+	// the helm templates are executed by helm rather than goctl
+	helmDeploymentTemplate = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ .Chart.Name }}
+  namespace: {{ .Release.Namespace }}
+  labels:
+    app: {{ .Chart.Name }}
+spec:
+  {{- if not .Values.autoscaling.enabled }}
+  replicas: {{ .Values.replicaCount }}
+  {{- end }}
+  revisionHistoryLimit: {{ .Values.revisionHistoryLimit }}
+  selector:
+    matchLabels:
+      app: {{ .Chart.Name }}
+  template:
+    metadata:
+      labels:
+        app: {{ .Chart.Name }}
+    spec:
+      {{- with .Values.imagePullSecrets }}
+      imagePullSecrets:
+        {{- toYaml . | nindent 8 }}
+      {{- end }}
+      containers:
+      - name: {{ .Chart.Name }}
+        image: "{{ .Values.image.repository }}:{{ .Values.image.tag | default .Chart.AppVersion }}{{ with .Values.image.digest }}@{{ . }}{{ end }}"
+        imagePullPolicy: {{ .Values.image.pullPolicy }}
+        lifecycle:
+          preStop:
+            exec:
+              command: ["sh","-c","sleep 5"]
+        ports:
+        - containerPort: {{ .Values.service.port }}
+        readinessProbe:
+          tcpSocket:
+            port: {{ .Values.service.port }}
+          initialDelaySeconds: 5
+          periodSeconds: 10
+        livenessProbe:
+          tcpSocket:
+            port: {{ .Values.service.port }}
+          initialDelaySeconds: 15
+          periodSeconds: 20
+        resources:
+          {{- toYaml .Values.resources | nindent 10 }}
+        volumeMounts:
+        - name: timezone
+          mountPath: /etc/localtime
+      volumes:
+        - name: timezone
+          hostPath:
+            path: /usr/share/zoneinfo/{{ .Values.timezone }}
+`
+
+	helmServiceTemplate = `apiVersion: v1
+kind: Service
+metadata:
+  name: {{ .Chart.Name }}-svc
+  namespace: {{ .Release.Namespace }}
+spec:
+  {{- if .Values.service.nodePort }}
+  type: NodePort
+  {{- end }}
+  ports:
+  - port: {{ .Values.service.port }}
+    protocol: TCP
+    targetPort: {{ .Values.service.port }}
+    {{- if .Values.service.nodePort }}
+    nodePort: {{ .Values.service.nodePort }}
+    {{- end }}
+  selector:
+    app: {{ .Chart.Name }}
+`
+
+	helmHpaTemplate = `{{- if .Values.autoscaling.enabled }}
+apiVersion: autoscaling/v2
+kind: HorizontalPodAutoscaler
+metadata:
+  name: {{ .Chart.Name }}-hpa
+  namespace: {{ .Release.Namespace }}
+  labels:
+    app: {{ .Chart.Name }}-hpa
+spec:
+  scaleTargetRef:
+    apiVersion: apps/v1
+    kind: Deployment
+    name: {{ .Chart.Name }}
+  minReplicas: {{ .Values.autoscaling.minReplicas }}
+  maxReplicas: {{ .Values.autoscaling.maxReplicas }}
+  metrics:
+  - type: Resource
+    resource:
+      name: cpu
+      target:
+        type: Utilization
+        averageUtilization: {{ .Values.autoscaling.targetCPUUtilizationPercentage }}
+  - type: Resource
+    resource:
+      name: memory
+      target:
+        type: Utilization
+        averageUtilization: {{ .Values.autoscaling.targetMemoryUtilizationPercentage }}
+{{- end }}
+`
+)
@@ -1,20 +1,22 @@
 package kube
 
-var jobTmeplate = `apiVersion: batch/v1
+var jobTemplate = `apiVersion: batch/v1
 kind: CronJob
 metadata:
   name: {{.Name}}
   namespace: {{.Namespace}}
 spec:
-  successfulJobsHistoryLimit: {{.SuccessfulJobsHistoryLimit}}
   schedule: "{{.Schedule}}"
+  concurrencyPolicy: {{.ConcurrencyPolicy}}
+  successfulJobsHistoryLimit: {{.SuccessfulJobsHistoryLimit}}
+  failedJobsHistoryLimit: {{.FailedJobsHistoryLimit}}
   jobTemplate:
     spec:
       template:
         spec:
           containers:
           - name: {{.Name}}
-            image: # todo image url
+            image: {{.Image}}
             resources:
               requests:
                 cpu: {{.RequestCpu}}m
@@ -25,15 +27,15 @@ spec:
             command:
             - ./{{.ServiceName}}
             - -f
-            - ./{{.Name}}.yaml
+            - {{.Config}}
             volumeMounts:
             - name: timezone
               mountPath: /etc/localtime
-          imagePullSecrets:
-          - name: # registry secret, if no, remove this
-          restartPolicy: OnFailure
+          {{if .Secret}}imagePullSecrets:
+          - name: {{.Secret}}
+          {{end}}restartPolicy: OnFailure
           volumes:
           - name: timezone
             hostPath:
-              path: /usr/share/zoneinfo/Asia/Shanghai
+              path: /usr/share/zoneinfo/{{.Timezone}}
 `
@@ -1,6 +1,7 @@
 package kube
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"text/template"
@@ -18,6 +19,37 @@ const (
 	portLimit          = 32767
 )
 
+var (
+	templates = map[string]string{
+		deployTemplateFile:                   deploymentTemplate,
+		jobTemplateFile:                      jobTemplate,
+		manifestDeploymentTemplateFile:       manifestDeploymentTemplate,
+		serviceTemplateFile:                  serviceTemplate,
+		configMapTemplateFile:                configMapTemplate,
+		ingressTemplateFile:                  ingressTemplate,
+		hpaTemplateFile:                      hpaTemplate,
+		serviceMonitorTemplateFile:           serviceMonitorTemplate,
+		pdbTemplateFile:                      pdbTemplate,
+		helmChartTemplateFile:                helmChartTemplate,
+		helmValuesTemplateFile:               helmValuesTemplate,
+		helmDeploymentTemplateFile:           helmDeploymentTemplate,
+		helmServiceTemplateFile:              helmServiceTemplate,
+		helmHpaTemplateFile:                  helmHpaTemplate,
+		kustomizeBaseTemplateFile:            kustomizeBaseTemplate,
+		kustomizeDeploymentTemplateFile:      kustomizeDeploymentTemplate,
+		kustomizeServiceTemplateFile:         kustomizeServiceTemplate,
+		kustomizeHpaTemplateFile:             kustomizeHpaTemplate,
+		kustomizeOverlayTemplateFile:         kustomizeOverlayTemplate,
+		kustomizeDeploymentPatchTemplateFile: kustomizeDeploymentPatchTemplate,
+		kustomizeHpaPatchTemplateFile:        kustomizeHpaPatchTemplate,
+	}
+	concurrencyPolicies = map[string]bool{
+		"Allow":   true,
+		"Forbid":  true,
+		"Replace": true,
+	}
+)
+
 // Deployment describes the k8s deployment yaml
 type Deployment struct {
 	Name        string
@@ -37,6 +69,25 @@ type Deployment struct {
 	MaxReplicas int
 }
 
+// Job describes the k8s cronjob yaml
+type Job struct {
+	Name                       string
+	Namespace                  string
+	Image                      string
+	Secret                     string
+	ServiceName                string
+	Config                     string
+	Schedule                   string
+	ConcurrencyPolicy          string
+	SuccessfulJobsHistoryLimit int
+	FailedJobsHistoryLimit     int
+	RequestCpu                 int
+	RequestMem                 int
+	LimitCpu                   int
+	LimitMem                   int
+	Timezone                   string
+}
+
 // DeploymentCommand is used to generate the kubernetes deployment yaml files.
 func DeploymentCommand(c *cli.Context) error {
 	nodePort := c.Int("nodePort")
@@ -50,14 +101,9 @@ func DeploymentCommand(c *cli.Context) error {
 		return err
 	}
 
-	out, err := util.CreateIfNotExist(c.String("o"))
-	if err != nil {
-		return err
-	}
-	defer out.Close()
-
 	t := template.Must(template.New("deploymentTemplate").Parse(text))
-	err = t.Execute(out, Deployment{
+	var buffer bytes.Buffer
+	err = t.Execute(&buffer, Deployment{
 		Name:        c.String("name"),
 		Namespace:   c.String("namespace"),
 		Image:       c.String("image"),
@@ -78,6 +124,71 @@ func DeploymentCommand(c *cli.Context) error {
 		return err
 	}
 
+	err = util.CreateFile(c.String("o"), buffer.Bytes())
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(aurora.Green("Done."))
+	return nil
+}
+
+// JobCommand is used to generate the kubernetes cronjob yaml files.
+func JobCommand(c *cli.Context) error {
+	schedule := c.String("schedule")
+	if err := validateSchedule(schedule); err != nil {
+		return err
+	}
+
+	policy := c.String("concurrencyPolicy")
+	if !concurrencyPolicies[policy] {
+		return fmt.Errorf("concurrencyPolicy should be one of Allow, Forbid and Replace, but got %q", policy)
+	}
+
+	successfulLimit, failedLimit := c.Int("successfulJobsHistoryLimit"), c.Int("failedJobsHistoryLimit")
+	if successfulLimit < 0 || failedLimit < 0 {
+		return errors.New("the history limits should not be negative")
+	}
+
+	name := c.String("name")
+	config := c.String("config")
+	if len(config) == 0 {
+		config = fmt.Sprintf("etc/%s.yaml", name)
+	}
+
+	text, err := util.LoadTemplate(category, jobTemplateFile, jobTemplate)
+	if err != nil {
+		return err
+	}
+
+	t := template.Must(template.New("jobTemplate").Parse(text))
+	var buffer bytes.Buffer
+	err = t.Execute(&buffer, Job{
+		Name:                       name,
+		Namespace:                  c.String("namespace"),
+		Image:                      c.String("image"),
+		Secret:                     c.String("secret"),
+		ServiceName:                name,
+		Config:                     config,
+		Schedule:                   schedule,
+		ConcurrencyPolicy:          policy,
+		SuccessfulJobsHistoryLimit: successfulLimit,
+		FailedJobsHistoryLimit:     failedLimit,
+		RequestCpu:                 c.Int("requestCpu"),
+		RequestMem:                 c.Int("requestMem"),
+		LimitCpu:                   c.Int("limitCpu"),
+		LimitMem:                   c.Int("limitMem"),
+		Timezone:                   c.String("timezone"),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = util.CreateFile(c.String("o"), buffer.Bytes())
+	if err != nil {
+		return err
+	}
+
 	fmt.Println(aurora.Green("Done."))
 	return nil
 }
@@ -94,15 +205,16 @@ func Clean() error {
 
 // GenTemplates generates the deployment template files.
 func GenTemplates(_ *cli.Context) error {
-	return util.InitTemplates(category, map[string]string{
-		deployTemplateFile: deploymentTemplate,
-		jobTemplateFile:    jobTmeplate,
-	})
+	return util.InitTemplates(category, templates)
 }
 
 // RevertTemplate reverts the given template file to the default value.
 func RevertTemplate(name string) error {
-	return util.CreateTemplate(category, name, deploymentTemplate)
+	content, ok := templates[name]
+	if !ok {
+		return fmt.Errorf("%s: no such file name", name)
+	}
+	return util.CreateTemplate(category, name, content)
 }
 
 // Update updates the template files to the templates built in current goctl.
@@ -112,8 +224,5 @@ func Update() error {
 		return err
 	}
 
-	return util.InitTemplates(category, map[string]string{
-		deployTemplateFile: deploymentTemplate,
-		jobTemplateFile:    jobTmeplate,
-	})
+	return util.InitTemplates(category, templates)
 }
@@ -0,0 +1,144 @@
+package kube
+
+var (
+	kustomizeBaseTemplate = `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+- deployment.yaml
+- service.yaml
+- hpa.yaml
+`
+
+	kustomizeDeploymentTemplate = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{.Name}}
+  labels:
+    app: {{.Name}}
+spec:
+  revisionHistoryLimit: {{.Revisions}}
+  selector:
+    matchLabels:
+      app: {{.Name}}
+  template:
+    metadata:
+      labels:
+        app: {{.Name}}
+    spec:
+      containers:
+      - name: {{.Name}}
+        image: {{.ImageRepo}}
+        lifecycle:
+          preStop:
+            exec:
+              command: ["sh","-c","sleep 5"]
+        ports:
+        - containerPort: {{.Port}}
+        readinessProbe:
+          tcpSocket:
+            port: {{.Port}}
+          initialDelaySeconds: 5
+          periodSeconds: 10
+        livenessProbe:
+          tcpSocket:
+            port: {{.Port}}
+          initialDelaySeconds: 15
+          periodSeconds: 20
+        volumeMounts:
+        - name: timezone
+          mountPath: /etc/localtime
+      {{if .Secret}}imagePullSecrets:
+      - name: {{.Secret}}
+      {{end}}volumes:
+        - name: timezone
+          hostPath:
+            path: /usr/share/zoneinfo/{{.Timezone}}
+`
+
+	kustomizeServiceTemplate = `apiVersion: v1
+kind: Service
+metadata:
+  name: {{.Name}}-svc
+spec:
+  ports:
+    {{if .UseNodePort}}- nodePort: {{.NodePort}}
+      port: {{.Port}}
+      protocol: TCP
+      targetPort: {{.Port}}
+  type: NodePort{{else}}- port: {{.Port}}{{end}}
+  selector:
+    app: {{.Name}}
+`
+
+	kustomizeHpaTemplate = `apiVersion: autoscaling/v2
+kind: HorizontalPodAutoscaler
+metadata:
+  name: {{.Name}}-hpa
+  labels:
+    app: {{.Name}}-hpa
+spec:
+  scaleTargetRef:
+    apiVersion: apps/v1
+    kind: Deployment
+    name: {{.Name}}
+  minReplicas: {{.MinReplicas}}
+  maxReplicas: {{.MaxReplicas}}
+  metrics:
+  - type: Resource
+    resource:
+      name: cpu
+      target:
+        type: Utilization
+        averageUtilization: 80
+  - type: Resource
+    resource:
+      name: memory
+      target:
+        type: Utilization
+        averageUtilization: 80
+`
+
+	kustomizeOverlayTemplate = `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+namespace: {{.Namespace}}
+resources:
+- ../../base
+images:
+- name: {{.ImageRepo}}
+  newTag: "{{.ImageTag}}"{{if .ImageDigest}}
+  digest: {{.ImageDigest}}{{end}}
+replicas:
+- name: {{.Name}}
+  count: {{.Replicas}}
+patches:
+- path: deployment-patch.yaml
+- path: hpa-patch.yaml
+`
+
+	kustomizeDeploymentPatchTemplate = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{.Name}}
+spec:
+  template:
+    spec:
+      containers:
+      - name: {{.Name}}
+        resources:
+          requests:
+            cpu: {{.RequestCpu}}m
+            memory: {{.RequestMem}}Mi
+          limits:
+            cpu: {{.LimitCpu}}m
+            memory: {{.LimitMem}}Mi
+`
+
+	kustomizeHpaPatchTemplate = `apiVersion: autoscaling/v2
+kind: HorizontalPodAutoscaler
+metadata:
+  name: {{.Name}}-hpa
+spec:
+  minReplicas: {{.MinReplicas}}
+  maxReplicas: {{.MaxReplicas}}
+`
+)
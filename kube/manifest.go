@@ -0,0 +1,194 @@
+package kube
+
+var (
+	manifestDeploymentTemplate = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{.Name}}
+  namespace: {{.Namespace}}
+  labels:
+    app: {{.Name}}
+spec:
+  replicas: {{.Replicas}}
+  revisionHistoryLimit: {{.Revisions}}
+  selector:
+    matchLabels:
+      app: {{.Name}}
+  template:
+    metadata:
+      labels:
+        app: {{.Name}}
+    spec:
+      containers:
+      - name: {{.Name}}
+        image: {{.Image}}
+        lifecycle:
+          preStop:
+            exec:
+              command: ["sh","-c","sleep 5"]
+        ports:
+        - name: http
+          containerPort: {{.Port}}{{if .MetricsPort}}
+        - name: metrics
+          containerPort: {{.MetricsPort}}{{end}}{{if .Probe}}
+        readinessProbe:
+          tcpSocket:
+            port: {{.Port}}
+          initialDelaySeconds: 5
+          periodSeconds: 10
+        livenessProbe:
+          tcpSocket:
+            port: {{.Port}}
+          initialDelaySeconds: 15
+          periodSeconds: 20{{end}}
+        resources:
+          requests:
+            cpu: {{.RequestCpu}}m
+            memory: {{.RequestMem}}Mi
+          limits:
+            cpu: {{.LimitCpu}}m
+            memory: {{.LimitMem}}Mi
+        volumeMounts:
+        - name: timezone
+          mountPath: /etc/localtime{{if .ConfigFiles}}
+        - name: config
+          mountPath: /app/etc{{end}}
+      {{if .Secret}}imagePullSecrets:
+      - name: {{.Secret}}
+      {{end}}volumes:
+        - name: timezone
+          hostPath:
+            path: /usr/share/zoneinfo/{{.Timezone}}{{if .ConfigFiles}}
+        - name: config
+          configMap:
+            name: {{.Name}}-conf{{end}}
+`
+
+	serviceTemplate = `apiVersion: v1
+kind: Service
+metadata:
+  name: {{.Name}}-svc
+  namespace: {{.Namespace}}
+  labels:
+    app: {{.Name}}
+spec:
+  ports:
+  - name: http
+    port: {{.Port}}
+    protocol: TCP
+    targetPort: {{.Port}}{{if .UseNodePort}}
+    nodePort: {{.NodePort}}{{end}}{{if .MetricsPort}}
+  - name: metrics
+    port: {{.MetricsPort}}
+    protocol: TCP
+    targetPort: {{.MetricsPort}}{{end}}
+  {{if .UseNodePort}}type: NodePort
+  {{end}}selector:
+    app: {{.Name}}
+`
+
+	configMapTemplate = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{.Name}}-conf
+  namespace: {{.Namespace}}
+data:
+{{range .ConfigFiles}}  {{.Name}}: |
+{{.Content}}
+{{end}}`
+
+	ingressTemplate = `apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: {{.Name}}-ingress
+  namespace: {{.Namespace}}
+spec:
+  {{if .IngressClass}}ingressClassName: {{.IngressClass}}
+  {{end}}rules:
+  - {{if .Host}}host: {{.Host}}
+    {{end}}http:
+      paths:{{range .Prefixes}}
+      - path: {{.}}
+        pathType: Prefix
+        backend:
+          service:
+            name: {{$.Name}}-svc
+            port:
+              number: {{$.Port}}{{end}}
+`
+
+	hpaTemplate = `apiVersion: autoscaling/v2
+kind: HorizontalPodAutoscaler
+metadata:
+  name: {{.Name}}-hpa-c
+  namespace: {{.Namespace}}
+  labels:
+    app: {{.Name}}-hpa-c
+spec:
+  scaleTargetRef:
+    apiVersion: apps/v1
+    kind: Deployment
+    name: {{.Name}}
+  minReplicas: {{.MinReplicas}}
+  maxReplicas: {{.MaxReplicas}}
+  metrics:
+  - type: Resource
+    resource:
+      name: cpu
+      target:
+        type: Utilization
+        averageUtilization: 80
+
+---
+
+apiVersion: autoscaling/v2
+kind: HorizontalPodAutoscaler
+metadata:
+  name: {{.Name}}-hpa-m
+  namespace: {{.Namespace}}
+  labels:
+    app: {{.Name}}-hpa-m
+spec:
+  scaleTargetRef:
+    apiVersion: apps/v1
+    kind: Deployment
+    name: {{.Name}}
+  minReplicas: {{.MinReplicas}}
+  maxReplicas: {{.MaxReplicas}}
+  metrics:
+  - type: Resource
+    resource:
+      name: memory
+      target:
+        type: Utilization
+        averageUtilization: 80
+`
+
+	serviceMonitorTemplate = `apiVersion: monitoring.coreos.com/v1
+kind: ServiceMonitor
+metadata:
+  name: {{.Name}}
+  namespace: {{.Namespace}}
+  labels:
+    app: {{.Name}}
+spec:
+  selector:
+    matchLabels:
+      app: {{.Name}}
+  endpoints:
+  - port: metrics
+    path: {{.MetricsPath}}
+`
+
+	pdbTemplate = `apiVersion: policy/v1
+kind: PodDisruptionBudget
+metadata:
+  name: {{.Name}}-pdb
+  namespace: {{.Namespace}}
+spec:
+  minAvailable: {{.MinAvailable}}
+  selector:
+    matchLabels:
+      app: {{.Name}}
+`
+)
@@ -0,0 +1,306 @@
+package kube
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/urfave/cli"
+	"github.com/zeromicro/goctl/api/parser"
+	"github.com/zeromicro/goctl/util"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	manifestDeploymentTemplateFile = "manifest-deployment.tpl"
+	serviceTemplateFile            = "service.tpl"
+	configMapTemplateFile          = "configmap.tpl"
+	ingressTemplateFile            = "ingress.tpl"
+	hpaTemplateFile                = "hpa.tpl"
+	serviceMonitorTemplateFile     = "servicemonitor.tpl"
+	pdbTemplateFile                = "pdb.tpl"
+	defaultMetricsPath             = "/metrics"
+)
+
+type (
+	// Manifest describes the k8s resources of a go-zero service
+	Manifest struct {
+		Name         string
+		Namespace    string
+		Image        string
+		Secret       string
+		Replicas     int
+		Revisions    int
+		Port         int
+		NodePort     int
+		UseNodePort  bool
+		MetricsPort  int
+		MetricsPath  string
+		Probe        bool
+		RequestCpu   int
+		RequestMem   int
+		LimitCpu     int
+		LimitMem     int
+		MinReplicas  int
+		MaxReplicas  int
+		MinAvailable int
+		Timezone     string
+		ConfigFiles  []ConfigFile
+		Host         string
+		IngressClass string
+		Prefixes     []string
+	}
+
+	// ConfigFile describes a config file of the service in the configmap
+	ConfigFile struct {
+		Name string
+		// Content is indented to be embedded in the configmap
+		Content string
+	}
+
+	// serviceConf is the part of the go-zero service config to describe the resources
+	serviceConf struct {
+		Name       string `yaml:"Name"`
+		Port       int    `yaml:"Port"`
+		ListenOn   string `yaml:"ListenOn"`
+		Prometheus struct {
+			Port int    `yaml:"Port"`
+			Path string `yaml:"Path"`
+		} `yaml:"Prometheus"`
+	}
+
+	manifestFile struct {
+		enabled  bool
+		name     string
+		file     string
+		template string
+	}
+)
+
+// ManifestCommand is used to generate the kubernetes manifests of a go-zero service,
+// the name, ports and configmap are derived from the etc/*.yaml config, and the ingress from the api routes.
+func ManifestCommand(c *cli.Context) error {
+	nodePort := c.Int("nodePort")
+	if nodePort != 0 && (nodePort < basePort || nodePort > portLimit) {
+		return errors.New("nodePort should be between 30000 and 32767")
+	}
+
+	dir := c.String("dir")
+	conf, files, err := loadServiceConf(filepath.Join(dir, "etc"))
+	if err != nil {
+		return err
+	}
+
+	m := Manifest{
+		Name:         c.String("name"),
+		Namespace:    c.String("namespace"),
+		Image:        c.String("image"),
+		Secret:       c.String("secret"),
+		Replicas:     c.Int("replicas"),
+		Revisions:    c.Int("revisions"),
+		Port:         c.Int("port"),
+		NodePort:     nodePort,
+		UseNodePort:  nodePort > 0,
+		MetricsPort:  c.Int("metricsPort"),
+		MetricsPath:  conf.Prometheus.Path,
+		Probe:        c.BoolT("probe"),
+		RequestCpu:   c.Int("requestCpu"),
+		RequestMem:   c.Int("requestMem"),
+		LimitCpu:     c.Int("limitCpu"),
+		LimitMem:     c.Int("limitMem"),
+		MinReplicas:  c.Int("minReplicas"),
+		MaxReplicas:  c.Int("maxReplicas"),
+		MinAvailable: c.Int("minAvailable"),
+		Timezone:     c.String("timezone"),
+		Host:         c.String("host"),
+		IngressClass: c.String("ingressClass"),
+	}
+	if len(m.Name) == 0 {
+		m.Name = serviceName(conf, dir)
+	}
+	if m.Port == 0 {
+		m.Port, err = servicePort(conf)
+		if err != nil {
+			return err
+		}
+	}
+	if m.MetricsPort == 0 {
+		m.MetricsPort = conf.Prometheus.Port
+	}
+	if len(m.MetricsPath) == 0 {
+		m.MetricsPath = defaultMetricsPath
+	}
+	if c.Bool("configmap") {
+		if len(files) == 0 {
+			return fmt.Errorf("no config file found in %s", filepath.Join(dir, "etc"))
+		}
+		m.ConfigFiles = files
+	}
+	if c.Bool("ingress") {
+		m.Prefixes, err = routePrefixes(dir)
+		if err != nil {
+			return err
+		}
+	}
+	if c.Bool("serviceMonitor") && m.MetricsPort == 0 {
+		return errors.New("the metrics port is required by the service monitor, " +
+			"configure Prometheus.Port or use -metricsPort")
+	}
+
+	return genManifests(c.String("o"), m, []manifestFile{
+		{enabled: true, name: "deployment", file: manifestDeploymentTemplateFile, template: manifestDeploymentTemplate},
+		{enabled: true, name: "service", file: serviceTemplateFile, template: serviceTemplate},
+		{enabled: c.Bool("configmap"), name: "configmap", file: configMapTemplateFile, template: configMapTemplate},
+		{enabled: c.Bool("ingress"), name: "ingress", file: ingressTemplateFile, template: ingressTemplate},
+		{enabled: c.BoolT("hpa"), name: "hpa", file: hpaTemplateFile, template: hpaTemplate},
+		{enabled: c.Bool("serviceMonitor"), name: "servicemonitor", file: serviceMonitorTemplateFile,
+			template: serviceMonitorTemplate},
+		{enabled: c.Bool("pdb"), name: "pdb", file: pdbTemplateFile, template: pdbTemplate},
+	})
+}
+
+func genManifests(dir string, m Manifest, files []manifestFile) error {
+	err := util.MkdirIfNotExist(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range files {
+		if !item.enabled {
+			continue
+		}
+
+		filename := filepath.Join(dir, fmt.Sprintf("%s-%s.yaml", m.Name, item.name))
+		err = saveTemplate(item.file, item.template, filename, m)
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Println(aurora.Green("Done."))
+	return nil
+}
+
+// loadServiceConf loads the config files in dir, the first one describes the service
+func loadServiceConf(dir string) (serviceConf, []ConfigFile, error) {
+	var conf serviceConf
+	matches, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if err != nil {
+		return conf, nil, err
+	}
+
+	sort.Strings(matches)
+	var files []ConfigFile
+	for i, item := range matches {
+		content, err := ioutil.ReadFile(item)
+		if err != nil {
+			return conf, nil, err
+		}
+
+		if i == 0 {
+			if err = yaml.Unmarshal(content, &conf); err != nil {
+				return conf, nil, fmt.Errorf("%s: %v", item, err)
+			}
+		}
+
+		files = append(files, ConfigFile{
+			Name:    filepath.Base(item),
+			Content: indent(string(content), "    "),
+		})
+	}
+
+	return conf, files, nil
+}
+
+func serviceName(conf serviceConf, dir string) string {
+	name := conf.Name
+	if len(name) == 0 {
+		abs, err := filepath.Abs(dir)
+		if err == nil {
+			name = filepath.Base(abs)
+		}
+	}
+
+	return strings.ToLower(strings.NewReplacer(".", "-", "_", "-").Replace(name))
+}
+
+// servicePort returns the Port of the api service or the port in the ListenOn of the rpc service
+func servicePort(conf serviceConf) (int, error) {
+	if conf.Port > 0 {
+		return conf.Port, nil
+	}
+
+	if len(conf.ListenOn) > 0 {
+		_, port, err := net.SplitHostPort(conf.ListenOn)
+		if err != nil {
+			return 0, err
+		}
+
+		return strconv.Atoi(port)
+	}
+
+	return 0, errors.New("the port is not found in the config, use -port to specify it")
+}
+
+// routePrefixes returns the first segments of the routes in the api files of dir
+func routePrefixes(dir string) ([]string, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "*.api"))
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no api file found in %s", dir)
+	}
+
+	set := make(map[string]bool)
+	for _, item := range matches {
+		api, err := parser.Parse(item)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, route := range api.Service.Routes() {
+			set[routePrefix(route.Path)] = true
+		}
+	}
+
+	if set["/"] {
+		return []string{"/"}, nil
+	}
+
+	var prefixes []string
+	for prefix := range set {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+
+	return prefixes, nil
+}
+
+func routePrefix(path string) string {
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	if len(segments[0]) == 0 || strings.HasPrefix(segments[0], ":") {
+		return "/"
+	}
+
+	return "/" + segments[0]
+}
+
+func indent(text, prefix string) string {
+	lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+	for i, line := range lines {
+		if len(strings.TrimSpace(line)) > 0 {
+			lines[i] = prefix + line
+		} else {
+			lines[i] = ""
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,126 @@
+package kube
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestRoutePrefix(t *testing.T) {
+	assert.Equal(t, "/user", routePrefix("/user/info"))
+	assert.Equal(t, "/user", routePrefix("/user"))
+	assert.Equal(t, "/", routePrefix("/:id"))
+	assert.Equal(t, "/", routePrefix("/"))
+}
+
+func TestServicePort(t *testing.T) {
+	port, err := servicePort(serviceConf{Port: 8888})
+	assert.Nil(t, err)
+	assert.Equal(t, 8888, port)
+
+	port, err = servicePort(serviceConf{ListenOn: "127.0.0.1:8080"})
+	assert.Nil(t, err)
+	assert.Equal(t, 8080, port)
+
+	_, err = servicePort(serviceConf{})
+	assert.NotNil(t, err)
+}
+
+func TestLoadServiceConf(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goctl-kube")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	err = ioutil.WriteFile(filepath.Join(dir, "user.yaml"), []byte(`Name: user.rpc
+ListenOn: 127.0.0.1:8080
+
+Prometheus:
+  Port: 9101
+`), 0666)
+	assert.Nil(t, err)
+
+	conf, files, err := loadServiceConf(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, "user-rpc", serviceName(conf, dir))
+	assert.Equal(t, 9101, conf.Prometheus.Port)
+	assert.Equal(t, []ConfigFile{{
+		Name:    "user.yaml",
+		Content: "    Name: user.rpc\n    ListenOn: 127.0.0.1:8080\n\n    Prometheus:\n      Port: 9101",
+	}}, files)
+}
+
+func TestHpaManifest(t *testing.T) {
+	dir := t.TempDir()
+	err := genManifests(dir, Manifest{
+		Name:        "user",
+		Namespace:   "adhoc",
+		MinReplicas: 3,
+		MaxReplicas: 10,
+	}, []manifestFile{{enabled: true, name: "hpa", file: hpaTemplateFile, template: hpaTemplate}})
+	assert.Nil(t, err)
+
+	docs := readYamlDocs(t, filepath.Join(dir, "user-hpa.yaml"))
+	assert.Equal(t, 2, len(docs))
+	for _, doc := range docs {
+		assertHpa(t, doc)
+	}
+}
+
+// hpa is the part of HorizontalPodAutoscaler checked by the render tests
+type hpa struct {
+	ApiVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+	Spec       struct {
+		MinReplicas int `yaml:"minReplicas"`
+		MaxReplicas int `yaml:"maxReplicas"`
+		Metrics     []struct {
+			Resource struct {
+				Target struct {
+					Type               string `yaml:"type"`
+					AverageUtilization int    `yaml:"averageUtilization"`
+				} `yaml:"target"`
+			} `yaml:"resource"`
+		} `yaml:"metrics"`
+	} `yaml:"spec"`
+}
+
+func assertHpa(t *testing.T, doc []byte) {
+	var h hpa
+	assert.Nil(t, yaml.Unmarshal(doc, &h))
+	assert.Equal(t, "autoscaling/v2", h.ApiVersion)
+	assert.Equal(t, "HorizontalPodAutoscaler", h.Kind)
+	assert.Equal(t, 3, h.Spec.MinReplicas)
+	assert.Equal(t, 10, h.Spec.MaxReplicas)
+	assert.NotEmpty(t, h.Spec.Metrics)
+	for _, metric := range h.Spec.Metrics {
+		assert.Equal(t, "Utilization", metric.Resource.Target.Type)
+		assert.Equal(t, 80, metric.Resource.Target.AverageUtilization)
+	}
+}
+
+// readYamlDocs returns the yaml documents in file
+func readYamlDocs(t *testing.T, file string) [][]byte {
+	content, err := ioutil.ReadFile(file)
+	assert.Nil(t, err)
+
+	var docs [][]byte
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	for {
+		var doc interface{}
+		err := decoder.Decode(&doc)
+		if err == io.EOF {
+			return docs
+		}
+		assert.Nil(t, err)
+
+		data, err := yaml.Marshal(doc)
+		assert.Nil(t, err)
+		docs = append(docs, data)
+	}
+}
@@ -0,0 +1,199 @@
+package kube
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/urfave/cli"
+	"github.com/zeromicro/goctl/util"
+)
+
+const (
+	helmChartTemplateFile                = "helm-chart.tpl"
+	helmValuesTemplateFile               = "helm-values.tpl"
+	helmDeploymentTemplateFile           = "helm-deployment.tpl"
+	helmServiceTemplateFile              = "helm-service.tpl"
+	helmHpaTemplateFile                  = "helm-hpa.tpl"
+	kustomizeBaseTemplateFile            = "kustomize-base.tpl"
+	kustomizeDeploymentTemplateFile      = "kustomize-deployment.tpl"
+	kustomizeServiceTemplateFile         = "kustomize-service.tpl"
+	kustomizeHpaTemplateFile             = "kustomize-hpa.tpl"
+	kustomizeOverlayTemplateFile         = "kustomize-overlay.tpl"
+	kustomizeDeploymentPatchTemplateFile = "kustomize-deployment-patch.tpl"
+	kustomizeHpaPatchTemplateFile        = "kustomize-hpa-patch.tpl"
+	defaultImageTag                      = "latest"
+)
+
+// Package describes the helm chart or the kustomize base and overlays of the deployment,
+// the image is split into ImageRepo, ImageTag and ImageDigest to be set in the values and the overlays
+type Package struct {
+	Deployment
+	ImageRepo string
+	ImageTag  string
+	// ImageDigest is the digest of the image such as sha256:..., empty if not pinned
+	ImageDigest string
+	Timezone    string
+	// Env is the environment of the kustomize overlay
+	Env string
+}
+
+// HelmCommand is used to generate the helm chart of the deployment.
+func HelmCommand(c *cli.Context) error {
+	pkg, err := newPackage(c)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Join(c.String("o"), pkg.Name)
+	for _, item := range []struct {
+		file    string
+		builtin string
+		target  string
+		data    interface{}
+	}{
+		{helmChartTemplateFile, helmChartTemplate, "Chart.yaml", pkg},
+		{helmValuesTemplateFile, helmValuesTemplate, "values.yaml", pkg},
+		{helmDeploymentTemplateFile, helmDeploymentTemplate, "templates/deployment.yaml", nil},
+		{helmServiceTemplateFile, helmServiceTemplate, "templates/service.yaml", nil},
+		{helmHpaTemplateFile, helmHpaTemplate, "templates/hpa.yaml", nil},
+	} {
+		err = saveTemplate(item.file, item.builtin, filepath.Join(dir, item.target), item.data)
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Println(aurora.Green("Done."))
+	return nil
+}
+
+// KustomizeCommand is used to generate the kustomize base and the overlays of the environments,
+// the replicas, resources and image tag are set in the overlays rather than the base.
+func KustomizeCommand(c *cli.Context) error {
+	pkg, err := newPackage(c)
+	if err != nil {
+		return err
+	}
+
+	dir := c.String("o")
+	for _, item := range []struct {
+		file    string
+		builtin string
+		target  string
+	}{
+		{kustomizeBaseTemplateFile, kustomizeBaseTemplate, "kustomization.yaml"},
+		{kustomizeDeploymentTemplateFile, kustomizeDeploymentTemplate, "deployment.yaml"},
+		{kustomizeServiceTemplateFile, kustomizeServiceTemplate, "service.yaml"},
+		{kustomizeHpaTemplateFile, kustomizeHpaTemplate, "hpa.yaml"},
+	} {
+		err = saveTemplate(item.file, item.builtin, filepath.Join(dir, "base", item.target), pkg)
+		if err != nil {
+			return err
+		}
+	}
+
+	envs := strings.Split(c.String("envs"), ",")
+	for _, env := range envs {
+		env = strings.TrimSpace(env)
+		if len(env) == 0 {
+			continue
+		}
+
+		pkg.Env = env
+		for _, item := range []struct {
+			file    string
+			builtin string
+			target  string
+		}{
+			{kustomizeOverlayTemplateFile, kustomizeOverlayTemplate, "kustomization.yaml"},
+			{kustomizeDeploymentPatchTemplateFile, kustomizeDeploymentPatchTemplate, "deployment-patch.yaml"},
+			{kustomizeHpaPatchTemplateFile, kustomizeHpaPatchTemplate, "hpa-patch.yaml"},
+		} {
+			err = saveTemplate(item.file, item.builtin, filepath.Join(dir, "overlays", env, item.target), pkg)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	fmt.Println(aurora.Green("Done."))
+	return nil
+}
+
+func newPackage(c *cli.Context) (Package, error) {
+	nodePort := c.Int("nodePort")
+	if nodePort != 0 && (nodePort < basePort || nodePort > portLimit) {
+		return Package{}, errors.New("nodePort should be between 30000 and 32767")
+	}
+
+	image := c.String("image")
+	repo, tag, digest := splitImage(image)
+	return Package{
+		Deployment: Deployment{
+			Name:        c.String("name"),
+			Namespace:   c.String("namespace"),
+			Image:       image,
+			Secret:      c.String("secret"),
+			Replicas:    c.Int("replicas"),
+			Revisions:   c.Int("revisions"),
+			Port:        c.Int("port"),
+			NodePort:    nodePort,
+			UseNodePort: nodePort > 0,
+			RequestCpu:  c.Int("requestCpu"),
+			RequestMem:  c.Int("requestMem"),
+			LimitCpu:    c.Int("limitCpu"),
+			LimitMem:    c.Int("limitMem"),
+			MinReplicas: c.Int("minReplicas"),
+			MaxReplicas: c.Int("maxReplicas"),
+		},
+		ImageRepo:   repo,
+		ImageTag:    tag,
+		ImageDigest: digest,
+		Timezone:    c.String("timezone"),
+	}, nil
+}
+
+// splitImage splits the image into the repository, the tag and the digest, such as
+// localhost:5000/user:v1@sha256:..., the port of the registry is not a tag
+func splitImage(image string) (repo, tag, digest string) {
+	repo = image
+	if pos := strings.Index(repo, "@"); pos >= 0 {
+		repo, digest = repo[:pos], repo[pos+1:]
+	}
+
+	tag = defaultImageTag
+	pos := strings.LastIndex(repo, ":")
+	if pos < 0 || strings.Contains(repo[pos+1:], "/") {
+		return repo, tag, digest
+	}
+
+	return repo[:pos], repo[pos+1:], digest
+}
+
+// saveTemplate loads the template file and writes the target with data, the existing target is skipped,
+// the template is copied as it is if data is nil, such as the helm templates which are executed by helm
+func saveTemplate(file, builtin, target string, data interface{}) error {
+	text, err := util.LoadTemplate(category, file, builtin)
+	if err != nil {
+		return err
+	}
+
+	err = util.MkdirIfNotExist(filepath.Dir(target))
+	if err != nil {
+		return err
+	}
+
+	if data != nil {
+		return util.With(file).Parse(text).SaveTo(data, target, false)
+	}
+
+	if util.FileExists(target) {
+		util.SkipFile(target)
+		return nil
+	}
+
+	return util.WriteFile(target, []byte(text), 0666)
+}
@@ -0,0 +1,93 @@
+package kube
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+const testDigest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func TestSplitImage(t *testing.T) {
+	for _, item := range []struct {
+		image  string
+		repo   string
+		tag    string
+		digest string
+	}{
+		{"user", "user", "latest", ""},
+		{"user:v1", "user", "v1", ""},
+		{"registry.io/foo/user:v1.2.0", "registry.io/foo/user", "v1.2.0", ""},
+		{"localhost:5000/user", "localhost:5000/user", "latest", ""},
+		{"localhost:5000/user:v1", "localhost:5000/user", "v1", ""},
+		{"user@" + testDigest, "user", "latest", testDigest},
+		{"localhost:5000/user@" + testDigest, "localhost:5000/user", "latest", testDigest},
+		{"localhost:5000/user:v1@" + testDigest, "localhost:5000/user", "v1", testDigest},
+	} {
+		repo, tag, digest := splitImage(item.image)
+		assert.Equal(t, item.repo, repo, item.image)
+		assert.Equal(t, item.tag, tag, item.image)
+		assert.Equal(t, item.digest, digest, item.image)
+	}
+}
+
+func TestHelmHpa(t *testing.T) {
+	// the values are set like helm does
+	var buffer bytes.Buffer
+	err := template.Must(template.New("hpa").Parse(helmHpaTemplate)).Execute(&buffer, map[string]interface{}{
+		"Chart":   map[string]interface{}{"Name": "user"},
+		"Release": map[string]interface{}{"Namespace": "adhoc"},
+		"Values": map[string]interface{}{
+			"autoscaling": map[string]interface{}{
+				"enabled":                           true,
+				"minReplicas":                       3,
+				"maxReplicas":                       10,
+				"targetCPUUtilizationPercentage":    80,
+				"targetMemoryUtilizationPercentage": 80,
+			},
+		},
+	})
+	assert.Nil(t, err)
+	assertHpa(t, buffer.Bytes())
+}
+
+func TestKustomizeHpa(t *testing.T) {
+	dir := t.TempDir()
+	pkg := Package{
+		Deployment: Deployment{
+			Name:        "user",
+			MinReplicas: 3,
+			MaxReplicas: 10,
+		},
+		ImageRepo:   "localhost:5000/user",
+		ImageTag:    "v1",
+		ImageDigest: testDigest,
+		Env:         "prod",
+	}
+	for _, item := range []struct {
+		file    string
+		builtin string
+	}{
+		{kustomizeHpaTemplateFile, kustomizeHpaTemplate},
+		{kustomizeHpaPatchTemplateFile, kustomizeHpaPatchTemplate},
+		{kustomizeOverlayTemplateFile, kustomizeOverlayTemplate},
+	} {
+		err := saveTemplate(item.file, item.builtin, filepath.Join(dir, item.file), pkg)
+		assert.Nil(t, err)
+	}
+
+	assertHpa(t, readYamlDocs(t, filepath.Join(dir, kustomizeHpaTemplateFile))[0])
+
+	var patch hpa
+	assert.Nil(t, yaml.Unmarshal(readYamlDocs(t, filepath.Join(dir, kustomizeHpaPatchTemplateFile))[0], &patch))
+	assert.Equal(t, "autoscaling/v2", patch.ApiVersion)
+	assert.Equal(t, "HorizontalPodAutoscaler", patch.Kind)
+
+	overlay := readYamlDocs(t, filepath.Join(dir, kustomizeOverlayTemplateFile))[0]
+	assert.Contains(t, string(overlay), "digest: "+testDigest)
+	assert.Contains(t, string(overlay), "name: localhost:5000/user")
+}
@@ -0,0 +1,296 @@
+package generate
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/zeromicro/goctl/util/stringx"
+)
+
+const (
+	defaultIdField = "ID"
+	idTag          = "_id"
+	tagKey         = "goctl"
+	tagUnique      = "unique"
+	tagIndex       = "index"
+)
+
+type (
+	// Field describes a field of the type marked with goctl:"unique" or goctl:"index"
+	Field struct {
+		// Name is the name of the field in go
+		Name string
+		// Arg is the argument name of the field in the find methods
+		Arg string
+		// Type is the go type of the field
+		Type string
+		// Key is the key of the field in the document
+		Key    string
+		Unique bool
+	}
+
+	// typeInfo describes the fields of a struct type in the types files
+	typeInfo struct {
+		IdField string
+		Uniques []Field
+		Indexes []Field
+		// StdImports and Imports are the imports of the packages referred by the types of the fields
+		StdImports []string
+		Imports    []string
+	}
+)
+
+// parseTypes parses the struct types of names in the go files of dir, the files which can't be parsed are ignored,
+// the type not found in the files generates the model with the ID field only
+func parseTypes(dir string, d driver, names []string) (map[string]*typeInfo, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(matches)
+	wanted := make(map[string]bool)
+	for _, name := range names {
+		wanted[name] = true
+	}
+
+	types := make(map[string]*typeInfo)
+	for _, item := range matches {
+		if strings.HasSuffix(item, "_test.go") {
+			continue
+		}
+
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, item, nil, 0)
+		if err != nil {
+			continue
+		}
+
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				st, ok := ts.Type.(*ast.StructType)
+				if !ok || !wanted[ts.Name.Name] {
+					continue
+				}
+
+				info, err := parseStruct(file, st, d)
+				if err != nil {
+					return nil, fmt.Errorf("type %s: %v", ts.Name.Name, err)
+				}
+
+				types[ts.Name.Name] = info
+			}
+		}
+	}
+
+	return types, nil
+}
+
+// parseStruct parses the fields of st, the field with the bson key _id must be the ObjectId type of the driver
+func parseStruct(file *ast.File, st *ast.StructType, d driver) (*typeInfo, error) {
+	info := &typeInfo{IdField: defaultIdField}
+	imports := make(map[string]bool)
+	for _, field := range st.Fields.List {
+		var tag reflect.StructTag
+		if field.Tag != nil {
+			value, err := strconv.Unquote(field.Tag.Value)
+			if err == nil {
+				tag = reflect.StructTag(value)
+			}
+		}
+
+		for _, name := range field.Names {
+			if !name.IsExported() {
+				continue
+			}
+
+			key := bsonKey(name.Name, tag)
+			if key == idTag {
+				if !isType(file, field.Type, d.idPackage, d.idType) {
+					return nil, fmt.Errorf("the _id field %s must be %s.%s of %q for the driver, but got %s",
+						name.Name, filepath.Base(d.idPackage), d.idType, d.idPackage, exprString(field.Type))
+				}
+
+				info.IdField = name.Name
+				continue
+			}
+			if len(key) == 0 {
+				continue
+			}
+
+			unique, index := parseTag(tag.Get(tagKey))
+			if !unique && !index {
+				continue
+			}
+
+			f := Field{
+				Name:   name.Name,
+				Arg:    argName(name.Name),
+				Type:   exprString(field.Type),
+				Key:    key,
+				Unique: unique,
+			}
+			if unique {
+				info.Uniques = append(info.Uniques, f)
+			} else {
+				info.Indexes = append(info.Indexes, f)
+			}
+			collectImports(file, field.Type, d.imports, imports)
+		}
+	}
+
+	for item, std := range imports {
+		if std {
+			info.StdImports = append(info.StdImports, item)
+		} else {
+			info.Imports = append(info.Imports, item)
+		}
+	}
+	sort.Strings(info.StdImports)
+	sort.Strings(info.Imports)
+
+	return info, nil
+}
+
+// isType returns true if expr refers to the type named name in the package of path
+func isType(file *ast.File, expr ast.Expr, path, name string) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != name {
+		return false
+	}
+
+	pkg, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return false
+	}
+
+	for _, spec := range file.Imports {
+		if spec.Path.Value != strconv.Quote(path) {
+			continue
+		}
+
+		if spec.Name != nil {
+			return spec.Name.Name == pkg.Name
+		}
+
+		return filepath.Base(path) == pkg.Name
+	}
+
+	return false
+}
+
+// bsonKey returns the key of the field in the document like mgo does, empty if the field is omitted
+func bsonKey(name string, tag reflect.StructTag) string {
+	value, ok := tag.Lookup("bson")
+	if !ok {
+		return strings.ToLower(name)
+	}
+
+	key := strings.Split(value, ",")[0]
+	switch key {
+	case "-":
+		return ""
+	case "":
+		return strings.ToLower(name)
+	default:
+		return key
+	}
+}
+
+func parseTag(value string) (unique, index bool) {
+	for _, item := range strings.Split(value, ",") {
+		switch strings.TrimSpace(item) {
+		case tagUnique:
+			unique = true
+		case tagIndex:
+			index = true
+		}
+	}
+
+	return
+}
+
+func argName(name string) string {
+	arg := stringx.From(name).Untitle()
+	switch {
+	case token.Lookup(arg).IsKeyword():
+		return arg + "Value"
+	case arg == "ctx", arg == "data", arg == "key", arg == "query", arg == "page", arg == "size":
+		return arg + "Value"
+	}
+
+	return arg
+}
+
+func exprString(expr ast.Expr) string {
+	switch v := expr.(type) {
+	case *ast.Ident:
+		return v.Name
+	case *ast.StarExpr:
+		return "*" + exprString(v.X)
+	case *ast.SelectorExpr:
+		return exprString(v.X) + "." + v.Sel.Name
+	case *ast.ArrayType:
+		if v.Len == nil {
+			return "[]" + exprString(v.Elt)
+		}
+		if lit, ok := v.Len.(*ast.BasicLit); ok {
+			return "[" + lit.Value + "]" + exprString(v.Elt)
+		}
+	case *ast.MapType:
+		return "map[" + exprString(v.Key) + "]" + exprString(v.Value)
+	}
+
+	return "interface{}"
+}
+
+// collectImports collects the imports of the packages referred by the type of the field except the builtin ones
+// imported by the model template, the value of imports is true if the package is in the standard library
+func collectImports(file *ast.File, expr ast.Expr, builtinImports, imports map[string]bool) {
+	ast.Inspect(expr, func(node ast.Node) bool {
+		sel, ok := node.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		pkg, ok := sel.X.(*ast.Ident)
+		if !ok {
+			return true
+		}
+
+		for _, spec := range file.Imports {
+			path, err := strconv.Unquote(spec.Path.Value)
+			if err != nil {
+				continue
+			}
+
+			if builtinImports[path] {
+				continue
+			}
+
+			std := !strings.Contains(strings.Split(path, "/")[0], ".")
+			if spec.Name != nil {
+				if spec.Name.Name == pkg.Name {
+					imports[spec.Name.Name+` "`+path+`"`] = std
+				}
+			} else if filepath.Base(path) == pkg.Name {
+				imports[`"`+path+`"`] = std
+			}
+		}
+
+		return false
+	})
+}
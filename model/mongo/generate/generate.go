@@ -2,6 +2,7 @@ package generate
 
 import (
 	"errors"
+	"fmt"
 	"path/filepath"
 
 	"github.com/zeromicro/goctl/config"
@@ -10,12 +11,66 @@ import (
 	"github.com/zeromicro/goctl/util/format"
 )
 
+const (
+	// DriverMgo generates the models with github.com/globalsign/mgo and the mongoc of go-zero
+	DriverMgo = "mgo"
+	// DriverOfficial generates the models with the official driver go.mongodb.org/mongo-driver
+	DriverOfficial = "official"
+)
+
 // Context defines the model generation data what they needs
 type Context struct {
 	Types  []string
 	Cache  bool
 	Output string
 	Cfg    *config.Config
+	// Driver is DriverMgo if empty
+	Driver string
+}
+
+// driver describes the templates of a mongo driver and the packages imported by the model template
+type driver struct {
+	modelFile     string
+	modelTemplate string
+	errFile       string
+	errTemplate   string
+	imports       map[string]bool
+	// idPackage and idType are the type of the field with the bson key _id
+	idPackage string
+	idType    string
+}
+
+var drivers = map[string]driver{
+	DriverMgo: {
+		modelFile:     modelTemplateFile,
+		modelTemplate: template.Text,
+		errFile:       errTemplateFile,
+		errTemplate:   template.Error,
+		imports: map[string]bool{
+			"context":                        true,
+			"fmt":                            true,
+			"github.com/globalsign/mgo":      true,
+			"github.com/globalsign/mgo/bson": true,
+		},
+		idPackage: "github.com/globalsign/mgo/bson",
+		idType:    "ObjectId",
+	},
+	DriverOfficial: {
+		modelFile:     officialModelTemplateFile,
+		modelTemplate: template.OfficialText,
+		errFile:       officialErrTemplateFile,
+		errTemplate:   template.OfficialError,
+		imports: map[string]bool{
+			"context":                          true,
+			"fmt":                              true,
+			"go.mongodb.org/mongo-driver/bson": true,
+			"go.mongodb.org/mongo-driver/bson/primitive": true,
+			"go.mongodb.org/mongo-driver/mongo":          true,
+			"go.mongodb.org/mongo-driver/mongo/options":  true,
+		},
+		idPackage: "go.mongodb.org/mongo-driver/bson/primitive",
+		idType:    "ObjectID",
+	},
 }
 
 // Do executes model template and output the result into the specified file path
@@ -24,30 +79,55 @@ func Do(ctx *Context) error {
 		return errors.New("missing config")
 	}
 
-	err := generateModel(ctx)
+	name := ctx.Driver
+	if len(name) == 0 {
+		name = DriverMgo
+	}
+	d, ok := drivers[name]
+	if !ok {
+		return fmt.Errorf("unsupported driver %q, expected %s or %s", name, DriverMgo, DriverOfficial)
+	}
+
+	err := generateModel(ctx, d)
 	if err != nil {
 		return err
 	}
 
-	return generateError(ctx)
+	return generateError(ctx, d)
 }
 
-func generateModel(ctx *Context) error {
+func generateModel(ctx *Context, d driver) error {
+	types, err := parseTypes(ctx.Output, d, ctx.Types)
+	if err != nil {
+		return err
+	}
+
 	for _, t := range ctx.Types {
+		info, ok := types[t]
+		if !ok {
+			info = &typeInfo{IdField: defaultIdField}
+		}
+
 		fn, err := format.FileNamingFormat(ctx.Cfg.NamingFormat, t+"_model")
 		if err != nil {
 			return err
 		}
 
-		text, err := util.LoadTemplate(category, modelTemplateFile, template.Text)
+		text, err := util.LoadTemplate(category, d.modelFile, d.modelTemplate)
 		if err != nil {
 			return err
 		}
 
 		output := filepath.Join(ctx.Output, fn+".go")
 		err = util.With("model").Parse(text).GoFmt(true).SaveTo(map[string]interface{}{
-			"Type":  t,
-			"Cache": ctx.Cache,
+			"Type":       t,
+			"Cache":      ctx.Cache,
+			"IdField":    info.IdField,
+			"Uniques":    info.Uniques,
+			"Indexes":    info.Indexes,
+			"HasIndex":   len(info.Uniques)+len(info.Indexes) > 0,
+			"StdImports": info.StdImports,
+			"Imports":    info.Imports,
 		}, output, false)
 		if err != nil {
 			return err
@@ -57,8 +137,8 @@ func generateModel(ctx *Context) error {
 	return nil
 }
 
-func generateError(ctx *Context) error {
-	text, err := util.LoadTemplate(category, errTemplateFile, template.Error)
+func generateError(ctx *Context, d driver) error {
+	text, err := util.LoadTemplate(category, d.errFile, d.errTemplate)
 	if err != nil {
 		return err
 	}
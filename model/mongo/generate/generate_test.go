@@ -32,3 +32,148 @@ func TestDo(t *testing.T) {
 
 	assert.Nil(t, err)
 }
+
+var testFieldTypes = `package model
+
+import (
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+)
+
+type Account struct {
+	Id       bson.ObjectId ` + "`bson:\"_id\"`" + `
+	Email    string        ` + "`bson:\"email\" goctl:\"unique\"`" + `
+	Type     int           ` + "`goctl:\"index\"`" + `
+	CreateAt time.Time     ` + "`bson:\"createAt,omitempty\" goctl:\"index\"`" + `
+	Ignored  string        ` + "`bson:\"-\" goctl:\"index\"`" + `
+	Name     string
+}
+`
+
+func TestParseTypes(t *testing.T) {
+	tempDir := t.TempDir()
+	err := ioutil.WriteFile(filepath.Join(tempDir, "types.go"), []byte(testFieldTypes), 0666)
+	assert.Nil(t, err)
+
+	types, err := parseTypes(tempDir, drivers[DriverMgo], []string{"Account"})
+	assert.Nil(t, err)
+
+	info, ok := types["Account"]
+	assert.True(t, ok)
+	assert.Equal(t, "Id", info.IdField)
+	assert.Equal(t, []Field{
+		{Name: "Email", Arg: "email", Type: "string", Key: "email", Unique: true},
+	}, info.Uniques)
+	assert.Equal(t, []Field{
+		{Name: "Type", Arg: "typeValue", Type: "int", Key: "type"},
+		{Name: "CreateAt", Arg: "createAt", Type: "time.Time", Key: "createAt"},
+	}, info.Indexes)
+	assert.Equal(t, []string{`"time"`}, info.StdImports)
+	assert.Empty(t, info.Imports)
+}
+
+func TestDoWithFields(t *testing.T) {
+	cfg, err := config.NewConfig(config.DefaultFormat)
+	assert.Nil(t, err)
+
+	tempDir := t.TempDir()
+	err = ioutil.WriteFile(filepath.Join(tempDir, "types.go"), []byte(testFieldTypes), 0666)
+	assert.Nil(t, err)
+
+	err = Do(&Context{
+		Types:  []string{"Account"},
+		Cache:  true,
+		Output: tempDir,
+		Cfg:    cfg,
+	})
+	assert.Nil(t, err)
+
+	content, err := ioutil.ReadFile(filepath.Join(tempDir, "accountmodel.go"))
+	assert.Nil(t, err)
+
+	code := string(content)
+	assert.Contains(t, code, `prefixAccountEmailCacheKey = "cache#Account#email#"`)
+	assert.Contains(t, code, "FindOneByEmail(ctx context.Context, email string) (*Account, error)")
+	assert.Contains(t, code, "FindManyByType(ctx context.Context, typeValue int, page, size int) ([]*Account, error)")
+	assert.Contains(t, code, "FindManyByCreateAt(ctx context.Context, createAt time.Time, page, size int)")
+	assert.Contains(t, code, "Count(ctx context.Context, query bson.M) (int, error)")
+	assert.Contains(t, code, `{Key: []string{"email"}, Unique: true, Background: true}`)
+	assert.Contains(t, code, "data.Id.Hex()")
+	assert.NotContains(t, code, "Ignored")
+}
+
+var testOfficialFieldTypes = `package model
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+type Account struct {
+	Id    primitive.ObjectID ` + "`bson:\"_id\"`" + `
+	Email string             ` + "`bson:\"email\" goctl:\"unique\"`" + `
+	Type  int                ` + "`goctl:\"index\"`" + `
+}
+`
+
+func TestParseTypesIdField(t *testing.T) {
+	tempDir := t.TempDir()
+	err := ioutil.WriteFile(filepath.Join(tempDir, "types.go"), []byte(testOfficialFieldTypes), 0666)
+	assert.Nil(t, err)
+
+	types, err := parseTypes(tempDir, drivers[DriverOfficial], []string{"Account"})
+	assert.Nil(t, err)
+	assert.Equal(t, "Id", types["Account"].IdField)
+
+	_, err = parseTypes(tempDir, drivers[DriverMgo], []string{"Account"})
+	assert.EqualError(t, err, `type Account: the _id field Id must be bson.ObjectId of "github.com/globalsign/mgo/bson" for the driver, but got primitive.ObjectID`)
+
+	// the types not generated are not checked
+	types, err = parseTypes(tempDir, drivers[DriverMgo], []string{"User"})
+	assert.Nil(t, err)
+	assert.Empty(t, types)
+
+	err = ioutil.WriteFile(filepath.Join(tempDir, "types.go"), []byte(testFieldTypes), 0666)
+	assert.Nil(t, err)
+
+	_, err = parseTypes(tempDir, drivers[DriverOfficial], []string{"Account"})
+	assert.EqualError(t, err, `type Account: the _id field Id must be primitive.ObjectID of "go.mongodb.org/mongo-driver/bson/primitive" for the driver, but got bson.ObjectId`)
+}
+
+func TestDoOfficial(t *testing.T) {
+	cfg, err := config.NewConfig(config.DefaultFormat)
+	assert.Nil(t, err)
+
+	tempDir := t.TempDir()
+	err = ioutil.WriteFile(filepath.Join(tempDir, "types.go"), []byte(testOfficialFieldTypes), 0666)
+	assert.Nil(t, err)
+
+	err = Do(&Context{
+		Types:  []string{"Account"},
+		Cache:  true,
+		Output: tempDir,
+		Cfg:    cfg,
+		Driver: DriverOfficial,
+	})
+	assert.Nil(t, err)
+
+	content, err := ioutil.ReadFile(filepath.Join(tempDir, "accountmodel.go"))
+	assert.Nil(t, err)
+
+	code := string(content)
+	assert.Contains(t, code, `"go.mongodb.org/mongo-driver/mongo"`)
+	assert.NotContains(t, code, "globalsign")
+	assert.Contains(t, code, "func NewAccountModel(db *mongo.Database, collection string, c cache.CacheConf) AccountModel")
+	assert.Contains(t, code, "data.Id = primitive.NewObjectID()")
+	assert.Contains(t, code, "FindManyByType(ctx context.Context, typeValue int, page, size int64) ([]*Account, error)")
+
+	content, err = ioutil.ReadFile(filepath.Join(tempDir, "error.go"))
+	assert.Nil(t, err)
+	assert.Contains(t, string(content), "var ErrNotFound = mongo.ErrNoDocuments")
+
+	err = Do(&Context{
+		Types:  []string{"Account"},
+		Output: t.TempDir(),
+		Cfg:    cfg,
+		Driver: "unknown",
+	})
+	assert.NotNil(t, err)
+}
@@ -9,14 +9,18 @@ import (
 )
 
 const (
-	category          = "mongo"
-	modelTemplateFile = "model.tpl"
-	errTemplateFile   = "err.tpl"
+	category                  = "mongo"
+	modelTemplateFile         = "model.tpl"
+	errTemplateFile           = "err.tpl"
+	officialModelTemplateFile = "model-official.tpl"
+	officialErrTemplateFile   = "err-official.tpl"
 )
 
 var templates = map[string]string{
-	modelTemplateFile: template.Text,
-	errTemplateFile:   template.Error,
+	modelTemplateFile:         template.Text,
+	errTemplateFile:           template.Error,
+	officialModelTemplateFile: template.OfficialText,
+	officialErrTemplateFile:   template.OfficialError,
 }
 
 // Category returns the mongo category.
@@ -16,6 +16,7 @@ func Action(ctx *cli.Context) error {
 	c := ctx.Bool("cache")
 	o := strings.TrimSpace(ctx.String("dir"))
 	s := ctx.String("style")
+	d := strings.TrimSpace(ctx.String("driver"))
 	if len(tp) == 0 {
 		return errors.New("missing type")
 	}
@@ -35,5 +36,6 @@ func Action(ctx *cli.Context) error {
 		Cache:  c,
 		Output: a,
 		Cfg:    cfg,
+		Driver: d,
 	})
 }
@@ -0,0 +1,213 @@
+package template
+
+// OfficialText provides the default template for model to generate with the official mongo driver
+var OfficialText = `package model
+
+import (
+    "context"
+    {{if and .Cache .Uniques}}"fmt"{{end}}
+    {{range .StdImports}}{{.}}
+    {{end}}
+
+    {{if .Cache}}"github.com/tal-tech/go-zero/core/stores/cache"
+    "github.com/tal-tech/go-zero/core/syncx"{{end}}
+    "go.mongodb.org/mongo-driver/bson"
+    "go.mongodb.org/mongo-driver/bson/primitive"
+    "go.mongodb.org/mongo-driver/mongo"
+    "go.mongodb.org/mongo-driver/mongo/options"
+    {{if .Imports}}
+    {{range .Imports}}{{.}}
+    {{end}}{{end}}
+)
+
+{{if .Cache}}{{if .Uniques}}var (
+	prefix{{.Type}}CacheKey = "cache#{{.Type}}#"
+	{{range .Uniques}}prefix{{$.Type}}{{.Name}}CacheKey = "cache#{{$.Type}}#{{.Key}}#"
+	{{end}}
+){{else}}var prefix{{.Type}}CacheKey = "cache#{{.Type}}#"{{end}}{{end}}
+
+type {{.Type}}Model interface{
+	Insert(ctx context.Context, data *{{.Type}}) error
+	FindOne(ctx context.Context, id string) (*{{.Type}}, error)
+	{{range .Uniques}}FindOneBy{{.Name}}(ctx context.Context, {{.Arg}} {{.Type}}) (*{{$.Type}}, error)
+	{{end}}{{range .Indexes}}FindManyBy{{.Name}}(ctx context.Context, {{.Arg}} {{.Type}}, page, size int64) ([]*{{$.Type}}, error)
+	{{end}}FindPage(ctx context.Context, filter bson.M, page, size int64) ([]*{{.Type}}, error)
+	Count(ctx context.Context, filter bson.M) (int64, error)
+	Update(ctx context.Context, data *{{.Type}}) error
+	Delete(ctx context.Context, id string) error
+	{{if .HasIndex}}EnsureIndexes(ctx context.Context) error{{end}}
+}
+
+type default{{.Type}}Model struct {
+    collection *mongo.Collection
+    {{if .Cache}}cache cache.Cache{{end}}
+}
+
+func New{{.Type}}Model(db *mongo.Database, collection string{{if .Cache}}, c cache.CacheConf{{end}}) {{.Type}}Model {
+	return &default{{.Type}}Model{
+		collection: db.Collection(collection),
+		{{if .Cache}}cache: cache.New(c, syncx.NewSharedCalls(), cache.NewStat("mongo"), ErrNotFound),{{end}}
+	}
+}
+
+func (m *default{{.Type}}Model) Insert(ctx context.Context, data *{{.Type}}) error {
+    if data.{{.IdField}}.IsZero() {
+        data.{{.IdField}} = primitive.NewObjectID()
+    }
+
+    _, err := m.collection.InsertOne(ctx, data)
+    {{if and .Cache .Uniques}}if err != nil {
+        return err
+    }
+
+    // removes the not found placeholders of the unique keys
+    return m.cache.Del({{range $i, $v := .Uniques}}{{if $i}}, {{end}}fmt.Sprintf("%s%v", prefix{{$.Type}}{{.Name}}CacheKey, data.{{.Name}}){{end}})
+    {{- else}}return err{{end}}
+}
+
+func (m *default{{.Type}}Model) FindOne(ctx context.Context, id string) (*{{.Type}}, error) {
+    oid, err := primitive.ObjectIDFromHex(id)
+    if err != nil {
+        return nil, ErrInvalidObjectId
+    }
+
+    var data {{.Type}}
+    {{if .Cache}}key := prefix{{.Type}}CacheKey + id
+    err = m.cache.Take(&data, key, func(v interface{}) error {
+        return m.collection.FindOne(ctx, bson.M{"_id": oid}).Decode(v)
+    })
+    {{- else}}err = m.collection.FindOne(ctx, bson.M{"_id": oid}).Decode(&data){{end}}
+    if err != nil {
+        return nil, err
+    }
+
+    return &data, nil
+}
+{{range .Uniques}}
+func (m *default{{$.Type}}Model) FindOneBy{{.Name}}(ctx context.Context, {{.Arg}} {{.Type}}) (*{{$.Type}}, error) {
+    var data {{$.Type}}
+    filter := bson.M{"{{.Key}}": {{.Arg}}}
+    {{if $.Cache}}key := fmt.Sprintf("%s%v", prefix{{$.Type}}{{.Name}}CacheKey, {{.Arg}})
+    err := m.cache.Take(&data, key, func(v interface{}) error {
+        return m.collection.FindOne(ctx, filter).Decode(v)
+    })
+    {{- else}}err := m.collection.FindOne(ctx, filter).Decode(&data){{end}}
+    if err != nil {
+        return nil, err
+    }
+
+    return &data, nil
+}
+{{end}}{{range .Indexes}}
+func (m *default{{$.Type}}Model) FindManyBy{{.Name}}(ctx context.Context, {{.Arg}} {{.Type}}, page, size int64) ([]*{{$.Type}}, error) {
+    return m.FindPage(ctx, bson.M{"{{.Key}}": {{.Arg}}}, page, size)
+}
+{{end}}
+// FindPage finds the documents of the page starts from 1 in the reverse order of _id, all the documents are returned if size is 0
+func (m *default{{.Type}}Model) FindPage(ctx context.Context, filter bson.M, page, size int64) ([]*{{.Type}}, error) {
+    if filter == nil {
+        filter = bson.M{}
+    }
+    if page < 1 {
+        page = 1
+    }
+
+    opts := options.Find().SetSort(bson.M{"_id": -1})
+    if size > 0 {
+        opts.SetSkip((page - 1) * size).SetLimit(size)
+    }
+
+    cursor, err := m.collection.Find(ctx, filter, opts)
+    if err != nil {
+        return nil, err
+    }
+
+    var data []*{{.Type}}
+    if err = cursor.All(ctx, &data); err != nil {
+        return nil, err
+    }
+
+    return data, nil
+}
+
+func (m *default{{.Type}}Model) Count(ctx context.Context, filter bson.M) (int64, error) {
+    if filter == nil {
+        filter = bson.M{}
+    }
+
+    return m.collection.CountDocuments(ctx, filter)
+}
+
+func (m *default{{.Type}}Model) Update(ctx context.Context, data *{{.Type}}) error {
+    {{if and .Cache .Uniques}}old, err := m.FindOne(ctx, data.{{.IdField}}.Hex())
+    if err != nil {
+        return err
+    }
+
+    {{end}}res, err := m.collection.ReplaceOne(ctx, bson.M{"_id": data.{{.IdField}}}, data)
+    if err != nil {
+        return err
+    }
+    if res.MatchedCount == 0 {
+        return ErrNotFound
+    }
+
+    {{if and .Cache .Uniques}}return m.cache.Del(prefix{{.Type}}CacheKey + data.{{.IdField}}.Hex(),
+        {{range .Uniques}}fmt.Sprintf("%s%v", prefix{{$.Type}}{{.Name}}CacheKey, old.{{.Name}}),
+        fmt.Sprintf("%s%v", prefix{{$.Type}}{{.Name}}CacheKey, data.{{.Name}}),
+        {{end}})
+    {{- else if .Cache}}return m.cache.Del(prefix{{.Type}}CacheKey + data.{{.IdField}}.Hex())
+    {{- else}}return nil{{end}}
+}
+
+func (m *default{{.Type}}Model) Delete(ctx context.Context, id string) error {
+    oid, err := primitive.ObjectIDFromHex(id)
+    if err != nil {
+        return ErrInvalidObjectId
+    }
+
+    {{if and .Cache .Uniques}}data, err := m.FindOne(ctx, id)
+    if err != nil {
+        return err
+    }
+
+    {{end}}res, err := m.collection.DeleteOne(ctx, bson.M{"_id": oid})
+    if err != nil {
+        return err
+    }
+    if res.DeletedCount == 0 {
+        return ErrNotFound
+    }
+
+    {{if and .Cache .Uniques}}return m.cache.Del(prefix{{.Type}}CacheKey + id,
+        {{range .Uniques}}fmt.Sprintf("%s%v", prefix{{$.Type}}{{.Name}}CacheKey, data.{{.Name}}),
+        {{end}})
+    {{- else if .Cache}}return m.cache.Del(prefix{{.Type}}CacheKey + id)
+    {{- else}}return nil{{end}}
+}
+{{if .HasIndex}}
+// EnsureIndexes creates the indexes of the unique and index fields
+func (m *default{{.Type}}Model) EnsureIndexes(ctx context.Context) error {
+    _, err := m.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+        {{range .Uniques}}{Keys: bson.M{"{{.Key}}": 1}, Options: options.Index().SetUnique(true)},
+        {{end}}{{range .Indexes}}{Keys: bson.M{"{{.Key}}": 1}},
+        {{end}}
+    })
+
+    return err
+}
+{{end}}`
+
+// OfficialError provides the default template for error definition with the official mongo driver,
+// ErrNotFound is the error of the driver to be returned by the cache and the queries as well.
+var OfficialError = `
+package model
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var ErrNotFound = mongo.ErrNoDocuments
+var ErrInvalidObjectId = primitive.ErrInvalidHex
+`
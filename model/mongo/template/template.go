@@ -5,35 +5,54 @@ var Text = `package model
 
 import (
     "context"
+    {{if and .Cache .Uniques}}"fmt"{{end}}
+    {{range .StdImports}}{{.}}
+    {{end}}
 
+    {{if .HasIndex}}"github.com/globalsign/mgo"{{end}}
     "github.com/globalsign/mgo/bson"
      cachec "github.com/tal-tech/go-zero/core/stores/cache"
+	"github.com/tal-tech/go-zero/core/stores/mongo"
 	"github.com/tal-tech/go-zero/core/stores/mongoc"
+	{{if .Imports}}
+	{{range .Imports}}{{.}}
+	{{end}}{{end}}
 )
 
-{{if .Cache}}var prefix{{.Type}}CacheKey = "cache#{{.Type}}#"{{end}}
+{{if .Cache}}{{if .Uniques}}var (
+	prefix{{.Type}}CacheKey = "cache#{{.Type}}#"
+	{{range .Uniques}}prefix{{$.Type}}{{.Name}}CacheKey = "cache#{{$.Type}}#{{.Key}}#"
+	{{end}}
+){{else}}var prefix{{.Type}}CacheKey = "cache#{{.Type}}#"{{end}}{{end}}
 
 type {{.Type}}Model interface{
 	Insert(ctx context.Context,data *{{.Type}}) error
 	FindOne(ctx context.Context,id string) (*{{.Type}}, error)
+	{{range .Uniques}}FindOneBy{{.Name}}(ctx context.Context, {{.Arg}} {{.Type}}) (*{{$.Type}}, error)
+	{{end}}{{range .Indexes}}FindManyBy{{.Name}}(ctx context.Context, {{.Arg}} {{.Type}}, page, size int) ([]*{{$.Type}}, error)
+	{{end}}FindPage(ctx context.Context, query bson.M, page, size int) ([]*{{.Type}}, error)
+	Count(ctx context.Context, query bson.M) (int, error)
 	Update(ctx context.Context,data *{{.Type}}) error
 	Delete(ctx context.Context,id string) error
+	{{if .HasIndex}}EnsureIndexes(ctx context.Context) error{{end}}
 }
 
 type default{{.Type}}Model struct {
     *mongoc.Model
+    {{if .HasIndex}}collection string{{end}}
 }
 
 func New{{.Type}}Model(url, collection string, c cachec.CacheConf) {{.Type}}Model {
 	return &default{{.Type}}Model{
 		Model: mongoc.MustNewModel(url, collection, c),
+		{{if .HasIndex}}collection: collection,{{end}}
 	}
 }
 
 
 func (m *default{{.Type}}Model) Insert(ctx context.Context, data *{{.Type}}) error {
-    if !data.ID.Valid() {
-        data.ID = bson.NewObjectId()
+    if !data.{{.IdField}}.Valid() {
+        data.{{.IdField}} = bson.NewObjectId()
     }
 
     session, err := m.TakeSession()
@@ -42,7 +61,13 @@ func (m *default{{.Type}}Model) Insert(ctx context.Context, data *{{.Type}}) err
     }
 
     defer m.PutSession(session)
-    return m.GetCollection(session).Insert(data)
+    {{if and .Cache .Uniques}}if err = m.GetCollection(session).Insert(data); err != nil {
+        return err
+    }
+
+    // removes the not found placeholders of the unique keys
+    return m.DelCache({{range $i, $v := .Uniques}}{{if $i}}, {{end}}fmt.Sprintf("%s%v", prefix{{$.Type}}{{.Name}}CacheKey, data.{{.Name}}){{end}})
+    {{- else}}return m.GetCollection(session).Insert(data){{end}}
 }
 
 func (m *default{{.Type}}Model) FindOne(ctx context.Context, id string) (*{{.Type}}, error) {
@@ -71,35 +96,120 @@ func (m *default{{.Type}}Model) FindOne(ctx context.Context, id string) (*{{.Typ
         return nil,err
     }
 }
+{{range .Uniques}}
+func (m *default{{$.Type}}Model) FindOneBy{{.Name}}(ctx context.Context, {{.Arg}} {{.Type}}) (*{{$.Type}}, error) {
+    var data {{$.Type}}
+    query := bson.M{"{{.Key}}": {{.Arg}}}
+    {{if $.Cache}}key := fmt.Sprintf("%s%v", prefix{{$.Type}}{{.Name}}CacheKey, {{.Arg}})
+    err := m.Model.FindOne(&data, key, query)
+    {{- else}}
+    err := m.Model.FindOneNoCache(&data, query)
+    {{- end}}
+    switch err {
+    case nil:
+        return &data, nil
+    case mongoc.ErrNotFound:
+        return nil, ErrNotFound
+    default:
+        return nil, err
+    }
+}
+{{end}}{{range .Indexes}}
+func (m *default{{$.Type}}Model) FindManyBy{{.Name}}(ctx context.Context, {{.Arg}} {{.Type}}, page, size int) ([]*{{$.Type}}, error) {
+    return m.FindPage(ctx, bson.M{"{{.Key}}": {{.Arg}}}, page, size)
+}
+{{end}}
+// FindPage finds the documents of the page starts from 1 in the reverse order of _id, all the documents are returned if size is 0
+func (m *default{{.Type}}Model) FindPage(ctx context.Context, query bson.M, page, size int) ([]*{{.Type}}, error) {
+    if page < 1 {
+        page = 1
+    }
+
+    var data []*{{.Type}}
+    err := m.FindAllNoCache(&data, query, func(q mongo.Query) mongo.Query {
+        return q.Sort("-_id").Skip((page - 1) * size).Limit(size)
+    })
+
+    return data, err
+}
+
+func (m *default{{.Type}}Model) Count(ctx context.Context, query bson.M) (int, error) {
+    return m.Model.Count(query)
+}
 
 func (m *default{{.Type}}Model) Update(ctx context.Context, data *{{.Type}}) error {
-    session, err := m.TakeSession()
+    {{if and .Cache .Uniques}}old, err := m.FindOne(ctx, data.{{.IdField}}.Hex())
+    if err != nil {
+        return err
+    }
+
+    {{end}}session, err := m.TakeSession()
     if err != nil {
         return err
     }
 
     defer m.PutSession(session)
-	{{if .Cache}}key := prefix{{.Type}}CacheKey + data.ID.Hex()
-    return m.GetCollection(session).UpdateId(data.ID, data, key)
+	{{if and .Cache .Uniques}}keys := []string{
+	    prefix{{.Type}}CacheKey + data.{{.IdField}}.Hex(),
+	    {{range .Uniques}}fmt.Sprintf("%s%v", prefix{{$.Type}}{{.Name}}CacheKey, old.{{.Name}}),
+	    fmt.Sprintf("%s%v", prefix{{$.Type}}{{.Name}}CacheKey, data.{{.Name}}),
+	    {{end}}
+	}
+    return m.GetCollection(session).UpdateId(data.{{.IdField}}, data, keys...)
+	{{- else if .Cache}}key := prefix{{.Type}}CacheKey + data.{{.IdField}}.Hex()
+    return m.GetCollection(session).UpdateId(data.{{.IdField}}, data, key)
 	{{- else}}
-	return m.GetCollection(session).UpdateIdNoCache(data.ID, data)
+	return m.GetCollection(session).UpdateIdNoCache(data.{{.IdField}}, data)
 	{{- end}}
 }
 
 func (m *default{{.Type}}Model) Delete(ctx context.Context, id string) error {
-    session, err := m.TakeSession()
+    {{if and .Cache .Uniques}}data, err := m.FindOne(ctx, id)
+    if err != nil {
+        return err
+    }
+
+    {{end}}session, err := m.TakeSession()
     if err != nil {
         return err
     }
 
     defer m.PutSession(session)
-    {{if .Cache}}key := prefix{{.Type}}CacheKey + id
+    {{if and .Cache .Uniques}}keys := []string{
+        prefix{{.Type}}CacheKey + id,
+        {{range .Uniques}}fmt.Sprintf("%s%v", prefix{{$.Type}}{{.Name}}CacheKey, data.{{.Name}}),
+        {{end}}
+    }
+    return m.GetCollection(session).RemoveId(bson.ObjectIdHex(id), keys...)
+    {{- else if .Cache}}key := prefix{{.Type}}CacheKey + id
     return m.GetCollection(session).RemoveId(bson.ObjectIdHex(id), key)
 	{{- else}}
 	return m.GetCollection(session).RemoveIdNoCache(bson.ObjectIdHex(id))
 	{{- end}}
 }
-`
+{{if .HasIndex}}
+// EnsureIndexes creates the indexes of the unique and index fields in the background
+func (m *default{{.Type}}Model) EnsureIndexes(ctx context.Context) error {
+    session, err := m.TakeSession()
+    if err != nil {
+        return err
+    }
+
+    defer m.PutSession(session)
+    collection := session.DB("").C(m.collection)
+    for _, index := range []mgo.Index{
+        {{range .Uniques}}{Key: []string{"{{.Key}}"}, Unique: true, Background: true},
+        {{end}}{{range .Indexes}}{Key: []string{"{{.Key}}"}, Background: true},
+        {{end}}
+    } {
+        if err := collection.EnsureIndex(index); err != nil {
+            return err
+        }
+    }
+
+    return nil
+}
+{{end}}`
 
 // Error provides the default template for error definition in mongo code generation.
 var Error = `
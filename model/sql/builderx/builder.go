@@ -80,8 +80,9 @@ func FieldNames(in interface{}) []string {
 	return out
 }
 
-// RawFieldNames converts golang struct field into slice string
-func RawFieldNames(in interface{}) []string {
+// RawFieldNames converts golang struct field into slice string,
+// the field names are quoted with double quotes instead of backquotes if postgreSql is true
+func RawFieldNames(in interface{}, postgreSql ...bool) []string {
 	out := make([]string, 0)
 	v := reflect.ValueOf(in)
 	if v.Kind() == reflect.Ptr {
@@ -93,12 +94,21 @@ func RawFieldNames(in interface{}) []string {
 		panic(fmt.Errorf("ToMap only accepts structs; got %T", v))
 	}
 
+	var pg bool
+	if len(postgreSql) > 0 {
+		pg = postgreSql[0]
+	}
+
 	typ := v.Type()
 	for i := 0; i < v.NumField(); i++ {
 		// gets us a StructField
 		fi := typ.Field(i)
 		if tagv := fi.Tag.Get(dbTag); tagv != "" {
-			out = append(out, fmt.Sprintf("`%s`", tagv))
+			if pg {
+				out = append(out, fmt.Sprintf(`"%s"`, tagv))
+			} else {
+				out = append(out, fmt.Sprintf("`%s`", tagv))
+			}
 		} else {
 			out = append(out, fmt.Sprintf(`"%s"`, fi.Name))
 		}
@@ -38,6 +38,13 @@ func TestFieldNames(t *testing.T) {
 		expected := []string{"`id`", "`user_name`", "`sex`", "`uuid`", "`age`"}
 		assert.Equal(t, expected, out)
 	})
+
+	t.Run("postgresql", func(t *testing.T) {
+		var u mockedUser
+		out := RawFieldNames(&u, true)
+		expected := []string{`"id"`, `"user_name"`, `"sex"`, `"uuid"`, `"age"`}
+		assert.Equal(t, expected, out)
+	})
 }
 
 func TestNewEq(t *testing.T) {
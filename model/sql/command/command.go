@@ -3,11 +3,13 @@ package command
 import (
 	"errors"
 	"io/ioutil"
+	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/tal-tech/go-zero/core/logx"
+	"github.com/tal-tech/go-zero/core/stores/postgres"
 	"github.com/tal-tech/go-zero/core/stores/sqlx"
 	"github.com/urfave/cli"
 	"github.com/zeromicro/goctl/config"
@@ -18,13 +20,20 @@ import (
 )
 
 const (
-	flagSrc   = "src"
-	flagDir   = "dir"
-	flagCache = "cache"
-	flagIdea  = "idea"
-	flagURL   = "url"
-	flagTable = "table"
-	flagStyle = "style"
+	flagSrc    = "src"
+	flagDir    = "dir"
+	flagCache  = "cache"
+	flagIdea   = "idea"
+	flagURL    = "url"
+	flagTable  = "table"
+	flagStyle  = "style"
+	flagSchema = "schema"
+	// flagSoftDelete and flagVersion name the columns, they're detected if empty or disabled if "none"
+	flagSoftDelete = "softDelete"
+	flagVersion    = "version"
+	flagCtx        = "ctx"
+
+	defaultPostgreSqlSchema = "public"
 )
 
 var errNotMatched = errors.New("sql not matched")
@@ -41,7 +50,37 @@ func MysqlDDL(ctx *cli.Context) error {
 		return err
 	}
 
-	return fromDDl(src, dir, cfg, cache, idea)
+	return fromDDl(src, dir, cfg, cache, idea, genOptions(ctx)...)
+}
+
+// PostgreSqlDDL generates postgresql model code from ddl
+func PostgreSqlDDL(ctx *cli.Context) error {
+	src := ctx.String(flagSrc)
+	dir := ctx.String(flagDir)
+	cache := ctx.Bool(flagCache)
+	idea := ctx.Bool(flagIdea)
+	style := ctx.String(flagStyle)
+	cfg, err := config.NewConfig(style)
+	if err != nil {
+		return err
+	}
+
+	return fromDDl(src, dir, cfg, cache, idea, append(genOptions(ctx), gen.WithPostgreSql())...)
+}
+
+// SqliteDDL generates sqlite model code from ddl
+func SqliteDDL(ctx *cli.Context) error {
+	src := ctx.String(flagSrc)
+	dir := ctx.String(flagDir)
+	cache := ctx.Bool(flagCache)
+	idea := ctx.Bool(flagIdea)
+	style := ctx.String(flagStyle)
+	cfg, err := config.NewConfig(style)
+	if err != nil {
+		return err
+	}
+
+	return fromDDl(src, dir, cfg, cache, idea, append(genOptions(ctx), gen.WithSqlite())...)
 }
 
 // MyDataSource generates model code from datasource
@@ -57,10 +96,60 @@ func MyDataSource(ctx *cli.Context) error {
 		return err
 	}
 
-	return fromDataSource(url, pattern, dir, cfg, cache, idea)
+	return fromDataSource(url, pattern, dir, cfg, cache, idea, genOptions(ctx)...)
+}
+
+// PostgreSqlDataSource generates postgresql model code from datasource
+func PostgreSqlDataSource(ctx *cli.Context) error {
+	url := strings.TrimSpace(ctx.String(flagURL))
+	dir := strings.TrimSpace(ctx.String(flagDir))
+	cache := ctx.Bool(flagCache)
+	idea := ctx.Bool(flagIdea)
+	style := ctx.String(flagStyle)
+	schema := strings.TrimSpace(ctx.String(flagSchema))
+	if len(schema) == 0 {
+		schema = defaultPostgreSqlSchema
+	}
+
+	pattern := strings.TrimSpace(ctx.String(flagTable))
+	cfg, err := config.NewConfig(style)
+	if err != nil {
+		return err
+	}
+
+	return fromPostgreSqlDataSource(url, pattern, schema, dir, cfg, cache, idea, genOptions(ctx)...)
+}
+
+// SqliteDataSource generates sqlite model code from the database file
+func SqliteDataSource(ctx *cli.Context) error {
+	url := strings.TrimSpace(ctx.String(flagURL))
+	dir := strings.TrimSpace(ctx.String(flagDir))
+	cache := ctx.Bool(flagCache)
+	idea := ctx.Bool(flagIdea)
+	style := ctx.String(flagStyle)
+	pattern := strings.TrimSpace(ctx.String(flagTable))
+	cfg, err := config.NewConfig(style)
+	if err != nil {
+		return err
+	}
+
+	return fromSqliteDataSource(url, pattern, dir, cfg, cache, idea, genOptions(ctx)...)
+}
+
+// genOptions returns the options of the soft delete and version columns and the context methods
+func genOptions(ctx *cli.Context) []gen.Option {
+	opts := []gen.Option{
+		gen.WithSoftDeleteColumn(strings.TrimSpace(ctx.String(flagSoftDelete))),
+		gen.WithVersionColumn(strings.TrimSpace(ctx.String(flagVersion))),
+	}
+	if ctx.Bool(flagCtx) {
+		opts = append(opts, gen.WithContext())
+	}
+
+	return opts
 }
 
-func fromDDl(src, dir string, cfg *config.Config, cache, idea bool) error {
+func fromDDl(src, dir string, cfg *config.Config, cache, idea bool, opts ...gen.Option) error {
 	log := console.NewConsole(idea)
 	src = strings.TrimSpace(src)
 	if len(src) == 0 {
@@ -86,7 +175,8 @@ func fromDDl(src, dir string, cfg *config.Config, cache, idea bool) error {
 		source = append(source, string(data))
 	}
 
-	generator, err := gen.NewDefaultGenerator(dir, cfg, gen.WithConsoleOption(log))
+	opts = append([]gen.Option{gen.WithConsoleOption(log)}, opts...)
+	generator, err := gen.NewDefaultGenerator(dir, cfg, opts...)
 	if err != nil {
 		return err
 	}
@@ -94,7 +184,7 @@ func fromDDl(src, dir string, cfg *config.Config, cache, idea bool) error {
 	return generator.StartFromDDL(strings.Join(source, "\n"), cache)
 }
 
-func fromDataSource(url, pattern, dir string, cfg *config.Config, cache, idea bool) error {
+func fromDataSource(url, pattern, dir string, cfg *config.Config, cache, idea bool, opts ...gen.Option) error {
 	log := console.NewConsole(idea)
 	if len(url) == 0 {
 		log.Error("%v", "expected data source of mysql, but nothing found")
@@ -149,7 +239,133 @@ func fromDataSource(url, pattern, dir string, cfg *config.Config, cache, idea bo
 		return errors.New("no tables matched")
 	}
 
-	generator, err := gen.NewDefaultGenerator(dir, cfg, gen.WithConsoleOption(log))
+	opts = append([]gen.Option{gen.WithConsoleOption(log)}, opts...)
+	generator, err := gen.NewDefaultGenerator(dir, cfg, opts...)
+	if err != nil {
+		return err
+	}
+
+	return generator.StartFromInformationSchema(matchTables, cache)
+}
+
+func fromPostgreSqlDataSource(url, pattern, schema, dir string, cfg *config.Config, cache, idea bool,
+	opts ...gen.Option) error {
+	log := console.NewConsole(idea)
+	if len(url) == 0 {
+		log.Error("%v", "expected data source of postgresql, but nothing found")
+		return nil
+	}
+
+	if len(pattern) == 0 {
+		log.Error("%v", "expected table or table globbing patterns, but nothing found")
+		return nil
+	}
+
+	logx.Disable()
+	db := postgres.New(url)
+	im := model.NewPostgreSqlModel(db)
+
+	tables, err := im.GetAllTables(schema)
+	if err != nil {
+		return err
+	}
+
+	matchTables := make(map[string]*model.Table)
+	for _, item := range tables {
+		match, err := filepath.Match(pattern, item)
+		if err != nil {
+			return err
+		}
+
+		if !match {
+			continue
+		}
+
+		columnData, err := im.FindColumns(schema, item)
+		if err != nil {
+			return err
+		}
+
+		table, err := columnData.Convert()
+		if err != nil {
+			return err
+		}
+
+		matchTables[item] = table
+	}
+
+	if len(matchTables) == 0 {
+		return errors.New("no tables matched")
+	}
+
+	opts = append([]gen.Option{gen.WithConsoleOption(log), gen.WithPostgreSql()}, opts...)
+	generator, err := gen.NewDefaultGenerator(dir, cfg, opts...)
+	if err != nil {
+		return err
+	}
+
+	return generator.StartFromInformationSchema(matchTables, cache)
+}
+
+func fromSqliteDataSource(url, pattern, dir string, cfg *config.Config, cache, idea bool, opts ...gen.Option) error {
+	log := console.NewConsole(idea)
+	if len(url) == 0 {
+		log.Error("%v", "expected database file of sqlite, but nothing found")
+		return nil
+	}
+
+	if len(pattern) == 0 {
+		log.Error("%v", "expected table or table globbing patterns, but nothing found")
+		return nil
+	}
+
+	// sqlite creates the database file if it is not exists
+	if _, err := os.Stat(url); err != nil {
+		return err
+	}
+
+	logx.Disable()
+	im, err := model.OpenSqlite(url)
+	if err != nil {
+		return err
+	}
+	defer im.Close()
+
+	tables, err := im.GetAllTables()
+	if err != nil {
+		return err
+	}
+
+	matchTables := make(map[string]*model.Table)
+	for _, item := range tables {
+		match, err := filepath.Match(pattern, item)
+		if err != nil {
+			return err
+		}
+
+		if !match {
+			continue
+		}
+
+		columnData, err := im.FindColumns(model.SqliteMainSchema, item)
+		if err != nil {
+			return err
+		}
+
+		table, err := columnData.Convert()
+		if err != nil {
+			return err
+		}
+
+		matchTables[item] = table
+	}
+
+	if len(matchTables) == 0 {
+		return errors.New("no tables matched")
+	}
+
+	opts = append([]gen.Option{gen.WithConsoleOption(log), gen.WithSqlite()}, opts...)
+	generator, err := gen.NewDefaultGenerator(dir, cfg, opts...)
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package command
 
 import (
+	dbsql "database/sql"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -8,12 +9,14 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/zeromicro/goctl/config"
+	"github.com/zeromicro/goctl/model/sql/gen"
 	"github.com/zeromicro/goctl/util"
 )
 
 var (
-	sql = "-- 用户表 --\nCREATE TABLE `user` (\n  `id` bigint(10) NOT NULL AUTO_INCREMENT,\n  `name` varchar(255) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '用户名称',\n  `password` varchar(255) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '用户密码',\n  `mobile` varchar(255) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '手机号',\n  `gender` char(5) COLLATE utf8mb4_general_ci NOT NULL COMMENT '男｜女｜未公开',\n  `nickname` varchar(255) COLLATE utf8mb4_general_ci DEFAULT '' COMMENT '用户昵称',\n  `create_time` timestamp NULL DEFAULT CURRENT_TIMESTAMP,\n  `update_time` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,\n  PRIMARY KEY (`id`),\n  UNIQUE KEY `name_index` (`name`),\n  UNIQUE KEY `mobile_index` (`mobile`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;\n\n"
-	cfg = &config.Config{
+	sql       = "-- 用户表 --\nCREATE TABLE `user` (\n  `id` bigint(10) NOT NULL AUTO_INCREMENT,\n  `name` varchar(255) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '用户名称',\n  `password` varchar(255) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '用户密码',\n  `mobile` varchar(255) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '手机号',\n  `gender` char(5) COLLATE utf8mb4_general_ci NOT NULL COMMENT '男｜女｜未公开',\n  `nickname` varchar(255) COLLATE utf8mb4_general_ci DEFAULT '' COMMENT '用户昵称',\n  `create_time` timestamp NULL DEFAULT CURRENT_TIMESTAMP,\n  `update_time` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,\n  PRIMARY KEY (`id`),\n  UNIQUE KEY `name_index` (`name`),\n  UNIQUE KEY `mobile_index` (`mobile`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;\n\n"
+	sqliteSql = "CREATE TABLE user (\n  id integer PRIMARY KEY,\n  name varchar(255) NOT NULL DEFAULT '',\n  mobile varchar(255) NOT NULL UNIQUE,\n  nickname text,\n  create_time datetime NOT NULL DEFAULT CURRENT_TIMESTAMP\n);\n"
+	cfg       = &config.Config{
 		NamingFormat: "gozero",
 	}
 )
@@ -71,3 +74,61 @@ func TestFromDDl(t *testing.T) {
 	_, err = os.Stat(filepath.Join(tempDir, "usermodel.go"))
 	assert.Nil(t, err)
 }
+
+func TestFromPostgreSqlDDl(t *testing.T) {
+	pgSql := "CREATE TABLE public.user (\n  id bigserial PRIMARY KEY,\n  name varchar(255) NOT NULL DEFAULT '',\n  mobile varchar(255) NOT NULL UNIQUE,\n  nickname text,\n  create_time timestamptz NOT NULL DEFAULT now()\n);\nCOMMENT ON COLUMN public.user.name IS '用户名称';\n"
+	tempDir := filepath.Join(t.TempDir(), "pg")
+	err := util.MkdirIfNotExist(tempDir)
+	assert.Nil(t, err)
+
+	userSql := filepath.Join(tempDir, "user.sql")
+	err = ioutil.WriteFile(userSql, []byte(pgSql), os.ModePerm)
+	assert.Nil(t, err)
+
+	err = fromDDl(userSql, tempDir, cfg, true, false, gen.WithPostgreSql())
+	assert.Nil(t, err)
+
+	_, err = os.Stat(filepath.Join(tempDir, "usermodel.go"))
+	assert.Nil(t, err)
+}
+
+func TestFromSqliteDDl(t *testing.T) {
+	tempDir := filepath.Join(t.TempDir(), "sqlite")
+	err := util.MkdirIfNotExist(tempDir)
+	assert.Nil(t, err)
+
+	userSql := filepath.Join(tempDir, "user.sql")
+	err = ioutil.WriteFile(userSql, []byte(sqliteSql), os.ModePerm)
+	assert.Nil(t, err)
+
+	err = fromDDl(userSql, tempDir, cfg, true, false, gen.WithSqlite())
+	assert.Nil(t, err)
+
+	_, err = os.Stat(filepath.Join(tempDir, "usermodel.go"))
+	assert.Nil(t, err)
+}
+
+func TestFromSqliteDataSource(t *testing.T) {
+	tempDir := filepath.Join(t.TempDir(), "sqlite")
+	err := util.MkdirIfNotExist(tempDir)
+	assert.Nil(t, err)
+
+	err = fromSqliteDataSource(filepath.Join(tempDir, "notexists.db"), "*", tempDir, cfg, false, false)
+	assert.True(t, os.IsNotExist(err))
+
+	dbFile := filepath.Join(tempDir, "user.db")
+	db, err := dbsql.Open("sqlite3", dbFile)
+	assert.Nil(t, err)
+	_, err = db.Exec(sqliteSql)
+	assert.Nil(t, err)
+	assert.Nil(t, db.Close())
+
+	err = fromSqliteDataSource(dbFile, "student", tempDir, cfg, false, false)
+	assert.Equal(t, "no tables matched", err.Error())
+
+	err = fromSqliteDataSource(dbFile, "us*", tempDir, cfg, false, false)
+	assert.Nil(t, err)
+
+	_, err = os.Stat(filepath.Join(tempDir, "usermodel.go"))
+	assert.Nil(t, err)
+}
@@ -2,6 +2,7 @@ package converter
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 )
 
@@ -39,6 +40,87 @@ var commonMysqlDataTypeMap = map[string]string{
 	"json":       "string",
 }
 
+var commonPostgreSqlDataTypeMap = map[string]string{
+	// For consistency, all integer types are converted to int64
+	// bool
+	"bool":    "bool",
+	"boolean": "bool",
+	// number
+	"smallint":         "int64",
+	"int2":             "int64",
+	"integer":          "int64",
+	"int":              "int64",
+	"int4":             "int64",
+	"bigint":           "int64",
+	"int8":             "int64",
+	"smallserial":      "int64",
+	"serial2":          "int64",
+	"serial":           "int64",
+	"serial4":          "int64",
+	"bigserial":        "int64",
+	"serial8":          "int64",
+	"real":             "float64",
+	"float4":           "float64",
+	"double precision": "float64",
+	"float8":           "float64",
+	"numeric":          "float64",
+	"decimal":          "float64",
+	"money":            "float64",
+	// date&time
+	"date":                        "time.Time",
+	"timestamp":                   "time.Time",
+	"timestamp without time zone": "time.Time",
+	"timestamptz":                 "time.Time",
+	"timestamp with time zone":    "time.Time",
+	"time":                        "string",
+	"time without time zone":      "string",
+	"timetz":                      "string",
+	"time with time zone":         "string",
+	"interval":                    "string",
+	// string
+	"char":              "string",
+	"character":         "string",
+	"bpchar":            "string",
+	"varchar":           "string",
+	"character varying": "string",
+	"text":              "string",
+	"citext":            "string",
+	"bytea":             "string",
+	"bit":               "string",
+	"bit varying":       "string",
+	"varbit":            "string",
+	"uuid":              "string",
+	"json":              "string",
+	"jsonb":             "string",
+	"xml":               "string",
+	"inet":              "string",
+	"cidr":              "string",
+	"macaddr":           "string",
+	"tsvector":          "string",
+}
+
+// the sqlite types with numeric affinity, the declared date and time types are scanned into time.Time by the driver
+var commonSqliteDataTypeMap = map[string]string{
+	"bool":      "bool",
+	"boolean":   "bool",
+	"numeric":   "float64",
+	"decimal":   "float64",
+	"date":      "time.Time",
+	"datetime":  "time.Time",
+	"timestamp": "time.Time",
+	"time":      "string",
+	"json":      "string",
+}
+
+var postgreSqlArrayTypeMap = map[string]string{
+	"bool":    "pq.BoolArray",
+	"int64":   "pq.Int64Array",
+	"float64": "pq.Float64Array",
+	"string":  "pq.StringArray",
+}
+
+var typeModifier = regexp.MustCompile(`\([^)]*\)`)
+
 // ConvertDataType converts mysql column type into golang type
 func ConvertDataType(dataBaseType string, isDefaultNull bool) (string, error) {
 	tp, ok := commonMysqlDataTypeMap[strings.ToLower(dataBaseType)]
@@ -49,6 +131,63 @@ func ConvertDataType(dataBaseType string, isDefaultNull bool) (string, error) {
 	return mayConvertNullType(tp, isDefaultNull), nil
 }
 
+// ConvertPostgreSqlDataType converts postgresql column type into golang type,
+// both the ddl form (integer[], character varying(255)) and the udt form (_int4, varchar) are accepted
+func ConvertPostgreSqlDataType(dataBaseType string, isDefaultNull bool) (string, error) {
+	tp := strings.ToLower(typeModifier.ReplaceAllString(dataBaseType, ""))
+	tp = strings.Join(strings.Fields(tp), " ")
+	var isArray bool
+	if strings.HasSuffix(tp, "[]") {
+		isArray = true
+		tp = strings.TrimSpace(strings.TrimRight(tp, "[] "))
+	} else if strings.HasPrefix(tp, "_") {
+		isArray = true
+		tp = strings.TrimPrefix(tp, "_")
+	}
+
+	goDataType, ok := commonPostgreSqlDataTypeMap[tp]
+	if !ok {
+		return "", fmt.Errorf("unexpected database type: %s", dataBaseType)
+	}
+
+	if isArray {
+		arrayType, ok := postgreSqlArrayTypeMap[goDataType]
+		if !ok {
+			return "", fmt.Errorf("unexpected array type: %s", dataBaseType)
+		}
+
+		// arrays are scanned into nil slices, no need to wrap them with sql.NullXxx
+		return arrayType, nil
+	}
+
+	return mayConvertNullType(goDataType, isDefaultNull), nil
+}
+
+// ConvertSqliteDataType converts sqlite column type into golang type by the rules of type affinity,
+// see https://www.sqlite.org/datatype3.html#determination_of_column_affinity
+func ConvertSqliteDataType(dataBaseType string, isDefaultNull bool) (string, error) {
+	tp := strings.ToLower(strings.TrimSpace(typeModifier.ReplaceAllString(dataBaseType, "")))
+	var goDataType string
+	switch {
+	case strings.Contains(tp, "int"):
+		goDataType = "int64"
+	case strings.Contains(tp, "char"), strings.Contains(tp, "clob"), strings.Contains(tp, "text"):
+		goDataType = "string"
+	case strings.Contains(tp, "blob"), len(tp) == 0:
+		goDataType = "string"
+	case strings.Contains(tp, "real"), strings.Contains(tp, "floa"), strings.Contains(tp, "doub"):
+		goDataType = "float64"
+	default:
+		var ok bool
+		goDataType, ok = commonSqliteDataTypeMap[tp]
+		if !ok {
+			return "", fmt.Errorf("unexpected database type: %s", dataBaseType)
+		}
+	}
+
+	return mayConvertNullType(goDataType, isDefaultNull), nil
+}
+
 func mayConvertNullType(goDataType string, isDefaultNull bool) string {
 	if !isDefaultNull {
 		return goDataType
@@ -26,3 +26,84 @@ func TestConvertDataType(t *testing.T) {
 	_, err = ConvertDataType("float32", false)
 	assert.NotNil(t, err)
 }
+
+func TestConvertPostgreSqlDataType(t *testing.T) {
+	v, err := ConvertPostgreSqlDataType("serial", false)
+	assert.Nil(t, err)
+	assert.Equal(t, "int64", v)
+
+	v, err = ConvertPostgreSqlDataType("boolean", true)
+	assert.Nil(t, err)
+	assert.Equal(t, "sql.NullBool", v)
+
+	v, err = ConvertPostgreSqlDataType("timestamp(6) with time zone", false)
+	assert.Nil(t, err)
+	assert.Equal(t, "time.Time", v)
+
+	v, err = ConvertPostgreSqlDataType("timestamptz", true)
+	assert.Nil(t, err)
+	assert.Equal(t, "sql.NullTime", v)
+
+	v, err = ConvertPostgreSqlDataType("character varying(255)", false)
+	assert.Nil(t, err)
+	assert.Equal(t, "string", v)
+
+	v, err = ConvertPostgreSqlDataType("uuid", false)
+	assert.Nil(t, err)
+	assert.Equal(t, "string", v)
+
+	v, err = ConvertPostgreSqlDataType("jsonb", true)
+	assert.Nil(t, err)
+	assert.Equal(t, "sql.NullString", v)
+
+	v, err = ConvertPostgreSqlDataType("varchar(20)[]", true)
+	assert.Nil(t, err)
+	assert.Equal(t, "pq.StringArray", v)
+
+	v, err = ConvertPostgreSqlDataType("_int4", false)
+	assert.Nil(t, err)
+	assert.Equal(t, "pq.Int64Array", v)
+
+	_, err = ConvertPostgreSqlDataType("_timestamptz", false)
+	assert.NotNil(t, err)
+
+	_, err = ConvertPostgreSqlDataType("point", false)
+	assert.NotNil(t, err)
+}
+
+func TestConvertSqliteDataType(t *testing.T) {
+	v, err := ConvertSqliteDataType("INTEGER", false)
+	assert.Nil(t, err)
+	assert.Equal(t, "int64", v)
+
+	v, err = ConvertSqliteDataType("unsigned big int", true)
+	assert.Nil(t, err)
+	assert.Equal(t, "sql.NullInt64", v)
+
+	v, err = ConvertSqliteDataType("VARCHAR(255)", false)
+	assert.Nil(t, err)
+	assert.Equal(t, "string", v)
+
+	v, err = ConvertSqliteDataType("", false)
+	assert.Nil(t, err)
+	assert.Equal(t, "string", v)
+
+	v, err = ConvertSqliteDataType("double precision", true)
+	assert.Nil(t, err)
+	assert.Equal(t, "sql.NullFloat64", v)
+
+	v, err = ConvertSqliteDataType("DECIMAL(10,5)", false)
+	assert.Nil(t, err)
+	assert.Equal(t, "float64", v)
+
+	v, err = ConvertSqliteDataType("BOOLEAN", false)
+	assert.Nil(t, err)
+	assert.Equal(t, "bool", v)
+
+	v, err = ConvertSqliteDataType("datetime", true)
+	assert.Nil(t, err)
+	assert.Equal(t, "sql.NullTime", v)
+
+	_, err = ConvertSqliteDataType("uuid", false)
+	assert.NotNil(t, err)
+}
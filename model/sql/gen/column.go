@@ -0,0 +1,130 @@
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/goctl/model/sql/parser"
+)
+
+// DisableColumn disables the detection of the soft delete or version column
+const DisableColumn = "none"
+
+var (
+	softDeleteColumns = []string{"deleted_at", "delete_time", "deleted_time", "is_deleted", "is_del"}
+	versionColumns    = []string{"version"}
+)
+
+type (
+	// softDelete describes the column to mark the row deleted instead of deleting it
+	softDelete struct {
+		// Column is the quoted column name
+		Column string
+		// Condition filters out the deleted rows, such as `deleted_at` is null
+		Condition string
+		// Value is the go expression of the value to mark the row deleted
+		Value string
+		// Time is true if Value refers to the time package
+		Time bool
+	}
+
+	// lockVersion describes the column of the optimistic lock
+	lockVersion struct {
+		// Column is the quoted column name
+		Column string
+		// Camel is the field name in the struct
+		Camel string
+	}
+)
+
+// findSoftDelete returns the soft delete column of the table, nil if not found,
+// the column is specified by name or detected from the common names if name is empty
+func (g *defaultGenerator) findSoftDelete(table parser.Table) (*softDelete, error) {
+	field, explicit := g.findColumn(table, g.softDeleteColumn, softDeleteColumns)
+	if field == nil {
+		return nil, nil
+	}
+
+	column := wrapWithRawString(field.Name.Source(), g.isPostgreSql)
+	sd := &softDelete{Column: column}
+	switch field.DataType {
+	case "sql.NullTime":
+		sd.Condition = column + " is null"
+		sd.Value = "time.Now()"
+		sd.Time = true
+	case "int64":
+		sd.Condition = column + " = 0"
+		name := strings.ToLower(field.Name.Source())
+		if strings.HasSuffix(name, "_at") || strings.HasSuffix(name, "time") {
+			sd.Value = "time.Now().Unix()"
+			sd.Time = true
+		} else {
+			sd.Value = "1"
+		}
+	case "bool":
+		sd.Condition = column + " = false"
+		sd.Value = "true"
+	default:
+		return nil, g.unsupportedColumn(table, field, explicit,
+			"the soft delete column should be a nullable time, an integer or a bool")
+	}
+
+	return sd, nil
+}
+
+// findVersion returns the optimistic lock column of the table, nil if not found
+func (g *defaultGenerator) findVersion(table parser.Table) (*lockVersion, error) {
+	field, explicit := g.findColumn(table, g.versionColumn, versionColumns)
+	if field == nil {
+		return nil, nil
+	}
+
+	if field.DataType != "int64" {
+		return nil, g.unsupportedColumn(table, field, explicit, "the version column should be a not null integer")
+	}
+
+	return &lockVersion{
+		Column: wrapWithRawString(field.Name.Source(), g.isPostgreSql),
+		Camel:  field.Name.ToCamel(),
+	}, nil
+}
+
+// findColumn finds the column named name, or the first one of candidates if name is empty,
+// explicit is true if the column is specified by name
+func (g *defaultGenerator) findColumn(table parser.Table, name string, candidates []string) (
+	field *parser.Field, explicit bool) {
+	if name == DisableColumn {
+		return nil, false
+	}
+
+	if len(name) > 0 {
+		candidates = []string{name}
+		explicit = true
+	}
+
+	for _, candidate := range candidates {
+		for _, item := range table.Fields {
+			if !strings.EqualFold(item.Name.Source(), candidate) {
+				continue
+			}
+
+			if item.Name.Source() == table.PrimaryKey.Name.Source() {
+				continue
+			}
+
+			return item, explicit
+		}
+	}
+
+	return nil, false
+}
+
+// unsupportedColumn returns an error if the column is specified explicitly, or ignores the detected column
+func (g *defaultGenerator) unsupportedColumn(table parser.Table, field *parser.Field, explicit bool, msg string) error {
+	if explicit {
+		return fmt.Errorf("table %s: column %s is %s, %s", table.Name.Source(), field.Name.Source(), field.DataType, msg)
+	}
+
+	g.Warning("table %s: column %s is %s, ignored, %s", table.Name.Source(), field.Name.Source(), field.DataType, msg)
+	return nil
+}
@@ -6,10 +6,9 @@ import (
 	"github.com/tal-tech/go-zero/core/collection"
 	"github.com/zeromicro/goctl/model/sql/template"
 	"github.com/zeromicro/goctl/util"
-	"github.com/zeromicro/goctl/util/stringx"
 )
 
-func genDelete(table Table, withCache bool) (string, string, error) {
+func genDelete(table Table, withCache, postgreSql, withCtx bool) (string, string, error) {
 	keySet := collection.NewSet()
 	keyVariableSet := collection.NewSet()
 	keySet.AddStr(table.PrimaryCacheKey.KeyExpression)
@@ -19,6 +18,12 @@ func genDelete(table Table, withCache bool) (string, string, error) {
 		keyVariableSet.AddStr(key.KeyLeft)
 	}
 
+	var softDeleteColumn, softDeleteValue string
+	if table.SoftDelete != nil {
+		softDeleteColumn = table.SoftDelete.Column
+		softDeleteValue = table.SoftDelete.Value
+	}
+
 	camel := table.Name.ToCamel()
 	text, err := util.LoadTemplate(category, deleteTemplateFile, template.Delete)
 	if err != nil {
@@ -28,14 +33,18 @@ func genDelete(table Table, withCache bool) (string, string, error) {
 	output, err := util.With("delete").
 		Parse(text).
 		Execute(map[string]interface{}{
+			"withCtx":                   withCtx,
 			"upperStartCamelObject":     camel,
 			"withCache":                 withCache,
 			"containsIndexCache":        table.ContainsUniqueCacheKey,
-			"lowerStartCamelPrimaryKey": stringx.From(table.PrimaryKey.Name.ToCamel()).Untitle(),
+			"lowerStartCamelPrimaryKey": argName(table.PrimaryKey.Name),
 			"dataType":                  table.PrimaryKey.DataType,
 			"keys":                      strings.Join(keySet.KeysStr(), "\n"),
-			"originalPrimaryKey":        wrapWithRawString(table.PrimaryKey.Name.Source()),
+			"originalPrimaryKey":        wrapWithRawString(table.PrimaryKey.Name.Source(), postgreSql),
 			"keyValues":                 strings.Join(keyVariableSet.KeysStr(), ", "),
+			"softDeleteColumn":          softDeleteColumn,
+			"softDeleteValue":           softDeleteValue,
+			"softDelete":                table.notDeleted(),
 		})
 	if err != nil {
 		return "", "", err
@@ -50,7 +59,8 @@ func genDelete(table Table, withCache bool) (string, string, error) {
 	deleteMethodOut, err := util.With("deleteMethod").
 		Parse(text).
 		Execute(map[string]interface{}{
-			"lowerStartCamelPrimaryKey": stringx.From(table.PrimaryKey.Name.ToCamel()).Untitle(),
+			"withCtx":                   withCtx,
+			"lowerStartCamelPrimaryKey": argName(table.PrimaryKey.Name),
 			"dataType":                  table.PrimaryKey.DataType,
 		})
 	if err != nil {
@@ -1,11 +1,13 @@
 package gen
 
 import (
+	"go/token"
 	"strings"
 
 	"github.com/zeromicro/goctl/model/sql/parser"
 	"github.com/zeromicro/goctl/model/sql/template"
 	"github.com/zeromicro/goctl/util"
+	"github.com/zeromicro/goctl/util/stringx"
 )
 
 func genFields(fields []*parser.Field) (string, error) {
@@ -49,3 +51,18 @@ func genField(field *parser.Field) (string, error) {
 
 	return output.String(), nil
 }
+
+// argName returns the parameter name of the field, the go keywords and the names
+// used by the generated methods are suffixed to keep the code compilable
+func argName(name stringx.String) string {
+	arg := stringx.From(name.ToCamel()).Untitle()
+	switch {
+	case token.Lookup(arg).IsKeyword():
+		return arg + "Value"
+	case arg == "ctx", arg == "conn", arg == "data", arg == "err", arg == "m", arg == "v",
+		arg == "query", arg == "resp", arg == "offset", arg == "limit":
+		return arg + "Value"
+	}
+
+	return arg
+}
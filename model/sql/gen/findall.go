@@ -0,0 +1,182 @@
+package gen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/zeromicro/goctl/model/sql/parser"
+	"github.com/zeromicro/goctl/model/sql/template"
+	"github.com/zeromicro/goctl/util"
+	"github.com/zeromicro/goctl/util/stringx"
+)
+
+type findAllCode struct {
+	findAllMethod          string
+	findAllInterfaceMethod string
+}
+
+// genFindAllByField generates the paginated queries by the fields of the normal indexes
+func genFindAllByField(table Table, withCache, postgreSql, withCtx bool) (*findAllCode, error) {
+	text, err := util.LoadTemplate(category, findAllByFieldTemplateFile, template.FindAllByField)
+	if err != nil {
+		return nil, err
+	}
+
+	methodText, err := util.LoadTemplate(category, findAllByFieldMethodTemplateFile, template.FindAllByFieldMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	t := util.With("findAllByField").Parse(text)
+	tm := util.With("findAllByFieldMethod").Parse(methodText)
+	camelTableName := table.Name.ToCamel()
+	primaryKey := table.PrimaryKey.Name.ToCamel()
+	var list, listMethod []string
+	for _, fields := range normalIndexes(table) {
+		var inJoin, paramJoin, argJoin, nameJoin Join
+		for _, f := range fields {
+			param := argName(f.Name)
+			inJoin = append(inJoin, fmt.Sprintf("%s %s", param, f.DataType))
+			paramJoin = append(paramJoin, param)
+			nameJoin = append(nameJoin, f.Name.Source())
+			argJoin = append(argJoin, fmt.Sprintf("%s = ?", wrapWithRawString(f.Name.Source(), postgreSql)))
+		}
+
+		if sd := table.notDeleted(); len(sd) > 0 {
+			argJoin = append(argJoin, sd)
+		}
+
+		data := map[string]interface{}{
+			"upperStartCamelObject": camelTableName,
+			"lowerStartCamelObject": stringx.From(camelTableName).Untitle(),
+			"upperField":            nameJoin.Camel().With("").Source(),
+			"in":                    inJoin.With(", ").Source(),
+			"lowerStartCamelField":  paramJoin.With(", ").Source(),
+			"originalField":         argJoin.With(" and ").Source(),
+			"originalPrimaryKey":    wrapWithRawString(table.PrimaryKey.Name.Source(), postgreSql),
+			"lastPrimaryKey":        "last" + primaryKey,
+			"dataType":              table.PrimaryKey.DataType,
+			"withCache":             withCache,
+			"withCtx":               withCtx,
+		}
+
+		output, err := t.Execute(data)
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, output.String())
+		output, err = tm.Execute(data)
+		if err != nil {
+			return nil, err
+		}
+
+		listMethod = append(listMethod, output.String())
+	}
+
+	return &findAllCode{
+		findAllMethod:          strings.Join(list, util.NL),
+		findAllInterfaceMethod: strings.Join(listMethod, util.NL),
+	}, nil
+}
+
+// normalIndexes returns the fields of the normal indexes which are not the prefix of the primary key,
+// the indexes with the same fields name are generated once
+func normalIndexes(table Table) [][]*parser.Field {
+	set := make(map[string][]*parser.Field)
+	for _, fields := range table.NormalIndex {
+		if len(fields) == 0 || fields[0].Name.Source() == table.PrimaryKey.Name.Source() {
+			continue
+		}
+
+		var names Join
+		for _, f := range fields {
+			names = append(names, f.Name.Source())
+		}
+		set[names.Camel().With("").Source()] = fields
+	}
+
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	indexes := make([][]*parser.Field, 0, len(keys))
+	for _, key := range keys {
+		indexes = append(indexes, set[key])
+	}
+
+	return indexes
+}
+
+func genFindByIds(table Table, withCache, postgreSql, withCtx bool) (string, string, error) {
+	camel := table.Name.ToCamel()
+	text, err := util.LoadTemplate(category, findByIdsTemplateFile, template.FindByIds)
+	if err != nil {
+		return "", "", err
+	}
+
+	output, err := util.With("findByIds").Parse(text).Execute(map[string]interface{}{
+		"withCtx":                   withCtx,
+		"withCache":                 withCache,
+		"upperStartCamelObject":     camel,
+		"lowerStartCamelObject":     stringx.From(camel).Untitle(),
+		"upperStartCamelPrimaryKey": table.PrimaryKey.Name.ToCamel(),
+		"originalPrimaryKey":        wrapWithRawString(table.PrimaryKey.Name.Source(), postgreSql),
+		"primaryKeyLeft":            table.PrimaryCacheKey.VarLeft,
+		"dataType":                  table.PrimaryKey.DataType,
+		"softDelete":                table.notDeleted(),
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	text, err = util.LoadTemplate(category, findByIdsMethodTemplateFile, template.FindByIdsMethod)
+	if err != nil {
+		return "", "", err
+	}
+
+	method, err := util.With("findByIdsMethod").Parse(text).Execute(map[string]interface{}{
+		"withCtx":               withCtx,
+		"upperStartCamelObject": camel,
+		"dataType":              table.PrimaryKey.DataType,
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	return output.String(), method.String(), nil
+}
+
+func genCount(table Table, withCache, withCtx bool) (string, string, error) {
+	text, err := util.LoadTemplate(category, countTemplateFile, template.Count)
+	if err != nil {
+		return "", "", err
+	}
+
+	output, err := util.With("count").Parse(text).Execute(map[string]interface{}{
+		"withCtx":               withCtx,
+		"withCache":             withCache,
+		"upperStartCamelObject": table.Name.ToCamel(),
+		"softDelete":            table.notDeleted(),
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	text, err = util.LoadTemplate(category, countMethodTemplateFile, template.CountMethod)
+	if err != nil {
+		return "", "", err
+	}
+
+	method, err := util.With("countMethod").Parse(text).Execute(map[string]interface{}{
+		"withCtx": withCtx,
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	return output.String(), method.String(), nil
+}
@@ -6,7 +6,7 @@ import (
 	"github.com/zeromicro/goctl/util/stringx"
 )
 
-func genFindOne(table Table, withCache bool) (string, string, error) {
+func genFindOne(table Table, withCache, postgreSql, withCtx bool) (string, string, error) {
 	camel := table.Name.ToCamel()
 	text, err := util.LoadTemplate(category, findOneTemplateFile, template.FindOne)
 	if err != nil {
@@ -16,14 +16,16 @@ func genFindOne(table Table, withCache bool) (string, string, error) {
 	output, err := util.With("findOne").
 		Parse(text).
 		Execute(map[string]interface{}{
+			"withCtx":                   withCtx,
 			"withCache":                 withCache,
 			"upperStartCamelObject":     camel,
 			"lowerStartCamelObject":     stringx.From(camel).Untitle(),
-			"originalPrimaryKey":        wrapWithRawString(table.PrimaryKey.Name.Source()),
-			"lowerStartCamelPrimaryKey": stringx.From(table.PrimaryKey.Name.ToCamel()).Untitle(),
+			"originalPrimaryKey":        wrapWithRawString(table.PrimaryKey.Name.Source(), postgreSql),
+			"lowerStartCamelPrimaryKey": argName(table.PrimaryKey.Name),
 			"dataType":                  table.PrimaryKey.DataType,
 			"cacheKey":                  table.PrimaryCacheKey.KeyExpression,
 			"cacheKeyVariable":          table.PrimaryCacheKey.KeyLeft,
+			"softDelete":                table.notDeleted(),
 		})
 	if err != nil {
 		return "", "", err
@@ -37,8 +39,9 @@ func genFindOne(table Table, withCache bool) (string, string, error) {
 	findOneMethod, err := util.With("findOneMethod").
 		Parse(text).
 		Execute(map[string]interface{}{
+			"withCtx":                   withCtx,
 			"upperStartCamelObject":     camel,
-			"lowerStartCamelPrimaryKey": stringx.From(table.PrimaryKey.Name.ToCamel()).Untitle(),
+			"lowerStartCamelPrimaryKey": argName(table.PrimaryKey.Name),
 			"dataType":                  table.PrimaryKey.DataType,
 		})
 	if err != nil {
@@ -15,7 +15,7 @@ type findOneCode struct {
 	cacheExtra             string
 }
 
-func genFindOneByField(table Table, withCache bool) (*findOneCode, error) {
+func genFindOneByField(table Table, withCache, postgreSql, withCtx bool) (*findOneCode, error) {
 	text, err := util.LoadTemplate(category, findOneByFieldTemplateFile, template.FindOneByField)
 	if err != nil {
 		return nil, err
@@ -27,10 +27,10 @@ func genFindOneByField(table Table, withCache bool) (*findOneCode, error) {
 	for _, key := range table.UniqueCacheKey {
 		var inJoin, paramJoin, argJoin Join
 		for _, f := range key.Fields {
-			param := stringx.From(f.Name.ToCamel()).Untitle()
+			param := argName(f.Name)
 			inJoin = append(inJoin, fmt.Sprintf("%s %s", param, f.DataType))
 			paramJoin = append(paramJoin, param)
-			argJoin = append(argJoin, fmt.Sprintf("%s = ?", wrapWithRawString(f.Name.Source())))
+			argJoin = append(argJoin, fmt.Sprintf("%s = ?", wrapWithRawString(f.Name.Source(), postgreSql)))
 		}
 		var in string
 		if len(inJoin) > 0 {
@@ -42,12 +42,17 @@ func genFindOneByField(table Table, withCache bool) (*findOneCode, error) {
 			paramJoinString = paramJoin.With(",").Source()
 		}
 
+		if sd := table.notDeleted(); len(sd) > 0 {
+			argJoin = append(argJoin, sd)
+		}
+
 		var originalFieldString string
 		if len(argJoin) > 0 {
 			originalFieldString = argJoin.With(" and ").Source()
 		}
 
 		output, err := t.Execute(map[string]interface{}{
+			"withCtx":                   withCtx,
 			"upperStartCamelObject":     camelTableName,
 			"upperField":                key.FieldNameJoin.Camel().With("").Source(),
 			"in":                        in,
@@ -76,7 +81,7 @@ func genFindOneByField(table Table, withCache bool) (*findOneCode, error) {
 	for _, key := range table.UniqueCacheKey {
 		var inJoin, paramJoin Join
 		for _, f := range key.Fields {
-			param := stringx.From(f.Name.ToCamel()).Untitle()
+			param := argName(f.Name)
 			inJoin = append(inJoin, fmt.Sprintf("%s %s", param, f.DataType))
 			paramJoin = append(paramJoin, param)
 		}
@@ -86,6 +91,7 @@ func genFindOneByField(table Table, withCache bool) (*findOneCode, error) {
 			in = inJoin.With(", ").Source()
 		}
 		output, err := t.Execute(map[string]interface{}{
+			"withCtx":               withCtx,
 			"upperStartCamelObject": camelTableName,
 			"upperField":            key.FieldNameJoin.Camel().With("").Source(),
 			"in":                    in,
@@ -104,10 +110,12 @@ func genFindOneByField(table Table, withCache bool) (*findOneCode, error) {
 		}
 
 		out, err := util.With("findOneByFieldExtraMethod").Parse(text).Execute(map[string]interface{}{
+			"withCtx":               withCtx,
 			"upperStartCamelObject": camelTableName,
 			"primaryKeyLeft":        table.PrimaryCacheKey.VarLeft,
 			"lowerStartCamelObject": stringx.From(camelTableName).Untitle(),
-			"originalPrimaryField":  wrapWithRawString(table.PrimaryKey.Name.Source()),
+			"originalPrimaryField":  wrapWithRawString(table.PrimaryKey.Name.Source(), postgreSql),
+			"softDelete":            table.notDeleted(),
 		})
 		if err != nil {
 			return nil, err
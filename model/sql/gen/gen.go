@@ -3,7 +3,6 @@ package gen
 import (
 	"bytes"
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
@@ -29,8 +28,15 @@ type (
 		// source string
 		dir string
 		console.Console
-		pkg string
-		cfg *config.Config
+		pkg          string
+		cfg          *config.Config
+		isPostgreSql bool
+		isSqlite     bool
+		// softDeleteColumn and versionColumn are detected if empty, or disabled if DisableColumn
+		softDeleteColumn string
+		versionColumn    string
+		// withCtx generates the methods with context and the session of transaction
+		withCtx bool
 	}
 
 	// Option defines a function with argument defaultGenerator
@@ -84,6 +90,41 @@ func WithConsoleOption(c console.Console) Option {
 	}
 }
 
+// WithPostgreSql marks the generator to generate postgresql model code
+func WithPostgreSql() Option {
+	return func(generator *defaultGenerator) {
+		generator.isPostgreSql = true
+	}
+}
+
+// WithSqlite marks the generator to generate sqlite model code
+func WithSqlite() Option {
+	return func(generator *defaultGenerator) {
+		generator.isSqlite = true
+	}
+}
+
+// WithSoftDeleteColumn specifies the column to mark the rows deleted
+func WithSoftDeleteColumn(column string) Option {
+	return func(generator *defaultGenerator) {
+		generator.softDeleteColumn = column
+	}
+}
+
+// WithVersionColumn specifies the column of the optimistic lock
+func WithVersionColumn(column string) Option {
+	return func(generator *defaultGenerator) {
+		generator.versionColumn = column
+	}
+}
+
+// WithContext generates the methods with context.Context and WithSession to run in a transaction
+func WithContext() Option {
+	return func(generator *defaultGenerator) {
+		generator.withCtx = true
+	}
+}
+
 func newDefaultOption() Option {
 	return func(generator *defaultGenerator) {
 		generator.Console = console.NewColorConsole()
@@ -102,7 +143,14 @@ func (g *defaultGenerator) StartFromDDL(source string, withCache bool) error {
 func (g *defaultGenerator) StartFromInformationSchema(tables map[string]*model.Table, withCache bool) error {
 	m := make(map[string]string)
 	for _, each := range tables {
-		table, err := parser.ConvertDataType(each)
+		convert := parser.ConvertDataType
+		if g.isPostgreSql {
+			convert = parser.ConvertPostgreSqlDataType
+		} else if g.isSqlite {
+			convert = parser.ConvertSqliteDataType
+		}
+
+		table, err := convert(each)
 		if err != nil {
 			return err
 		}
@@ -141,10 +189,11 @@ func (g *defaultGenerator) createFile(modelList map[string]string) error {
 		name := modelFilename + ".go"
 		filename := filepath.Join(dirAbs, name)
 		if util.FileExists(filename) {
+			util.SkipFile(filename)
 			g.Warning("%s already exists, ignored.", name)
 			continue
 		}
-		err = ioutil.WriteFile(filename, []byte(code), os.ModePerm)
+		err = util.WriteFile(filename, []byte(code), os.ModePerm)
 		if err != nil {
 			return err
 		}
@@ -162,36 +211,83 @@ func (g *defaultGenerator) createFile(modelList map[string]string) error {
 		return err
 	}
 
-	err = util.With("vars").Parse(text).SaveTo(map[string]interface{}{
+	// merged into the existing file to add the errors introduced by the newer versions
+	err = util.With("vars").Parse(text).Merge(true).SaveTo(map[string]interface{}{
 		"pkg": g.pkg,
 	}, filename, false)
 	if err != nil {
 		return err
 	}
 
+	if g.withCtx {
+		if err = g.createTransactFile(dirAbs); err != nil {
+			return err
+		}
+	}
+
 	g.Success("Done.")
 	return nil
 }
 
+// createTransactFile generates TransactCtx in the model directory, the existing file is kept
+func (g *defaultGenerator) createTransactFile(dir string) error {
+	filename, err := format.FileNamingFormat(g.cfg.NamingFormat, "transact")
+	if err != nil {
+		return err
+	}
+
+	text, err := util.LoadTemplate(category, transactTemplateFile, template.Transact)
+	if err != nil {
+		return err
+	}
+
+	return util.With("transact").Parse(text).GoFmt(true).SaveTo(map[string]interface{}{
+		"pkg": g.pkg,
+	}, filepath.Join(dir, filename+".go"), false)
+}
+
 // ret1: key-table name,value-code
 func (g *defaultGenerator) genFromDDL(source string, withCache bool) (map[string]string, error) {
-	ddlList := g.split(source)
+	tables, err := g.parseDDL(source)
+	if err != nil {
+		return nil, err
+	}
+
 	m := make(map[string]string)
-	for _, ddl := range ddlList {
-		table, err := parser.Parse(ddl)
+	for _, table := range tables {
+		code, err := g.genModel(*table, withCache)
 		if err != nil {
 			return nil, err
 		}
 
-		code, err := g.genModel(*table, withCache)
+		m[table.Name.Source()] = code
+	}
+
+	return m, nil
+}
+
+func (g *defaultGenerator) parseDDL(source string) ([]*parser.Table, error) {
+	// the indexes and comments of postgresql and sqlite are declared in separate statements,
+	// so the whole source must be parsed together
+	if g.isPostgreSql {
+		return parser.ParsePostgreSql(source)
+	}
+
+	if g.isSqlite {
+		return parser.ParseSqlite(source)
+	}
+
+	var tables []*parser.Table
+	for _, ddl := range g.split(source) {
+		table, err := parser.Parse(ddl)
 		if err != nil {
 			return nil, err
 		}
 
-		m[table.Name.Source()] = code
+		tables = append(tables, table)
 	}
 
-	return m, nil
+	return tables, nil
 }
 
 // Table defines mysql table
@@ -200,6 +296,28 @@ type Table struct {
 	PrimaryCacheKey        Key
 	UniqueCacheKey         []Key
 	ContainsUniqueCacheKey bool
+	// SoftDelete is nil if the rows are deleted from the table
+	SoftDelete *softDelete
+	// Version is nil if the table has no optimistic lock
+	Version *lockVersion
+}
+
+// notDeleted returns the condition to filter out the deleted rows, empty if no soft delete column
+func (t Table) notDeleted() string {
+	if t.SoftDelete == nil {
+		return ""
+	}
+
+	return t.SoftDelete.Condition
+}
+
+// versionColumn returns the quoted version column, empty if the table has no optimistic lock
+func (t Table) versionColumn() string {
+	if t.Version == nil {
+		return ""
+	}
+
+	return t.Version.Column
 }
 
 func (g *defaultGenerator) genModel(in parser.Table, withCache bool) (string, error) {
@@ -209,57 +327,100 @@ func (g *defaultGenerator) genModel(in parser.Table, withCache bool) (string, er
 
 	primaryKey, uniqueKey := genCacheKeys(in)
 
-	importsCode, err := genImports(withCache, in.ContainsTime())
-	if err != nil {
-		return "", err
-	}
-
 	var table Table
 	table.Table = in
 	table.PrimaryCacheKey = primaryKey
 	table.UniqueCacheKey = uniqueKey
 	table.ContainsUniqueCacheKey = len(uniqueKey) > 0
 
-	varsCode, err := genVars(table, withCache)
+	sd, err := g.findSoftDelete(in)
+	if err != nil {
+		return "", err
+	}
+
+	table.SoftDelete = sd
+	table.Version, err = g.findVersion(in)
+	if err != nil {
+		return "", err
+	}
+
+	importsCode, err := genImports(withCache, in.ContainsTime() || (sd != nil && sd.Time), in.ContainsPQ(), g.withCtx)
+	if err != nil {
+		return "", err
+	}
+
+	varsCode, err := genVars(table, withCache, g.isPostgreSql)
 	if err != nil {
 		return "", err
 	}
 
-	insertCode, insertCodeMethod, err := genInsert(table, withCache)
+	insertCode, insertCodeMethod, err := genInsert(table, withCache, g.isPostgreSql, g.withCtx)
 	if err != nil {
 		return "", err
 	}
 
 	var findCode = make([]string, 0)
-	findOneCode, findOneCodeMethod, err := genFindOne(table, withCache)
+	findOneCode, findOneCodeMethod, err := genFindOne(table, withCache, g.isPostgreSql, g.withCtx)
+	if err != nil {
+		return "", err
+	}
+
+	ret, err := genFindOneByField(table, withCache, g.isPostgreSql, g.withCtx)
+	if err != nil {
+		return "", err
+	}
+
+	batchInsertCode, batchInsertCodeMethod, err := genBatchInsert(table, withCache, g.isPostgreSql, g.withCtx)
+	if err != nil {
+		return "", err
+	}
+
+	findByIdsCode, findByIdsCodeMethod, err := genFindByIds(table, withCache, g.isPostgreSql, g.withCtx)
 	if err != nil {
 		return "", err
 	}
 
-	ret, err := genFindOneByField(table, withCache)
+	findAll, err := genFindAllByField(table, withCache, g.isPostgreSql, g.withCtx)
 	if err != nil {
 		return "", err
 	}
 
-	findCode = append(findCode, findOneCode, ret.findOneMethod)
-	updateCode, updateCodeMethod, err := genUpdate(table, withCache)
+	countCode, countCodeMethod, err := genCount(table, withCache, g.withCtx)
 	if err != nil {
 		return "", err
 	}
 
-	deleteCode, deleteCodeMethod, err := genDelete(table, withCache)
+	findCode = append(findCode, findOneCode, ret.findOneMethod, findByIdsCode, findAll.findAllMethod, countCode)
+	updateCode, updateCodeMethod, err := genUpdate(table, withCache, g.isPostgreSql, g.withCtx)
+	if err != nil {
+		return "", err
+	}
+
+	deleteCode, deleteCodeMethod, err := genDelete(table, withCache, g.isPostgreSql, g.withCtx)
 	if err != nil {
 		return "", err
 	}
 
 	var list []string
-	list = append(list, insertCodeMethod, findOneCodeMethod, ret.findOneInterfaceMethod, updateCodeMethod, deleteCodeMethod)
-	typesCode, err := genTypes(table, strings.Join(modelutil.TrimStringSlice(list), util.NL), withCache)
+	list = append(list, insertCodeMethod, batchInsertCodeMethod, findOneCodeMethod, ret.findOneInterfaceMethod,
+		findByIdsCodeMethod, findAll.findAllInterfaceMethod, countCodeMethod, updateCodeMethod, deleteCodeMethod)
+	extraCode := ret.cacheExtra
+	if g.withCtx {
+		sessionCode, sessionCodeMethod, err := genSession(table, withCache)
+		if err != nil {
+			return "", err
+		}
+
+		list = append(list, sessionCodeMethod)
+		extraCode += sessionCode
+	}
+
+	typesCode, err := genTypes(table, strings.Join(modelutil.TrimStringSlice(list), util.NL), withCache, g.withCtx)
 	if err != nil {
 		return "", err
 	}
 
-	newCode, err := genNew(table, withCache)
+	newCode, err := genNew(table, withCache, g.isPostgreSql, g.withCtx)
 	if err != nil {
 		return "", err
 	}
@@ -269,11 +430,11 @@ func (g *defaultGenerator) genModel(in parser.Table, withCache bool) (string, er
 		varsCode:    varsCode,
 		typesCode:   typesCode,
 		newCode:     newCode,
-		insertCode:  insertCode,
+		insertCode:  insertCode + batchInsertCode,
 		findCode:    findCode,
 		updateCode:  updateCode,
 		deleteCode:  deleteCode,
-		cacheExtra:  ret.cacheExtra,
+		cacheExtra:  extraCode,
 	}
 
 	output, err := g.executeModel(code)
@@ -310,7 +471,11 @@ func (g *defaultGenerator) executeModel(code *code) (*bytes.Buffer, error) {
 	return output, nil
 }
 
-func wrapWithRawString(v string) string {
+func wrapWithRawString(v string, postgreSql bool) string {
+	if postgreSql {
+		return `\"` + v + `\"`
+	}
+
 	if v == "`" {
 		return v
 	}
@@ -2,6 +2,7 @@ package gen
 
 import (
 	"database/sql"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
@@ -82,11 +83,179 @@ func TestNamingModel(t *testing.T) {
 	}())
 }
 
+func TestQueryMethods(t *testing.T) {
+	logx.Disable()
+	_ = Clean()
+	dir := filepath.Join(t.TempDir(), "./testmodel")
+	g, err := NewDefaultGenerator(dir, &config.Config{
+		NamingFormat: "gozero",
+	})
+	assert.Nil(t, err)
+
+	for _, withCache := range []bool{true, false} {
+		code, err := g.genFromDDL(source, withCache)
+		assert.Nil(t, err)
+
+		userModel := code["test_user"]
+		assert.Contains(t, userModel, "BatchInsert(data []TestUser) (sql.Result, error)")
+		assert.Contains(t, userModel, "FindByIds(ids []int64) ([]*TestUser, error)")
+		assert.Contains(t, userModel, "FindAllByName(name string, offset, limit int64) ([]*TestUser, error)")
+		assert.Contains(t, userModel, "FindAllByNameAfter(name string, lastId int64, limit int64) ([]*TestUser, error)")
+		assert.Contains(t, userModel, "Count() (int64, error)")
+		assert.Contains(t, userModel, "where `name` = ? order by `id` limit ? offset ?")
+		assert.Contains(t, userModel, "where `name` = ? and `id` > ? order by `id` limit ?")
+		assert.Contains(t, userModel, `values = append(values, "(?, ?, ?)")`)
+		// the index of create_time is ignored
+		assert.NotContains(t, userModel, "FindAllByCreateTime")
+		if withCache {
+			assert.Contains(t, userModel, "m.GetCache(fmt.Sprintf(\"%s%v\", cacheTestUserIdPrefix, id), &item)")
+			assert.Contains(t, userModel, "fmt.Sprintf(\"%s%v\", cacheTestUserMobilePrefix, item.Mobile)")
+		} else {
+			assert.NotContains(t, userModel, "GetCache")
+		}
+	}
+}
+
+func TestKeywordColumns(t *testing.T) {
+	logx.Disable()
+	_ = Clean()
+	source := "CREATE TABLE `item` (\n  `id` bigint NOT NULL AUTO_INCREMENT,\n  `type` varchar(16) NOT NULL,\n  `default` varchar(16) NOT NULL,\n  `limit` bigint NOT NULL,\n  PRIMARY KEY (`id`),\n  UNIQUE KEY `default_unique` (`default`),\n  KEY `type_index` (`type`),\n  KEY `limit_index` (`limit`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;"
+	dir := filepath.Join(t.TempDir(), "./testmodel")
+	for _, opts := range [][]Option{nil, {WithContext()}} {
+		g, err := NewDefaultGenerator(dir, &config.Config{
+			NamingFormat: "gozero",
+		}, opts...)
+		assert.Nil(t, err)
+
+		for _, withCache := range []bool{true, false} {
+			code, err := g.genFromDDL(source, withCache)
+			assert.Nil(t, err)
+
+			itemModel := code["item"]
+			assert.Contains(t, itemModel, "typeValue string, offset, limit int64) ([]*Item, error)")
+			assert.Contains(t, itemModel, "limitValue int64, offset, limit int64) ([]*Item, error)")
+			assert.Contains(t, itemModel, "FindOneByDefault(")
+			assert.Contains(t, itemModel, "defaultValue string) (*Item, error)")
+		}
+	}
+}
+
+func TestSoftDeleteAndVersion(t *testing.T) {
+	logx.Disable()
+	_ = Clean()
+	source := "CREATE TABLE `account` (\n  `id` bigint NOT NULL AUTO_INCREMENT,\n  `email` varchar(128) NOT NULL,\n  `group_id` bigint NOT NULL,\n  `version` bigint NOT NULL DEFAULT 0,\n  `deleted_at` timestamp NULL DEFAULT NULL,\n  PRIMARY KEY (`id`),\n  UNIQUE KEY `email_unique` (`email`),\n  KEY `group_index` (`group_id`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;"
+	dir := filepath.Join(t.TempDir(), "./testmodel")
+	for _, withCache := range []bool{true, false} {
+		g, err := NewDefaultGenerator(dir, &config.Config{
+			NamingFormat: "gozero",
+		})
+		assert.Nil(t, err)
+
+		code, err := g.genFromDDL(source, withCache)
+		assert.Nil(t, err)
+
+		model := code["account"]
+		assert.Contains(t, model, "where `id` = ? and `deleted_at` is null limit 1")
+		assert.Contains(t, model, "where `email` = ? and `deleted_at` is null limit 1")
+		assert.Contains(t, model, "where `group_id` = ? and `deleted_at` is null order by `id` limit ? offset ?")
+		assert.Contains(t, model, "select count(*) from %s where `deleted_at` is null")
+		assert.Contains(t, model, "update %s set `deleted_at` = ? where `id` = ? and `deleted_at` is null")
+		assert.Contains(t, model, "update %s set %s, `version` = `version` + 1 where `id` = ? and `version` = ? and `deleted_at` is null")
+		assert.Contains(t, model, "data.Id, data.Version)")
+		assert.Contains(t, model, "return ErrVersionConflict")
+		assert.Contains(t, model, "select count(*) from %s where `id` = ? and `deleted_at` is null")
+		assert.Contains(t, model, `"time"`)
+		assert.NotContains(t, model, "delete from")
+	}
+
+	g, err := NewDefaultGenerator(dir, &config.Config{
+		NamingFormat: "gozero",
+	}, WithSoftDeleteColumn(DisableColumn), WithVersionColumn(DisableColumn))
+	assert.Nil(t, err)
+
+	code, err := g.genFromDDL(source, true)
+	assert.Nil(t, err)
+	assert.Contains(t, code["account"], "delete from")
+	assert.NotContains(t, code["account"], "is null")
+	assert.NotContains(t, code["account"], "ErrVersionConflict")
+
+	g, err = NewDefaultGenerator(dir, &config.Config{
+		NamingFormat: "gozero",
+	}, WithSoftDeleteColumn("email"))
+	assert.Nil(t, err)
+
+	_, err = g.genFromDDL(source, true)
+	assert.NotNil(t, err)
+
+	g, err = NewDefaultGenerator(dir, &config.Config{
+		NamingFormat: "gozero",
+	}, WithPostgreSql())
+	assert.Nil(t, err)
+
+	pgSource := `CREATE TABLE public.account (
+    id bigserial PRIMARY KEY,
+    email varchar(255) NOT NULL,
+    version bigint NOT NULL DEFAULT 0,
+    is_deleted boolean NOT NULL DEFAULT false,
+    CONSTRAINT email_unique UNIQUE (email)
+);`
+	code, err = g.genFromDDL(pgSource, true)
+	assert.Nil(t, err)
+
+	model := code["account"]
+	assert.Contains(t, model, `update %s set \"is_deleted\" = ? where \"id\" = ? and \"is_deleted\" = false`)
+	assert.Contains(t, model, `where \"id\" = ? and \"version\" = ? and \"is_deleted\" = false`)
+	assert.NotContains(t, model, "$1")
+}
+
+func TestContextModel(t *testing.T) {
+	logx.Disable()
+	_ = Clean()
+	dir := filepath.Join(t.TempDir(), "./testmodel")
+	g, err := NewDefaultGenerator(dir, &config.Config{
+		NamingFormat: "gozero",
+	}, WithContext())
+	assert.Nil(t, err)
+
+	for _, withCache := range []bool{true, false} {
+		code, err := g.genFromDDL(source, withCache)
+		assert.Nil(t, err)
+
+		userModel := code["test_user"]
+		assert.Contains(t, userModel, `"context"`)
+		assert.Contains(t, userModel, "Insert(ctx context.Context, data TestUser) (sql.Result, error)")
+		assert.Contains(t, userModel, "FindOneByMobile(ctx context.Context, mobile string) (*TestUser, error)")
+		assert.Contains(t, userModel, "FindAllByName(ctx context.Context, name string, offset, limit int64) ([]*TestUser, error)")
+		assert.Contains(t, userModel, "Count(ctx context.Context) (int64, error)")
+		assert.Contains(t, userModel, "Delete(ctx context.Context, id int64) error")
+		assert.Contains(t, userModel, "WithSession(session sqlx.Session) TestUserModel")
+		if withCache {
+			assert.Contains(t, userModel, "m.execCtx(ctx, func(conn sqlx.Session)")
+			assert.Contains(t, userModel, "m.queryRowIndexSession(&resp, testUserMobileKey, m.formatPrimary")
+			assert.Contains(t, userModel, "return nil, ErrNotInTransactCtx")
+			assert.Contains(t, userModel, "delCacheOnCommit(ctx, m.CachedConn, keys...)")
+			assert.Contains(t, userModel, "data, err := m.FindOne(ctx, id)")
+			assert.Contains(t, userModel, "if !m.tx && m.GetCache(")
+		} else {
+			assert.NotContains(t, userModel, "execCtx")
+			assert.Contains(t, userModel, "conn  sqlx.Session")
+		}
+	}
+
+	err = g.StartFromDDL(source, true)
+	assert.Nil(t, err)
+	assert.FileExists(t, filepath.Join(dir, "transact.go"))
+	vars, err := ioutil.ReadFile(filepath.Join(dir, "vars.go"))
+	assert.Nil(t, err)
+	assert.Contains(t, string(vars), "var ErrNotInTransactCtx")
+}
+
 func TestWrapWithRawString(t *testing.T) {
-	assert.Equal(t, "``", wrapWithRawString(""))
-	assert.Equal(t, "``", wrapWithRawString("``"))
-	assert.Equal(t, "`a`", wrapWithRawString("a"))
-	assert.Equal(t, "`   `", wrapWithRawString("   "))
+	assert.Equal(t, "``", wrapWithRawString("", false))
+	assert.Equal(t, "``", wrapWithRawString("``", false))
+	assert.Equal(t, "`a`", wrapWithRawString("a", false))
+	assert.Equal(t, "`   `", wrapWithRawString("   ", false))
+	assert.Equal(t, `\"a\"`, wrapWithRawString("a", true))
 }
 
 func TestFields(t *testing.T) {
@@ -110,3 +279,54 @@ func TestFields(t *testing.T) {
 	assert.Equal(t, "`name`,`age`,`score`", studentRowsExpectAutoSet)
 	assert.Equal(t, "`name`=?,`age`=?,`score`=?", studentRowsWithPlaceHolder)
 }
+
+func TestPostgreSqlModel(t *testing.T) {
+	logx.Disable()
+	_ = Clean()
+	source := `CREATE TABLE public.test_user (
+    id bigserial PRIMARY KEY,
+    mobile varchar(255) NOT NULL,
+    class bigint NOT NULL,
+    name varchar(255) NOT NULL,
+    tags text[],
+    create_time timestamptz NOT NULL DEFAULT now(),
+    update_time timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT mobile_unique UNIQUE (mobile),
+    CONSTRAINT class_name_unique UNIQUE (class, name)
+);
+CREATE INDEX name_index ON public.test_user (name);`
+	dir := filepath.Join(t.TempDir(), "./testmodel")
+	for _, withCache := range []bool{true, false} {
+		g, err := NewDefaultGenerator(dir, &config.Config{
+			NamingFormat: "gozero",
+		}, WithPostgreSql())
+		assert.Nil(t, err)
+
+		code, err := g.genFromDDL(source, withCache)
+		assert.Nil(t, err)
+		userModel := code["test_user"]
+		assert.Contains(t, userModel, `builderx.RawFieldNames(&TestUser{}, true)`)
+		assert.Contains(t, userModel, `"=?,") + "=?"`)
+		assert.Contains(t, userModel, `"\"public\".\"test_user\""`)
+		assert.Contains(t, userModel, `where \"id\" = ? limit 1`)
+		assert.Contains(t, userModel, `where \"class\" = ? and \"name\" = ? limit 1`)
+		assert.Contains(t, userModel, `values (?, ?, ?, ?)`)
+		assert.Contains(t, userModel, `"github.com/lib/pq"`)
+		assert.Contains(t, userModel, `where \"name\" = ? order by \"id\" limit ? offset ?`)
+		assert.Contains(t, userModel, "postgres.New of github.com/zeromicro/goctl/model/sql/postgres")
+		assert.NotContains(t, userModel, "$1")
+	}
+
+	err := func() error {
+		g, err := NewDefaultGenerator(dir, &config.Config{
+			NamingFormat: "gozero",
+		}, WithPostgreSql())
+		if err != nil {
+			return err
+		}
+
+		return g.StartFromDDL(source, true)
+	}()
+	assert.Nil(t, err)
+	assert.FileExists(t, filepath.Join(dir, "testusermodel.go"))
+}
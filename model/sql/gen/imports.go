@@ -5,7 +5,7 @@ import (
 	"github.com/zeromicro/goctl/util"
 )
 
-func genImports(withCache, timeImport bool) (string, error) {
+func genImports(withCache, timeImport, pqImport, ctxImport bool) (string, error) {
 	if withCache {
 		text, err := util.LoadTemplate(category, importsTemplateFile, template.Imports)
 		if err != nil {
@@ -14,6 +14,8 @@ func genImports(withCache, timeImport bool) (string, error) {
 
 		buffer, err := util.With("import").Parse(text).Execute(map[string]interface{}{
 			"time": timeImport,
+			"pq":   pqImport,
+			"ctx":  ctxImport,
 		})
 		if err != nil {
 			return "", err
@@ -29,6 +31,8 @@ func genImports(withCache, timeImport bool) (string, error) {
 
 	buffer, err := util.With("import").Parse(text).Execute(map[string]interface{}{
 		"time": timeImport,
+		"pq":   pqImport,
+		"ctx":  ctxImport,
 	})
 	if err != nil {
 		return "", err
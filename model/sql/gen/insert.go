@@ -9,7 +9,7 @@ import (
 	"github.com/zeromicro/goctl/util/stringx"
 )
 
-func genInsert(table Table, withCache bool) (string, string, error) {
+func genInsert(table Table, withCache, postgreSql, withCtx bool) (string, string, error) {
 	keySet := collection.NewSet()
 	keyVariableSet := collection.NewSet()
 	for _, key := range table.UniqueCacheKey {
@@ -19,18 +19,7 @@ func genInsert(table Table, withCache bool) (string, string, error) {
 
 	expressions := make([]string, 0)
 	expressionValues := make([]string, 0)
-	for _, field := range table.Fields {
-		camel := field.Name.ToCamel()
-		if camel == "CreateTime" || camel == "UpdateTime" {
-			continue
-		}
-
-		if field.Name.Source() == table.PrimaryKey.Name.Source() {
-			if table.PrimaryKey.AutoIncrement {
-				continue
-			}
-		}
-
+	for _, camel := range insertFields(table) {
 		expressions = append(expressions, "?")
 		expressionValues = append(expressionValues, "data."+camel)
 	}
@@ -44,6 +33,7 @@ func genInsert(table Table, withCache bool) (string, string, error) {
 	output, err := util.With("insert").
 		Parse(text).
 		Execute(map[string]interface{}{
+			"withCtx":               withCtx,
 			"withCache":             withCache,
 			"containsIndexCache":    table.ContainsUniqueCacheKey,
 			"upperStartCamelObject": camel,
@@ -64,6 +54,7 @@ func genInsert(table Table, withCache bool) (string, string, error) {
 	}
 
 	insertMethodOutput, err := util.With("insertMethod").Parse(text).Execute(map[string]interface{}{
+		"withCtx":               withCtx,
 		"upperStartCamelObject": camel,
 	})
 	if err != nil {
@@ -72,3 +63,78 @@ func genInsert(table Table, withCache bool) (string, string, error) {
 
 	return output.String(), insertMethodOutput.String(), nil
 }
+
+func genBatchInsert(table Table, withCache, postgreSql, withCtx bool) (string, string, error) {
+	fields := insertFields(table)
+	expressions := make([]string, 0, len(fields))
+	expressionValues := make([]string, 0, len(fields))
+	for _, camel := range fields {
+		expressions = append(expressions, "?")
+		expressionValues = append(expressionValues, "item."+camel)
+	}
+
+	keyValues := make([]string, 0, len(table.UniqueCacheKey))
+	for _, key := range table.UniqueCacheKey {
+		keyValues = append(keyValues, strings.ReplaceAll(key.DataKeyRight, "data.", "item."))
+	}
+
+	camel := table.Name.ToCamel()
+	text, err := util.LoadTemplate(category, batchInsertTemplateFile, template.BatchInsert)
+	if err != nil {
+		return "", "", err
+	}
+
+	output, err := util.With("batchInsert").
+		Parse(text).
+		Execute(map[string]interface{}{
+			"withCtx":               withCtx,
+			"withCache":             withCache,
+			"containsIndexCache":    table.ContainsUniqueCacheKey,
+			"upperStartCamelObject": camel,
+			"lowerStartCamelObject": stringx.From(camel).Untitle(),
+			"expression":            strings.Join(expressions, ", "),
+			"expressionValues":      strings.Join(expressionValues, ", "),
+			"fieldCount":            len(fields),
+			"keyCount":              len(keyValues),
+			"keyValues":             strings.Join(keyValues, ", "),
+		})
+	if err != nil {
+		return "", "", err
+	}
+
+	text, err = util.LoadTemplate(category, batchInsertMethodTemplateFile, template.BatchInsertMethod)
+	if err != nil {
+		return "", "", err
+	}
+
+	method, err := util.With("batchInsertMethod").Parse(text).Execute(map[string]interface{}{
+		"withCtx":               withCtx,
+		"upperStartCamelObject": camel,
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	return output.String(), method.String(), nil
+}
+
+// insertFields returns the camel names of the fields to insert, the auto set fields are excluded
+func insertFields(table Table) []string {
+	var fields []string
+	for _, field := range table.Fields {
+		camel := field.Name.ToCamel()
+		if camel == "CreateTime" || camel == "UpdateTime" {
+			continue
+		}
+
+		if field.Name.Source() == table.PrimaryKey.Name.Source() {
+			if table.PrimaryKey.AutoIncrement {
+				continue
+			}
+		}
+
+		fields = append(fields, camel)
+	}
+
+	return fields
+}
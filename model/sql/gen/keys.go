@@ -67,7 +67,7 @@ func genCacheKey(table stringx.String, in []*parser.Field) Key {
 		varLeftJoin = append(varLeftJoin, each.Name.Source())
 		varRightJon = append(varRightJon, each.Name.Source())
 		keyLeftJoin = append(keyLeftJoin, each.Name.Source())
-		keyRightJoin = append(keyRightJoin, stringx.From(each.Name.ToCamel()).Untitle())
+		keyRightJoin = append(keyRightJoin, argName(each.Name))
 		keyRightArgJoin = append(keyRightArgJoin, "%v")
 		dataRightJoin = append(dataRightJoin, "data."+each.Name.ToCamel())
 		fieldNameJoin = append(fieldNameJoin, each.Name.Source())
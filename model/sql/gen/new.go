@@ -5,7 +5,12 @@ import (
 	"github.com/zeromicro/goctl/util"
 )
 
-func genNew(table Table, withCache bool) (string, error) {
+func genNew(table Table, withCache, postgreSql, withCtx bool) (string, error) {
+	tableName := wrapWithRawString(table.Name.Source(), postgreSql)
+	if postgreSql && len(table.Db.Source()) > 0 {
+		tableName = wrapWithRawString(table.Db.Source(), postgreSql) + "." + tableName
+	}
+
 	text, err := util.LoadTemplate(category, modelNewTemplateFile, template.New)
 	if err != nil {
 		return "", err
@@ -14,8 +19,10 @@ func genNew(table Table, withCache bool) (string, error) {
 	output, err := util.With("new").
 		Parse(text).
 		Execute(map[string]interface{}{
-			"table":                 wrapWithRawString(table.Name.Source()),
+			"table":                 tableName,
 			"withCache":             withCache,
+			"withCtx":               withCtx,
+			"postgreSql":            postgreSql,
 			"upperStartCamelObject": table.Name.ToCamel(),
 		})
 	if err != nil {
@@ -0,0 +1,37 @@
+package gen
+
+import (
+	"github.com/zeromicro/goctl/model/sql/template"
+	"github.com/zeromicro/goctl/util"
+)
+
+func genSession(table Table, withCache bool) (string, string, error) {
+	camel := table.Name.ToCamel()
+	text, err := util.LoadTemplate(category, sessionTemplateFile, template.Session)
+	if err != nil {
+		return "", "", err
+	}
+
+	output, err := util.With("session").Parse(text).Execute(map[string]interface{}{
+		"withCache":             withCache,
+		"containsIndexCache":    table.ContainsUniqueCacheKey,
+		"upperStartCamelObject": camel,
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	text, err = util.LoadTemplate(category, sessionMethodTemplateFile, template.SessionMethod)
+	if err != nil {
+		return "", "", err
+	}
+
+	method, err := util.With("sessionMethod").Parse(text).Execute(map[string]interface{}{
+		"upperStartCamelObject": camel,
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	return output.String(), method.String(), nil
+}
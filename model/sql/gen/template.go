@@ -30,6 +30,17 @@ const (
 	updateMethodTemplateFile              = "interface-update.tpl"
 	varTemplateFile                       = "var.tpl"
 	errTemplateFile                       = "err.tpl"
+	findAllByFieldTemplateFile            = "find-all-by-field.tpl"
+	findAllByFieldMethodTemplateFile      = "interface-find-all-by-field.tpl"
+	findByIdsTemplateFile                 = "find-by-ids.tpl"
+	findByIdsMethodTemplateFile           = "interface-find-by-ids.tpl"
+	countTemplateFile                     = "count.tpl"
+	countMethodTemplateFile               = "interface-count.tpl"
+	batchInsertTemplateFile               = "batch-insert.tpl"
+	batchInsertMethodTemplateFile         = "interface-batch-insert.tpl"
+	sessionTemplateFile                   = "session.tpl"
+	sessionMethodTemplateFile             = "interface-session.tpl"
+	transactTemplateFile                  = "transact.tpl"
 )
 
 var templates = map[string]string{
@@ -52,6 +63,17 @@ var templates = map[string]string{
 	updateTemplateFile:                    template.Update,
 	varTemplateFile:                       template.Vars,
 	errTemplateFile:                       template.Error,
+	findAllByFieldTemplateFile:            template.FindAllByField,
+	findAllByFieldMethodTemplateFile:      template.FindAllByFieldMethod,
+	findByIdsTemplateFile:                 template.FindByIds,
+	findByIdsMethodTemplateFile:           template.FindByIdsMethod,
+	countTemplateFile:                     template.Count,
+	countMethodTemplateFile:               template.CountMethod,
+	batchInsertTemplateFile:               template.BatchInsert,
+	batchInsertMethodTemplateFile:         template.BatchInsertMethod,
+	sessionTemplateFile:                   template.Session,
+	sessionMethodTemplateFile:             template.SessionMethod,
+	transactTemplateFile:                  template.Transact,
 }
 
 // Category returns model const value
@@ -1,6 +1,7 @@
 package gen
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/tal-tech/go-zero/core/collection"
@@ -9,7 +10,7 @@ import (
 	"github.com/zeromicro/goctl/util/stringx"
 )
 
-func genUpdate(table Table, withCache bool) (string, string, error) {
+func genUpdate(table Table, withCache, postgreSql, withCtx bool) (string, string, error) {
 	expressionValues := make([]string, 0)
 	for _, field := range table.Fields {
 		camel := field.Name.ToCamel()
@@ -21,6 +22,11 @@ func genUpdate(table Table, withCache bool) (string, string, error) {
 			continue
 		}
 
+		// the version is increased by the statement instead of set by the caller
+		if table.Version != nil && camel == table.Version.Camel {
+			continue
+		}
+
 		expressionValues = append(expressionValues, "data."+camel)
 	}
 
@@ -34,6 +40,19 @@ func genUpdate(table Table, withCache bool) (string, string, error) {
 	}
 
 	expressionValues = append(expressionValues, "data."+table.PrimaryKey.Name.ToCamel())
+
+	var versionSet, condition, softDelete string
+	versionColumn := table.versionColumn()
+	if table.Version != nil {
+		versionSet = fmt.Sprintf(", %s = %s + 1", versionColumn, versionColumn)
+		condition = fmt.Sprintf(" and %s = ?", versionColumn)
+		expressionValues = append(expressionValues, "data."+table.Version.Camel)
+	}
+	if sd := table.notDeleted(); len(sd) > 0 {
+		softDelete = " and " + sd
+		condition += softDelete
+	}
+
 	camelTableName := table.Name.ToCamel()
 	text, err := util.LoadTemplate(category, updateTemplateFile, template.Update)
 	if err != nil {
@@ -43,15 +62,23 @@ func genUpdate(table Table, withCache bool) (string, string, error) {
 	output, err := util.With("update").
 		Parse(text).
 		Execute(map[string]interface{}{
-			"withCache":             withCache,
-			"upperStartCamelObject": camelTableName,
-			"keys":                  strings.Join(keySet.KeysStr(), "\n"),
-			"keyValues":             strings.Join(keyVariableSet.KeysStr(), ", "),
-			"primaryCacheKey":       table.PrimaryCacheKey.DataKeyExpression,
-			"primaryKeyVariable":    table.PrimaryCacheKey.KeyLeft,
-			"lowerStartCamelObject": stringx.From(camelTableName).Untitle(),
-			"originalPrimaryKey":    wrapWithRawString(table.PrimaryKey.Name.Source()),
-			"expressionValues":      strings.Join(expressionValues, ", "),
+			"withCtx":                   withCtx,
+			"withCache":                 withCache,
+			"upperStartCamelObject":     camelTableName,
+			"keys":                      strings.Join(keySet.KeysStr(), "\n"),
+			"keyValues":                 strings.Join(keyVariableSet.KeysStr(), ", "),
+			"primaryCacheKey":           table.PrimaryCacheKey.DataKeyExpression,
+			"primaryKeyVariable":        table.PrimaryCacheKey.KeyLeft,
+			"upperStartCamelPrimaryKey": table.PrimaryKey.Name.ToCamel(),
+			"lowerStartCamelPrimaryKey": argName(table.PrimaryKey.Name),
+			"dataType":                  table.PrimaryKey.DataType,
+			"lowerStartCamelObject":     stringx.From(camelTableName).Untitle(),
+			"originalPrimaryKey":        wrapWithRawString(table.PrimaryKey.Name.Source(), postgreSql),
+			"expressionValues":          strings.Join(expressionValues, ", "),
+			"versionColumn":             versionColumn,
+			"versionSet":                versionSet,
+			"condition":                 condition,
+			"softDelete":                softDelete,
 		})
 	if err != nil {
 		return "", "", nil
@@ -66,6 +93,7 @@ func genUpdate(table Table, withCache bool) (string, string, error) {
 	updateMethodOutput, err := util.With("updateMethod").
 		Parse(text).
 		Execute(map[string]interface{}{
+			"withCtx":               withCtx,
 			"upperStartCamelObject": camelTableName,
 		})
 	if err != nil {
@@ -0,0 +1,107 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/lib/pq"
+	"github.com/tal-tech/go-zero/core/stores/sqlx"
+)
+
+const autoIncrement = "auto_increment"
+
+// PostgreSqlModel gets table information from information_schema and pg_catalog,
+// the arguments are quoted into the statements because sqlx only knows ? placeholders
+type PostgreSqlModel struct {
+	conn sqlx.SqlConn
+}
+
+// NewPostgreSqlModel creates an instance for PostgreSqlModel
+func NewPostgreSqlModel(conn sqlx.SqlConn) *PostgreSqlModel {
+	return &PostgreSqlModel{conn: conn}
+}
+
+// GetAllTables selects all tables from the specified schema
+func (m *PostgreSqlModel) GetAllTables(schema string) ([]string, error) {
+	query := fmt.Sprintf(`select table_name from information_schema.tables where table_schema = %s and table_type = 'BASE TABLE'`,
+		pq.QuoteLiteral(schema))
+	var tables []string
+	err := m.conn.QueryRows(&tables, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return tables, nil
+}
+
+// FindColumns return columns in specified schema and table,
+// serial and identity columns are marked as auto_increment in Extra
+func (m *PostgreSqlModel) FindColumns(schema, table string) (*ColumnData, error) {
+	querySql := fmt.Sprintf(`select c.column_name as "COLUMN_NAME", c.udt_name as "DATA_TYPE",
+		case when c.is_identity = 'YES' or c.column_default like 'nextval(%%' then '%s' else '' end as "EXTRA",
+		coalesce(col_description(format('%%I.%%I', c.table_schema, c.table_name)::regclass::oid, c.ordinal_position), '') as "COLUMN_COMMENT",
+		c.column_default as "COLUMN_DEFAULT", c.is_nullable as "IS_NULLABLE", c.ordinal_position as "ORDINAL_POSITION"
+		from information_schema.columns c where c.table_schema = %s and c.table_name = %s`,
+		autoIncrement, pq.QuoteLiteral(schema), pq.QuoteLiteral(table))
+	var reply []*DbColumn
+	err := m.conn.QueryRowsPartial(&reply, querySql)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*Column
+	for _, item := range reply {
+		index, err := m.FindIndex(schema, table, item.Name)
+		if err != nil {
+			if err != sqlx.ErrNotFound {
+				return nil, err
+			}
+			continue
+		}
+
+		if len(index) > 0 {
+			for _, i := range index {
+				list = append(list, &Column{
+					DbColumn: item,
+					Index:    i,
+				})
+			}
+		} else {
+			list = append(list, &Column{
+				DbColumn: item,
+			})
+		}
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].OrdinalPosition < list[j].OrdinalPosition
+	})
+
+	var columnData ColumnData
+	columnData.Db = schema
+	columnData.Table = table
+	columnData.Columns = list
+	return &columnData, nil
+}
+
+// FindIndex finds index with given schema, table and column,
+// the primary key index is named PRIMARY to keep the same as mysql
+func (m *PostgreSqlModel) FindIndex(schema, table, column string) ([]*DbIndex, error) {
+	querySql := fmt.Sprintf(`select case when i.indisprimary then '%s' else ic.relname end as "INDEX_NAME",
+		case when i.indisunique then 0 else 1 end as "NON_UNIQUE", k.ord as "SEQ_IN_INDEX"
+		from pg_index i
+		join pg_class t on t.oid = i.indrelid
+		join pg_namespace n on n.oid = t.relnamespace
+		join pg_class ic on ic.oid = i.indexrelid
+		cross join lateral unnest(i.indkey) with ordinality as k(attnum, ord)
+		join pg_attribute a on a.attrelid = t.oid and a.attnum = k.attnum
+		where n.nspname = %s and t.relname = %s and a.attname = %s`,
+		indexPri, pq.QuoteLiteral(schema), pq.QuoteLiteral(table), pq.QuoteLiteral(column))
+	var reply []*DbIndex
+	err := m.conn.QueryRowsPartial(&reply, querySql)
+	if err != nil {
+		return nil, err
+	}
+
+	return reply, nil
+}
@@ -0,0 +1,12 @@
+//go:build cgo
+// +build cgo
+
+package model
+
+import (
+	// imports the sqlite driver, which requires cgo.
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// errSqliteDriver is not nil if the sqlite driver is not available
+var errSqliteDriver error
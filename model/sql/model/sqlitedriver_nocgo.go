@@ -0,0 +1,9 @@
+//go:build !cgo
+// +build !cgo
+
+package model
+
+import "errors"
+
+// errSqliteDriver is not nil if the sqlite driver is not available
+var errSqliteDriver = errors.New("sqlite is not supported by goctl built without cgo, rebuild it with CGO_ENABLED=1")
@@ -0,0 +1,273 @@
+package model
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// SqliteMainSchema is the schema name of the opened database in sqlite
+	SqliteMainSchema = "main"
+
+	sqliteDriverName         = "sqlite3"
+	sqliteIndexOriginPrimary = "pk"
+	sqliteNullable           = "YES"
+	sqliteNotNull            = "NO"
+)
+
+type (
+	// SqliteModel gets table information from sqlite_master and the pragma functions
+	SqliteModel struct {
+		db *sql.DB
+	}
+
+	// SqliteColumn defines a row of pragma table_info
+	SqliteColumn struct {
+		Cid          int
+		Name         string
+		DataType     string
+		NotNull      int
+		DefaultValue sql.NullString
+		PrimaryKey   int
+	}
+
+	// SqliteIndex defines a row of pragma index_list
+	SqliteIndex struct {
+		Name    string
+		Unique  int
+		Origin  string
+		Partial int
+	}
+
+	// SqliteIndexColumn defines a row of pragma index_info
+	SqliteIndexColumn struct {
+		SeqNo int
+		Name  sql.NullString
+	}
+)
+
+// OpenSqlite opens the sqlite database file in read only mode, the model must be closed after use
+func OpenSqlite(file string) (*SqliteModel, error) {
+	return openSqlite(fmt.Sprintf("file:%s?mode=ro", file))
+}
+
+// OpenSqliteMemory opens a new in-memory sqlite database, which is dropped after the model is closed
+func OpenSqliteMemory() (*SqliteModel, error) {
+	m, err := openSqlite(":memory:")
+	if err != nil {
+		return nil, err
+	}
+
+	// every connection to :memory: opens a different database
+	m.db.SetMaxOpenConns(1)
+	return m, nil
+}
+
+func openSqlite(datasource string) (*SqliteModel, error) {
+	if errSqliteDriver != nil {
+		return nil, errSqliteDriver
+	}
+
+	db, err := sql.Open(sqliteDriverName, datasource)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SqliteModel{db: db}, nil
+}
+
+// Close closes the database
+func (m *SqliteModel) Close() error {
+	return m.db.Close()
+}
+
+// Exec executes the statements, e.g. the ddl of the tables
+func (m *SqliteModel) Exec(query string) error {
+	_, err := m.db.Exec(query)
+	return err
+}
+
+// GetAllTables selects all tables except the internal tables of sqlite
+func (m *SqliteModel) GetAllTables() ([]string, error) {
+	query := `select name from sqlite_master where type = 'table' and name not like 'sqlite_%'`
+	var tables []string
+	err := m.query(func(rows *sql.Rows) error {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			return err
+		}
+
+		tables = append(tables, table)
+		return nil
+	}, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return tables, nil
+}
+
+// FindColumns return columns in specified table, the database is the name of the schema, e.g. main
+func (m *SqliteModel) FindColumns(db, table string) (*ColumnData, error) {
+	querySql := `select cid, name, type, "notnull", dflt_value, pk from pragma_table_info(?)`
+	var reply []*SqliteColumn
+	err := m.query(func(rows *sql.Rows) error {
+		var item SqliteColumn
+		err := rows.Scan(&item.Cid, &item.Name, &item.DataType, &item.NotNull, &item.DefaultValue, &item.PrimaryKey)
+		if err != nil {
+			return err
+		}
+
+		reply = append(reply, &item)
+		return nil
+	}, querySql, table)
+	if err != nil {
+		return nil, err
+	}
+
+	indexes, err := m.FindIndex(table)
+	if err != nil {
+		return nil, err
+	}
+
+	var primaryCount int
+	for _, item := range reply {
+		if item.PrimaryKey > 0 {
+			primaryCount++
+		}
+	}
+
+	var list []*Column
+	for _, item := range reply {
+		column := &DbColumn{
+			Name:            item.Name,
+			DataType:        item.DataType,
+			IsNullAble:      sqliteNullable,
+			OrdinalPosition: item.Cid + 1,
+		}
+		if item.DefaultValue.Valid {
+			column.ColumnDefault = item.DefaultValue.String
+		}
+
+		if item.NotNull > 0 || item.PrimaryKey > 0 {
+			column.IsNullAble = sqliteNotNull
+		}
+
+		// the single INTEGER PRIMARY KEY is an alias of the rowid
+		if item.PrimaryKey > 0 && primaryCount == 1 && strings.EqualFold(item.DataType, "integer") {
+			column.Extra = autoIncrement
+		}
+
+		var columnIndexes []*DbIndex
+		if item.PrimaryKey > 0 {
+			columnIndexes = append(columnIndexes, &DbIndex{
+				IndexName:  indexPri,
+				SeqInIndex: item.PrimaryKey,
+			})
+		}
+		columnIndexes = append(columnIndexes, indexes[item.Name]...)
+
+		if len(columnIndexes) > 0 {
+			for _, i := range columnIndexes {
+				list = append(list, &Column{
+					DbColumn: column,
+					Index:    i,
+				})
+			}
+		} else {
+			list = append(list, &Column{
+				DbColumn: column,
+			})
+		}
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].OrdinalPosition < list[j].OrdinalPosition
+	})
+
+	var columnData ColumnData
+	columnData.Db = db
+	columnData.Table = table
+	columnData.Columns = list
+	return &columnData, nil
+}
+
+// FindIndex finds the indexes of table and groups them by column name,
+// the primary key index is excluded because it is read from table_info
+func (m *SqliteModel) FindIndex(table string) (map[string][]*DbIndex, error) {
+	querySql := `select name, "unique", origin, partial from pragma_index_list(?)`
+	var reply []*SqliteIndex
+	err := m.query(func(rows *sql.Rows) error {
+		var item SqliteIndex
+		if err := rows.Scan(&item.Name, &item.Unique, &item.Origin, &item.Partial); err != nil {
+			return err
+		}
+
+		reply = append(reply, &item)
+		return nil
+	}, querySql, table)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make(map[string][]*DbIndex)
+	for _, index := range reply {
+		if index.Origin == sqliteIndexOriginPrimary {
+			continue
+		}
+
+		var columns []*SqliteIndexColumn
+		err := m.query(func(rows *sql.Rows) error {
+			var item SqliteIndexColumn
+			if err := rows.Scan(&item.SeqNo, &item.Name); err != nil {
+				return err
+			}
+
+			columns = append(columns, &item)
+			return nil
+		}, `select seqno, name from pragma_index_info(?)`, index.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		// a partial unique index doesn't make a column unique in the whole table
+		nonUnique := 1
+		if index.Unique > 0 && index.Partial == 0 {
+			nonUnique = 0
+		}
+
+		for _, column := range columns {
+			// the indexes on expressions have no column name
+			if !column.Name.Valid {
+				continue
+			}
+
+			ret[column.Name.String] = append(ret[column.Name.String], &DbIndex{
+				IndexName:  index.Name,
+				NonUnique:  nonUnique,
+				SeqInIndex: column.SeqNo + 1,
+			})
+		}
+	}
+
+	return ret, nil
+}
+
+// query calls scan for every row of the query
+func (m *SqliteModel) query(scan func(rows *sql.Rows) error, query string, args ...interface{}) error {
+	rows, err := m.db.Query(query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := scan(rows); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
@@ -13,12 +13,16 @@ import (
 	"github.com/zeromicro/goctl/util/stringx"
 )
 
-const timeImport = "time.Time"
+const (
+	timeImport = "time.Time"
+	pqImport   = "pq."
+)
 
 type (
 	// Table describes a mysql table
 	Table struct {
 		Name        stringx.String
+		Db          stringx.String
 		PrimaryKey  Primary
 		UniqueIndex map[string][]*Field
 		NormalIndex map[string][]*Field
@@ -201,12 +205,6 @@ func convertIndexes(indexes []*sqlparser.IndexDefinition) (string, map[string][]
 	uniqueKeyMap := make(map[string][]string)
 	normalKeyMap := make(map[string][]string)
 
-	isCreateTimeOrUpdateTime := func(name string) bool {
-		camelColumnName := stringx.From(name).ToCamel()
-		// by default, createTime|updateTime findOne is not used.
-		return camelColumnName == "CreateTime" || camelColumnName == "UpdateTime"
-	}
-
 	for _, index := range indexes {
 		info := index.Info
 		if info == nil {
@@ -250,6 +248,12 @@ func convertIndexes(indexes []*sqlparser.IndexDefinition) (string, map[string][]
 	return primaryColumn, uniqueKeyMap, normalKeyMap, nil
 }
 
+func isCreateTimeOrUpdateTime(name string) bool {
+	camelColumnName := stringx.From(name).ToCamel()
+	// by default, createTime|updateTime findOne is not used.
+	return camelColumnName == "CreateTime" || camelColumnName == "UpdateTime"
+}
+
 // ContainsTime returns true if contains golang type time.Time
 func (t *Table) ContainsTime() bool {
 	for _, item := range t.Fields {
@@ -260,10 +264,34 @@ func (t *Table) ContainsTime() bool {
 	return false
 }
 
+// ContainsPQ returns true if contains the postgresql array types of github.com/lib/pq
+func (t *Table) ContainsPQ() bool {
+	for _, item := range t.Fields {
+		if strings.HasPrefix(item.DataType, pqImport) {
+			return true
+		}
+	}
+	return false
+}
+
 // ConvertDataType converts mysql data type into golang data type
 func ConvertDataType(table *model.Table) (*Table, error) {
+	return convertDataType(table, converter.ConvertDataType)
+}
+
+// ConvertPostgreSqlDataType converts postgresql data type into golang data type
+func ConvertPostgreSqlDataType(table *model.Table) (*Table, error) {
+	return convertDataType(table, converter.ConvertPostgreSqlDataType)
+}
+
+// ConvertSqliteDataType converts sqlite data type into golang data type
+func ConvertSqliteDataType(table *model.Table) (*Table, error) {
+	return convertDataType(table, converter.ConvertSqliteDataType)
+}
+
+func convertDataType(table *model.Table, convert func(string, bool) (string, error)) (*Table, error) {
 	isPrimaryDefaultNull := table.PrimaryKey.ColumnDefault == nil && table.PrimaryKey.IsNullAble == "YES"
-	primaryDataType, err := converter.ConvertDataType(table.PrimaryKey.DataType, isPrimaryDefaultNull)
+	primaryDataType, err := convert(table.PrimaryKey.DataType, isPrimaryDefaultNull)
 	if err != nil {
 		return nil, err
 	}
@@ -272,6 +300,7 @@ func ConvertDataType(table *model.Table) (*Table, error) {
 	reply.UniqueIndex = map[string][]*Field{}
 	reply.NormalIndex = map[string][]*Field{}
 	reply.Name = stringx.From(table.Table)
+	reply.Db = stringx.From(table.Db)
 	seqInIndex := 0
 	if table.PrimaryKey.Index != nil {
 		seqInIndex = table.PrimaryKey.Index.SeqInIndex
@@ -292,7 +321,7 @@ func ConvertDataType(table *model.Table) (*Table, error) {
 	fieldM := make(map[string]*Field)
 	for _, each := range table.Columns {
 		isDefaultNull := each.ColumnDefault == nil && each.IsNullAble == "YES"
-		dt, err := converter.ConvertDataType(each.DataType, isDefaultNull)
+		dt, err := convert(each.DataType, isDefaultNull)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,825 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tal-tech/go-zero/core/collection"
+	"github.com/zeromicro/goctl/model/sql/converter"
+	"github.com/zeromicro/goctl/util/console"
+	"github.com/zeromicro/goctl/util/stringx"
+)
+
+const (
+	pgTokenWord pgTokenKind = iota
+	pgTokenIdent
+	pgTokenString
+	pgTokenSymbol
+)
+
+type (
+	pgTokenKind int
+
+	pgToken struct {
+		kind pgTokenKind
+		text string
+	}
+
+	pgTokens []pgToken
+
+	pgColumn struct {
+		name          string
+		dataType      string
+		comment       string
+		notNull       bool
+		defaultNull   bool
+		hasDefault    bool
+		autoIncrement bool
+	}
+
+	pgIndex struct {
+		name    string
+		columns []string
+	}
+
+	pgTable struct {
+		schema  string
+		name    string
+		columns []*pgColumn
+		primary []string
+		unique  []pgIndex
+		normal  []pgIndex
+	}
+
+	pgParser struct {
+		tables []*pgTable
+		log    console.Console
+	}
+)
+
+var pgSerialTypes = map[string]bool{
+	"smallserial": true,
+	"serial2":     true,
+	"serial":      true,
+	"serial4":     true,
+	"bigserial":   true,
+	"serial8":     true,
+}
+
+// column constraint keywords which terminate the data type of a column definition
+var pgColumnConstraints = map[string]bool{
+	"constraint": true,
+	"not":        true,
+	"null":       true,
+	"default":    true,
+	"primary":    true,
+	"unique":     true,
+	"check":      true,
+	"references": true,
+	"generated":  true,
+	"collate":    true,
+}
+
+// ParsePostgreSql parses postgresql ddl into golang structures, the indexes, constraints and
+// comments declared by CREATE INDEX, ALTER TABLE and COMMENT ON statements are merged into
+// the tables created in the same source
+func ParsePostgreSql(ddl string) ([]*Table, error) {
+	tokens, err := pgLex(ddl)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &pgParser{log: console.NewColorConsole()}
+	for _, stmt := range tokens.split(";") {
+		if err := p.parseStatement(stmt); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(p.tables) == 0 {
+		return nil, errTableBodyNotFound
+	}
+
+	var tables []*Table
+	for _, each := range p.tables {
+		table, err := p.convert(each)
+		if err != nil {
+			return nil, err
+		}
+
+		tables = append(tables, table)
+	}
+
+	return tables, nil
+}
+
+func (p *pgParser) parseStatement(stmt pgTokens) error {
+	switch {
+	case stmt.hasPrefix("create"):
+		var i = 1
+		for i < len(stmt) && stmt[i].isWord("global", "local", "temp", "temporary", "unlogged", "unique") {
+			i++
+		}
+
+		if i < len(stmt) && stmt[i].isWord("table") {
+			return p.parseCreateTable(stmt[i+1:])
+		}
+
+		if i < len(stmt) && stmt[i].isWord("index") {
+			return p.parseCreateIndex(stmt[1:])
+		}
+	case stmt.hasPrefix("alter", "table"):
+		return p.parseAlterTable(stmt[2:])
+	case stmt.hasPrefix("comment", "on", "column"):
+		return p.parseComment(stmt[3:])
+	}
+
+	// other statements are not about the table structure
+	return nil
+}
+
+func (p *pgParser) parseCreateTable(stmt pgTokens) error {
+	stmt = stmt.skipWords("if", "not", "exists")
+	schema, name, stmt, err := stmt.qualifiedName()
+	if err != nil {
+		return err
+	}
+
+	if len(stmt) == 0 || !stmt[0].isSymbol("(") {
+		return errTableBodyNotFound
+	}
+
+	body, _ := stmt.group()
+	table := &pgTable{schema: schema, name: name}
+	for _, element := range body.split(",") {
+		if len(element) == 0 {
+			continue
+		}
+
+		if element.hasPrefix("constraint") || element.hasPrefix("primary", "key") ||
+			element.hasPrefix("unique") || element.hasPrefix("check") ||
+			element.hasPrefix("foreign", "key") || element.hasPrefix("exclude") || element.hasPrefix("like") {
+			table.addConstraint(element)
+			continue
+		}
+
+		column, err := parseColumn(table, element)
+		if err != nil {
+			return err
+		}
+
+		table.columns = append(table.columns, column)
+	}
+
+	p.tables = append(p.tables, table)
+	return nil
+}
+
+func parseColumn(table *pgTable, element pgTokens) (*pgColumn, error) {
+	if !element[0].isName() {
+		return nil, fmt.Errorf("table %s: unexpected column definition near %q", table.name, element[0].text)
+	}
+
+	column := &pgColumn{name: element[0].name()}
+	var typeJoin []string
+	var i = 1
+	for i < len(element) {
+		token := element[i]
+		if token.isColumnConstraint() {
+			break
+		}
+
+		switch {
+		case token.isSymbol("("):
+			// type modifiers such as varchar(255) and numeric(10, 2) are not needed
+			_, rest := element[i:].group()
+			i = len(element) - len(rest)
+			continue
+		case token.isSymbol("["):
+			typeJoin = append(typeJoin, "[]")
+			for i < len(element) && !element[i].isSymbol("]") {
+				i++
+			}
+		case token.isWord("array"):
+			typeJoin = append(typeJoin, "[]")
+		default:
+			typeJoin = append(typeJoin, strings.ToLower(token.text))
+		}
+		i++
+	}
+
+	if len(typeJoin) == 0 {
+		return nil, fmt.Errorf("table %s: missing data type of column %s", table.name, column.name)
+	}
+
+	column.dataType = strings.Replace(strings.Join(typeJoin, " "), " []", "[]", -1)
+	column.autoIncrement = pgSerialTypes[column.dataType]
+	constraints := element[i:]
+	for len(constraints) > 0 {
+		token := constraints[0]
+		constraints = constraints[1:]
+		switch {
+		case token.isWord("constraint"), token.isWord("collate"):
+			constraints = constraints.skip(1)
+		case token.isWord("not"):
+			if constraints.hasPrefix("null") {
+				column.notNull = true
+				constraints = constraints[1:]
+			}
+		case token.isWord("default"):
+			column.hasDefault = true
+			column.defaultNull = len(constraints) > 0 && constraints[0].isWord("null")
+			if len(constraints) > 0 && constraints[0].isWord("nextval") {
+				column.autoIncrement = true
+			}
+
+			// the first token always belongs to the default expression, e.g. DEFAULT NULL
+			constraints = constraints.skip(1)
+			for len(constraints) > 0 && !constraints[0].isColumnConstraint() {
+				if constraints[0].isSymbol("(") {
+					_, constraints = constraints.group()
+					continue
+				}
+				constraints = constraints[1:]
+			}
+		case token.isWord("primary"):
+			table.primary = []string{column.name}
+			column.notNull = true
+			constraints = constraints.skipWords("key")
+		case token.isWord("unique"):
+			table.unique = append(table.unique, pgIndex{
+				name:    fmt.Sprintf("%s_%s_key", table.name, column.name),
+				columns: []string{column.name},
+			})
+		case token.isWord("check"):
+			if len(constraints) > 0 && constraints[0].isSymbol("(") {
+				_, constraints = constraints.group()
+			}
+		case token.isWord("references"):
+			constraints = skipReferences(constraints)
+		case token.isWord("generated"):
+			constraints = constraints.skipWords("always", "by", "default", "as")
+			if constraints.hasPrefix("identity") {
+				column.autoIncrement = true
+				constraints = constraints[1:]
+			}
+
+			if len(constraints) > 0 && constraints[0].isSymbol("(") {
+				_, constraints = constraints.group()
+			}
+		}
+	}
+
+	return column, nil
+}
+
+func skipReferences(tokens pgTokens) pgTokens {
+	_, _, tokens, err := tokens.qualifiedName()
+	if err != nil {
+		return nil
+	}
+
+	if len(tokens) > 0 && tokens[0].isSymbol("(") {
+		_, tokens = tokens.group()
+	}
+
+	for len(tokens) > 0 {
+		switch {
+		case tokens.hasPrefix("match"):
+			tokens = tokens.skip(2)
+		case tokens.hasPrefix("on"):
+			tokens = tokens.skip(2)
+			if tokens.hasPrefix("set") || tokens.hasPrefix("no") {
+				tokens = tokens.skip(2)
+			} else {
+				tokens = tokens.skip(1)
+			}
+		case tokens[0].isWord("deferrable", "initially", "deferred", "immediate"):
+			tokens = tokens[1:]
+		default:
+			return tokens
+		}
+	}
+
+	return tokens
+}
+
+func (t *pgTable) addConstraint(element pgTokens) {
+	var name string
+	if element.hasPrefix("constraint") && len(element) > 1 {
+		name = element[1].name()
+		element = element[2:]
+	}
+
+	switch {
+	case element.hasPrefix("primary", "key"):
+		columns, ok := element[2:].columnNames()
+		if ok {
+			t.primary = columns
+		}
+	case element.hasPrefix("unique"):
+		element = element[1:].skipWords("nulls", "not", "distinct")
+		columns, ok := element.columnNames()
+		if !ok {
+			return
+		}
+
+		if len(name) == 0 {
+			name = fmt.Sprintf("%s_%s_key", t.name, strings.Join(columns, "_"))
+		}
+
+		t.unique = append(t.unique, pgIndex{name: name, columns: columns})
+	}
+}
+
+func (p *pgParser) parseCreateIndex(stmt pgTokens) error {
+	var unique bool
+	if stmt.hasPrefix("unique") {
+		unique = true
+		stmt = stmt[1:]
+	}
+
+	stmt = stmt.skipWords("index", "concurrently", "if", "not", "exists")
+	var name string
+	if len(stmt) > 0 && !stmt[0].isWord("on") {
+		name = stmt[0].name()
+		stmt = stmt[1:]
+	}
+
+	stmt = stmt.skipWords("on", "only")
+	schema, tableName, stmt, err := stmt.qualifiedName()
+	if err != nil {
+		return err
+	}
+
+	table := p.findTable(schema, tableName)
+	if table == nil {
+		p.log.Warning("index %s: table %s not found, ignored", name, tableName)
+		return nil
+	}
+
+	if stmt.hasPrefix("using") {
+		stmt = stmt.skip(2)
+	}
+
+	columns, ok := stmt.columnNames()
+	if !ok {
+		p.log.Warning("table %s: index %s on expressions is not supported, ignored", tableName, name)
+		return nil
+	}
+
+	_, rest := stmt.group()
+	for len(rest) > 0 && !rest[0].isWord("where") {
+		rest = rest[1:]
+	}
+
+	// a partial unique index doesn't make a column unique in the whole table
+	if len(rest) > 0 {
+		unique = false
+	}
+
+	if len(name) == 0 {
+		suffix := "idx"
+		if unique {
+			suffix = "key"
+		}
+		name = fmt.Sprintf("%s_%s_%s", tableName, strings.Join(columns, "_"), suffix)
+	}
+
+	if unique {
+		table.unique = append(table.unique, pgIndex{name: name, columns: columns})
+	} else {
+		table.normal = append(table.normal, pgIndex{name: name, columns: columns})
+	}
+
+	return nil
+}
+
+func (p *pgParser) parseAlterTable(stmt pgTokens) error {
+	stmt = stmt.skipWords("if", "exists", "only")
+	schema, tableName, stmt, err := stmt.qualifiedName()
+	if err != nil {
+		return err
+	}
+
+	table := p.findTable(schema, tableName)
+	if table == nil {
+		return nil
+	}
+
+	for _, action := range stmt.split(",") {
+		if !action.hasPrefix("add") {
+			continue
+		}
+
+		action = action[1:]
+		if action.hasPrefix("constraint") || action.hasPrefix("primary", "key") || action.hasPrefix("unique") {
+			table.addConstraint(action)
+		}
+	}
+
+	return nil
+}
+
+func (p *pgParser) parseComment(stmt pgTokens) error {
+	var names []string
+	for len(stmt) > 0 && stmt[0].isName() && !stmt[0].isWord("is") {
+		names = append(names, stmt[0].name())
+		stmt = stmt[1:]
+		if len(stmt) > 0 && stmt[0].isSymbol(".") {
+			stmt = stmt[1:]
+		}
+	}
+
+	if len(names) < 2 || !stmt.hasPrefix("is") || len(stmt) < 2 || stmt[1].kind != pgTokenString {
+		return nil
+	}
+
+	var schema string
+	if len(names) > 2 {
+		schema = names[len(names)-3]
+	}
+
+	table := p.findTable(schema, names[len(names)-2])
+	if table == nil {
+		return nil
+	}
+
+	for _, column := range table.columns {
+		if column.name == names[len(names)-1] {
+			column.comment = stmt[1].text
+		}
+	}
+
+	return nil
+}
+
+func (p *pgParser) findTable(schema, name string) *pgTable {
+	for _, each := range p.tables {
+		if each.name != name {
+			continue
+		}
+
+		if len(schema) == 0 || len(each.schema) == 0 || each.schema == schema {
+			return each
+		}
+	}
+
+	return nil
+}
+
+func (p *pgParser) convert(in *pgTable) (*Table, error) {
+	if len(in.primary) == 0 {
+		return nil, fmt.Errorf("table %s: missing primary key", in.name)
+	}
+
+	if len(in.primary) > 1 {
+		return nil, errPrimaryKey
+	}
+
+	primaryColumn := in.primary[0]
+
+	var table Table
+	table.Name = stringx.From(in.name)
+	table.Db = stringx.From(in.schema)
+	table.UniqueIndex = make(map[string][]*Field)
+	table.NormalIndex = make(map[string][]*Field)
+	fieldM := make(map[string]*Field)
+	for index, column := range in.columns {
+		isPrimary := column.name == primaryColumn
+		isDefaultNull := !column.notNull && !isPrimary && (!column.hasDefault || column.defaultNull)
+		dataType, err := converter.ConvertPostgreSqlDataType(column.dataType, isDefaultNull)
+		if err != nil {
+			return nil, fmt.Errorf("table %s: %v", in.name, err)
+		}
+
+		field := &Field{
+			Name:            stringx.From(column.name),
+			DataBaseType:    column.dataType,
+			DataType:        dataType,
+			Comment:         column.comment,
+			OrdinalPosition: index + 1,
+		}
+		if isPrimary {
+			table.PrimaryKey = Primary{
+				Field:         *field,
+				AutoIncrement: column.autoIncrement,
+			}
+		}
+
+		fieldM[column.name] = field
+		table.Fields = append(table.Fields, field)
+	}
+
+	if fieldM[primaryColumn] == nil {
+		return nil, fmt.Errorf("table %s: primary key %s not found", in.name, primaryColumn)
+	}
+
+	uniqueSet := collection.NewSet()
+	uniqueSet.AddStr(primaryColumn)
+	for _, index := range in.unique {
+		fields, err := p.indexFields(in, index, fieldM, uniqueSet)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(fields) > 0 {
+			table.UniqueIndex[index.name] = fields
+		}
+	}
+
+	normalSet := collection.NewSet()
+	for _, index := range in.normal {
+		fields, err := p.indexFields(in, index, fieldM, normalSet)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(fields) > 0 {
+			table.NormalIndex[index.name] = fields
+		}
+	}
+
+	return &table, nil
+}
+
+func (p *pgParser) indexFields(table *pgTable, index pgIndex, fieldM map[string]*Field, set *collection.Set) ([]*Field, error) {
+	var fields []*Field
+	var names []string
+	for _, each := range index.columns {
+		if isCreateTimeOrUpdateTime(each) {
+			break
+		}
+
+		field, ok := fieldM[each]
+		if !ok {
+			return nil, fmt.Errorf("table %s: column %s of index %s not found", table.name, each, index.name)
+		}
+
+		fields = append(fields, field)
+		names = append(names, each)
+	}
+
+	joinRet := strings.Join(names, ",")
+	if set.Contains(joinRet) {
+		p.log.Warning("table %s: duplicate index %s", table.name, joinRet)
+		return nil, nil
+	}
+
+	set.AddStr(joinRet)
+	return fields, nil
+}
+
+func pgLex(source string) (pgTokens, error) {
+	var tokens pgTokens
+	runes := []rune(source)
+	for i := 0; i < len(runes); {
+		ch := runes[i]
+		switch {
+		case ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r':
+			i++
+		case ch == '-' && i+1 < len(runes) && runes[i+1] == '-':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+		case ch == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			end := strings.Index(string(runes[i+2:]), "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated comment")
+			}
+			i += 2 + len([]rune(string(runes[i+2:])[:end])) + 2
+		case ch == '"' || ch == '\'':
+			text, n, err := pgQuoted(runes[i:], ch)
+			if err != nil {
+				return nil, err
+			}
+
+			kind := pgTokenString
+			if ch == '"' {
+				kind = pgTokenIdent
+			}
+			tokens = append(tokens, pgToken{kind: kind, text: text})
+			i += n
+		case ch == '$' && i+1 < len(runes) && (runes[i+1] == '$' || isPgWordRune(runes[i+1])):
+			j := i + 1
+			for j < len(runes) && runes[j] != '$' && isPgWordRune(runes[j]) {
+				j++
+			}
+
+			if j >= len(runes) || runes[j] != '$' {
+				// positional parameters such as $1
+				tokens = append(tokens, pgToken{kind: pgTokenWord, text: string(runes[i:j])})
+				i = j
+				continue
+			}
+
+			tag := string(runes[i : j+1])
+			rest := string(runes[j+1:])
+			end := strings.Index(rest, tag)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated dollar-quoted string %s", tag)
+			}
+
+			tokens = append(tokens, pgToken{kind: pgTokenString, text: rest[:end]})
+			i = j + 1 + len([]rune(rest[:end])) + len([]rune(tag))
+		case isPgWordRune(ch):
+			j := i
+			for j < len(runes) && (isPgWordRune(runes[j]) || runes[j] == '$') {
+				j++
+			}
+
+			word := string(runes[i:j])
+			// escape string constants, e.g. E'it''s'
+			if strings.EqualFold(word, "e") && j < len(runes) && runes[j] == '\'' {
+				text, n, err := pgQuoted(runes[j:], '\'')
+				if err != nil {
+					return nil, err
+				}
+
+				tokens = append(tokens, pgToken{kind: pgTokenString, text: text})
+				i = j + n
+				continue
+			}
+
+			tokens = append(tokens, pgToken{kind: pgTokenWord, text: word})
+			i = j
+		case ch == ':' && i+1 < len(runes) && runes[i+1] == ':':
+			tokens = append(tokens, pgToken{kind: pgTokenSymbol, text: "::"})
+			i += 2
+		default:
+			tokens = append(tokens, pgToken{kind: pgTokenSymbol, text: string(ch)})
+			i++
+		}
+	}
+
+	return tokens, nil
+}
+
+func pgQuoted(runes []rune, quote rune) (string, int, error) {
+	var builder strings.Builder
+	for i := 1; i < len(runes); i++ {
+		if runes[i] != quote {
+			builder.WriteRune(runes[i])
+			continue
+		}
+
+		if i+1 < len(runes) && runes[i+1] == quote {
+			builder.WriteRune(quote)
+			i++
+			continue
+		}
+
+		return builder.String(), i + 1, nil
+	}
+
+	return "", 0, fmt.Errorf("unterminated quoted string: %s", string(runes))
+}
+
+func isPgWordRune(r rune) bool {
+	return r == '_' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r > 127
+}
+
+func (t pgToken) isWord(words ...string) bool {
+	if t.kind != pgTokenWord {
+		return false
+	}
+
+	for _, each := range words {
+		if strings.EqualFold(t.text, each) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (t pgToken) isSymbol(symbol string) bool {
+	return t.kind == pgTokenSymbol && t.text == symbol
+}
+
+func (t pgToken) isName() bool {
+	return t.kind == pgTokenWord || t.kind == pgTokenIdent
+}
+
+func (t pgToken) isColumnConstraint() bool {
+	return t.kind == pgTokenWord && pgColumnConstraints[strings.ToLower(t.text)]
+}
+
+// name returns the identifier, the unquoted identifiers are folded to lower case as postgresql does
+func (t pgToken) name() string {
+	if t.kind == pgTokenIdent {
+		return t.text
+	}
+
+	return strings.ToLower(t.text)
+}
+
+func (ts pgTokens) hasPrefix(words ...string) bool {
+	if len(ts) < len(words) {
+		return false
+	}
+
+	for i, each := range words {
+		if !ts[i].isWord(each) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (ts pgTokens) skip(n int) pgTokens {
+	if n > len(ts) {
+		return nil
+	}
+
+	return ts[n:]
+}
+
+func (ts pgTokens) skipWords(words ...string) pgTokens {
+	for len(ts) > 0 && ts[0].isWord(words...) {
+		ts = ts[1:]
+	}
+
+	return ts
+}
+
+// split splits tokens by the symbol which is not in parentheses
+func (ts pgTokens) split(symbol string) []pgTokens {
+	var list []pgTokens
+	var depth, start int
+	for i, each := range ts {
+		switch {
+		case each.isSymbol("("):
+			depth++
+		case each.isSymbol(")"):
+			depth--
+		case each.isSymbol(symbol) && depth == 0:
+			list = append(list, ts[start:i])
+			start = i + 1
+		}
+	}
+
+	if start < len(ts) {
+		list = append(list, ts[start:])
+	}
+
+	return list
+}
+
+// group returns the tokens in the leading parentheses and the rest tokens after them
+func (ts pgTokens) group() (pgTokens, pgTokens) {
+	var depth int
+	for i, each := range ts {
+		switch {
+		case each.isSymbol("("):
+			depth++
+		case each.isSymbol(")"):
+			depth--
+			if depth == 0 {
+				return ts[1:i], ts[i+1:]
+			}
+		}
+	}
+
+	return ts.skip(1), nil
+}
+
+// qualifiedName reads the name likes schema.table or table
+func (ts pgTokens) qualifiedName() (string, string, pgTokens, error) {
+	if len(ts) == 0 || !ts[0].isName() {
+		return "", "", nil, errTableBodyNotFound
+	}
+
+	if len(ts) > 2 && ts[1].isSymbol(".") && ts[2].isName() {
+		return ts[0].name(), ts[2].name(), ts[3:], nil
+	}
+
+	return "", ts[0].name(), ts[1:], nil
+}
+
+// columnNames reads the column list in parentheses, returns false if it contains expressions
+func (ts pgTokens) columnNames() ([]string, bool) {
+	if len(ts) == 0 || !ts[0].isSymbol("(") {
+		return nil, false
+	}
+
+	body, _ := ts.group()
+	var columns []string
+	for _, each := range body.split(",") {
+		if len(each) == 0 || !each[0].isName() {
+			return nil, false
+		}
+
+		// the rest are sort orders or operator classes
+		for _, token := range each[1:] {
+			if !token.isName() {
+				return nil, false
+			}
+		}
+
+		columns = append(columns, each[0].name())
+	}
+
+	return columns, len(columns) > 0
+}
@@ -0,0 +1,123 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const postgreSqlDDL = `
+-- users of the platform
+CREATE TABLE IF NOT EXISTS public.test_user (
+    id bigserial PRIMARY KEY,
+    uid uuid NOT NULL DEFAULT gen_random_uuid(),
+    mobile character varying(255) NOT NULL,
+    class bigint NOT NULL,
+    name varchar(255) NOT NULL DEFAULT ''::character varying,
+    nickname text,
+    tags text[] NOT NULL DEFAULT '{}',
+    scores integer ARRAY,
+    profile jsonb DEFAULT NULL,
+    enabled boolean NOT NULL DEFAULT true,
+    amount numeric(10, 2) NOT NULL CHECK (amount >= 0),
+    group_id int4 REFERENCES public.test_group (id) ON DELETE SET NULL,
+    create_time timestamp(6) with time zone NOT NULL DEFAULT now(),
+    update_time timestamptz,
+    CONSTRAINT mobile_unique UNIQUE (mobile),
+    UNIQUE (class, name)
+);
+
+CREATE INDEX name_index ON public.test_user USING btree (name);
+CREATE UNIQUE INDEX uid_unique ON test_user (uid);
+CREATE INDEX create_index ON test_user (create_time);
+CREATE INDEX lower_name_index ON test_user (lower(name));
+COMMENT ON COLUMN public.test_user.mobile IS 'the mobile of user';
+
+CREATE TABLE "Order" (
+    "ID" integer GENERATED BY DEFAULT AS IDENTITY,
+    user_id bigint NOT NULL
+);
+ALTER TABLE ONLY "Order" ADD CONSTRAINT order_pkey PRIMARY KEY ("ID");
+
+CREATE FUNCTION touch() RETURNS trigger AS $$
+BEGIN
+    NEW.update_time = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+`
+
+func TestParsePostgreSql(t *testing.T) {
+	tables, err := ParsePostgreSql(postgreSqlDDL)
+	assert.Nil(t, err)
+	assert.Len(t, tables, 2)
+
+	user := tables[0]
+	assert.Equal(t, "test_user", user.Name.Source())
+	assert.Equal(t, "public", user.Db.Source())
+	assert.Equal(t, "id", user.PrimaryKey.Name.Source())
+	assert.Equal(t, "int64", user.PrimaryKey.DataType)
+	assert.True(t, user.PrimaryKey.AutoIncrement)
+	assert.True(t, user.ContainsTime())
+	assert.True(t, user.ContainsPQ())
+
+	dataTypes := make(map[string]string)
+	comments := make(map[string]string)
+	for _, each := range user.Fields {
+		dataTypes[each.Name.Source()] = each.DataType
+		comments[each.Name.Source()] = each.Comment
+	}
+	assert.Equal(t, map[string]string{
+		"id":          "int64",
+		"uid":         "string",
+		"mobile":      "string",
+		"class":       "int64",
+		"name":        "string",
+		"nickname":    "sql.NullString",
+		"tags":        "pq.StringArray",
+		"scores":      "pq.Int64Array",
+		"profile":     "sql.NullString",
+		"enabled":     "bool",
+		"amount":      "float64",
+		"group_id":    "sql.NullInt64",
+		"create_time": "time.Time",
+		"update_time": "sql.NullTime",
+	}, dataTypes)
+	assert.Equal(t, "the mobile of user", comments["mobile"])
+	assert.Equal(t, "id", user.Fields[0].Name.Source())
+	assert.Equal(t, "update_time", user.Fields[len(user.Fields)-1].Name.Source())
+
+	assert.Len(t, user.UniqueIndex, 3)
+	assert.Len(t, user.UniqueIndex["mobile_unique"], 1)
+	assert.Len(t, user.UniqueIndex["uid_unique"], 1)
+	className := user.UniqueIndex["test_user_class_name_key"]
+	assert.Len(t, className, 2)
+	assert.Equal(t, "class", className[0].Name.Source())
+	assert.Equal(t, "name", className[1].Name.Source())
+
+	assert.Len(t, user.NormalIndex, 1)
+	assert.Len(t, user.NormalIndex["name_index"], 1)
+
+	order := tables[1]
+	assert.Equal(t, "Order", order.Name.Source())
+	assert.Equal(t, "", order.Db.Source())
+	assert.Equal(t, "ID", order.PrimaryKey.Name.Source())
+	assert.True(t, order.PrimaryKey.AutoIncrement)
+}
+
+func TestParsePostgreSqlError(t *testing.T) {
+	_, err := ParsePostgreSql("select * from user")
+	assert.Equal(t, errTableBodyNotFound, err)
+
+	_, err = ParsePostgreSql("create table a (id int, name text, primary key (id, name))")
+	assert.Equal(t, errPrimaryKey, err)
+
+	_, err = ParsePostgreSql("create table a (id int, name text unique)")
+	assert.EqualError(t, err, "table a: missing primary key")
+
+	_, err = ParsePostgreSql("create table a (id int primary key, location point)")
+	assert.NotNil(t, err)
+
+	_, err = ParsePostgreSql("create table a (id int primary key, name text default 'abc)")
+	assert.NotNil(t, err)
+}
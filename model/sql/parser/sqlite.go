@@ -0,0 +1,48 @@
+package parser
+
+import "github.com/zeromicro/goctl/model/sql/model"
+
+// ParseSqlite parses sqlite ddl into golang structures, the ddl is executed in a temporary
+// in-memory database, so that the statements are parsed by sqlite itself
+func ParseSqlite(ddl string) ([]*Table, error) {
+	im, err := model.OpenSqliteMemory()
+	if err != nil {
+		return nil, err
+	}
+	defer im.Close()
+
+	if err := im.Exec(ddl); err != nil {
+		return nil, err
+	}
+
+	tableNames, err := im.GetAllTables()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tableNames) == 0 {
+		return nil, errTableBodyNotFound
+	}
+
+	var tables []*Table
+	for _, name := range tableNames {
+		columnData, err := im.FindColumns(model.SqliteMainSchema, name)
+		if err != nil {
+			return nil, err
+		}
+
+		table, err := columnData.Convert()
+		if err != nil {
+			return nil, err
+		}
+
+		ret, err := ConvertSqliteDataType(table)
+		if err != nil {
+			return nil, err
+		}
+
+		tables = append(tables, ret)
+	}
+
+	return tables, nil
+}
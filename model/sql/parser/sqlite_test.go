@@ -0,0 +1,77 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSqlite(t *testing.T) {
+	tables, err := ParseSqlite(`
+CREATE TABLE test_user (
+    id INTEGER PRIMARY KEY,
+    mobile VARCHAR(255) NOT NULL,
+    class BIGINT NOT NULL,
+    name TEXT NOT NULL DEFAULT '',
+    nickname TEXT,
+    score REAL,
+    enabled BOOLEAN NOT NULL DEFAULT 1,
+    create_time DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (class, name)
+);
+CREATE UNIQUE INDEX mobile_unique ON test_user (mobile);
+CREATE INDEX name_index ON test_user (name);
+CREATE INDEX lower_name_index ON test_user (lower(name));
+
+CREATE TABLE test_tag (
+    name TEXT PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES test_user (id)
+);`)
+	assert.Nil(t, err)
+	assert.Len(t, tables, 2)
+
+	user := tables[0]
+	assert.Equal(t, "test_user", user.Name.Source())
+	assert.Equal(t, "id", user.PrimaryKey.Name.Source())
+	assert.Equal(t, "int64", user.PrimaryKey.DataType)
+	assert.True(t, user.PrimaryKey.AutoIncrement)
+	assert.True(t, user.ContainsTime())
+
+	dataTypes := make(map[string]string)
+	for _, each := range user.Fields {
+		dataTypes[each.Name.Source()] = each.DataType
+	}
+	assert.Equal(t, map[string]string{
+		"id":          "int64",
+		"mobile":      "string",
+		"class":       "int64",
+		"name":        "string",
+		"nickname":    "sql.NullString",
+		"score":       "sql.NullFloat64",
+		"enabled":     "bool",
+		"create_time": "time.Time",
+	}, dataTypes)
+	assert.Equal(t, "id", user.Fields[0].Name.Source())
+
+	assert.Len(t, user.UniqueIndex, 2)
+	assert.Len(t, user.UniqueIndex["mobile_unique"], 1)
+	assert.Len(t, user.UniqueIndex["sqlite_autoindex_test_user_1"], 2)
+	assert.Len(t, user.NormalIndex, 1)
+	assert.Len(t, user.NormalIndex["name_index"], 1)
+
+	tag := tables[1]
+	assert.Equal(t, "name", tag.PrimaryKey.Name.Source())
+	assert.False(t, tag.PrimaryKey.AutoIncrement)
+	assert.Len(t, tag.UniqueIndex, 0)
+}
+
+func TestParseSqliteError(t *testing.T) {
+	_, err := ParseSqlite("select 1")
+	assert.Equal(t, errTableBodyNotFound, err)
+
+	_, err = ParseSqlite("create table a (id int, name text, primary key (id, name))")
+	assert.NotNil(t, err)
+
+	_, err = ParseSqlite("create table a (")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,134 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/tal-tech/go-zero/core/stores/sqlx"
+)
+
+// DriverName is the name of the postgresql driver which accepts the ? placeholders
+const DriverName = "goctl-postgres"
+
+func init() {
+	sql.Register(DriverName, Wrap(pq.Driver{}))
+}
+
+// New returns a postgresql connection for the generated models, the ? placeholders which
+// sqlx requires are rewritten into the $n placeholders of postgresql before sent to the server
+func New(datasource string, opts ...sqlx.SqlOption) sqlx.SqlConn {
+	return sqlx.NewSqlConn(DriverName, datasource, opts...)
+}
+
+// Wrap wraps the driver d to rewrite the ? placeholders of the statements into $n
+func Wrap(d driver.Driver) driver.Driver {
+	return rebindDriver{Driver: d}
+}
+
+// Rebind rewrites the ? placeholders of query into $1, $2 ..., the question marks in
+// the quoted strings, the quoted identifiers and the comments are kept
+func Rebind(query string) string {
+	if !strings.Contains(query, "?") {
+		return query
+	}
+
+	var builder strings.Builder
+	var n int
+	for i := 0; i < len(query); i++ {
+		ch := query[i]
+		switch {
+		case ch == '\'' || ch == '"':
+			end := strings.IndexByte(query[i+1:], ch)
+			if end < 0 {
+				builder.WriteString(query[i:])
+				return builder.String()
+			}
+
+			builder.WriteString(query[i : i+end+2])
+			i += end + 1
+		case ch == '-' && strings.HasPrefix(query[i:], "--"):
+			end := strings.IndexByte(query[i:], '\n')
+			if end < 0 {
+				builder.WriteString(query[i:])
+				return builder.String()
+			}
+
+			builder.WriteString(query[i : i+end+1])
+			i += end
+		case ch == '?':
+			n++
+			builder.WriteString("$" + strconv.Itoa(n))
+		default:
+			builder.WriteByte(ch)
+		}
+	}
+
+	return builder.String()
+}
+
+type (
+	rebindDriver struct {
+		driver.Driver
+	}
+
+	rebindConn struct {
+		driver.Conn
+	}
+)
+
+func (d rebindDriver) Open(name string) (driver.Conn, error) {
+	conn, err := d.Driver.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return rebindConn{Conn: conn}, nil
+}
+
+func (c rebindConn) Prepare(query string) (driver.Stmt, error) {
+	return c.Conn.Prepare(Rebind(query))
+}
+
+func (c rebindConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	if conn, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		return conn.PrepareContext(ctx, Rebind(query))
+	}
+
+	return c.Prepare(query)
+}
+
+func (c rebindConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if conn, ok := c.Conn.(driver.ConnBeginTx); ok {
+		return conn.BeginTx(ctx, opts)
+	}
+
+	return c.Conn.Begin()
+}
+
+func (c rebindConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if conn, ok := c.Conn.(driver.ExecerContext); ok {
+		return conn.ExecContext(ctx, Rebind(query), args)
+	}
+
+	return nil, driver.ErrSkip
+}
+
+func (c rebindConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if conn, ok := c.Conn.(driver.QueryerContext); ok {
+		return conn.QueryContext(ctx, Rebind(query), args)
+	}
+
+	return nil, driver.ErrSkip
+}
+
+func (c rebindConn) Ping(ctx context.Context) error {
+	if conn, ok := c.Conn.(driver.Pinger); ok {
+		return conn.Ping(ctx)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRebind(t *testing.T) {
+	cases := []struct {
+		query  string
+		expect string
+	}{
+		{
+			query:  `select * from "user"`,
+			expect: `select * from "user"`,
+		},
+		{
+			query:  `insert into "user" ("name","age") values (?, ?), (?, ?)`,
+			expect: `insert into "user" ("name","age") values ($1, $2), ($3, $4)`,
+		},
+		{
+			query:  `update "user" set "name"=?,"age"=? where "id" = ?`,
+			expect: `update "user" set "name"=$1,"age"=$2 where "id" = $3`,
+		},
+		{
+			query:  `select * from "user?" where "name" = '?' and "age" = ?`,
+			expect: `select * from "user?" where "name" = '?' and "age" = $1`,
+		},
+		{
+			query:  "select * from \"user\" -- where ?\nwhere \"id\" = ?",
+			expect: "select * from \"user\" -- where ?\nwhere \"id\" = $1",
+		},
+		{
+			query:  `select '?`,
+			expect: `select '?`,
+		},
+	}
+
+	for _, each := range cases {
+		t.Run(each.query, func(t *testing.T) {
+			assert.Equal(t, each.expect, Rebind(each.query))
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	assert.NotNil(t, New("postgres://localhost/test"))
+}
@@ -1,22 +1,24 @@
 package template
 
-// Delete defines a delete template
+// Delete defines a delete template, the row is marked deleted by softDeleteColumn if it's not empty
 var Delete = `
-func (m *default{{.upperStartCamelObject}}Model) Delete({{.lowerStartCamelPrimaryKey}} {{.dataType}}) error {
-	{{if .withCache}}{{if .containsIndexCache}}data, err:=m.FindOne({{.lowerStartCamelPrimaryKey}})
+func (m *default{{.upperStartCamelObject}}Model) Delete({{if .withCtx}}ctx context.Context, {{end}}{{.lowerStartCamelPrimaryKey}} {{.dataType}}) error {
+	{{if .withCache}}{{if .containsIndexCache}}data, err:=m.FindOne({{if .withCtx}}ctx, {{end}}{{.lowerStartCamelPrimaryKey}})
 	if err!=nil{
 		return err
 	}{{end}}
 
 	{{.keys}}
-    _, err {{if .containsIndexCache}}={{else}}:={{end}} m.Exec(func(conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("delete from %s where {{.originalPrimaryKey}} = ?", m.table)
-		return conn.Exec(query, {{.lowerStartCamelPrimaryKey}})
-	}, {{.keyValues}}){{else}}query := fmt.Sprintf("delete from %s where {{.originalPrimaryKey}} = ?", m.table)
-		_,err:=m.conn.Exec(query, {{.lowerStartCamelPrimaryKey}}){{end}}
+    _, err {{if .containsIndexCache}}={{else}}:={{end}} m.{{if .withCtx}}execCtx(ctx, func(conn sqlx.Session){{else}}Exec(func(conn sqlx.SqlConn){{end}} (result sql.Result, err error) {
+		{{if .softDeleteColumn}}query := fmt.Sprintf("update %s set {{.softDeleteColumn}} = ? where {{.originalPrimaryKey}} = ? and {{.softDelete}}", m.table)
+		return conn.Exec(query, {{.softDeleteValue}}, {{.lowerStartCamelPrimaryKey}}){{else}}query := fmt.Sprintf("delete from %s where {{.originalPrimaryKey}} = ?", m.table)
+		return conn.Exec(query, {{.lowerStartCamelPrimaryKey}}){{end}}
+	}, {{.keyValues}}){{else}}{{if .softDeleteColumn}}query := fmt.Sprintf("update %s set {{.softDeleteColumn}} = ? where {{.originalPrimaryKey}} = ? and {{.softDelete}}", m.table)
+		_,err:=m.conn.Exec(query, {{.softDeleteValue}}, {{.lowerStartCamelPrimaryKey}}){{else}}query := fmt.Sprintf("delete from %s where {{.originalPrimaryKey}} = ?", m.table)
+		_,err:=m.conn.Exec(query, {{.lowerStartCamelPrimaryKey}}){{end}}{{end}}
 	return err
 }
 `
 
 // DeleteMethod defines a delete template for interface method
-var DeleteMethod = `Delete({{.lowerStartCamelPrimaryKey}} {{.dataType}}) error`
+var DeleteMethod = `Delete({{if .withCtx}}ctx context.Context, {{end}}{{.lowerStartCamelPrimaryKey}} {{.dataType}}) error`
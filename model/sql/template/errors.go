@@ -3,7 +3,18 @@ package template
 // Error defines an error template
 var Error = `package {{.pkg}}
 
-import "github.com/tal-tech/go-zero/core/stores/sqlx"
+import (
+	"errors"
+
+	"github.com/tal-tech/go-zero/core/stores/sqlx"
+)
 
 var ErrNotFound = sqlx.ErrNotFound
+
+// ErrVersionConflict is returned by Update if the row is changed by others since it's read
+var ErrVersionConflict = errors.New("version conflict")
+
+// ErrNotInTransactCtx is returned by the cached models bound to a session by WithSession if the changes
+// are not made with the ctx of TransactCtx, because the caches must be deleted after the commit
+var ErrNotInTransactCtx = errors.New("model with session must be called with the ctx of TransactCtx")
 `
@@ -2,11 +2,11 @@ package template
 
 // FindOne defines find row by id.
 var FindOne = `
-func (m *default{{.upperStartCamelObject}}Model) FindOne({{.lowerStartCamelPrimaryKey}} {{.dataType}}) (*{{.upperStartCamelObject}}, error) {
+func (m *default{{.upperStartCamelObject}}Model) FindOne({{if .withCtx}}ctx context.Context, {{end}}{{.lowerStartCamelPrimaryKey}} {{.dataType}}) (*{{.upperStartCamelObject}}, error) {
 	{{if .withCache}}{{.cacheKey}}
 	var resp {{.upperStartCamelObject}}
-	err := m.QueryRow(&resp, {{.cacheKeyVariable}}, func(conn sqlx.SqlConn, v interface{}) error {
-		query :=  fmt.Sprintf("select %s from %s where {{.originalPrimaryKey}} = ? limit 1", {{.lowerStartCamelObject}}Rows, m.table)
+	err := m.{{if .withCtx}}queryRowSession(&resp, {{.cacheKeyVariable}}, func(conn sqlx.Session{{else}}QueryRow(&resp, {{.cacheKeyVariable}}, func(conn sqlx.SqlConn{{end}}, v interface{}) error {
+		query :=  fmt.Sprintf("select %s from %s where {{.originalPrimaryKey}} = ?{{if .softDelete}} and {{.softDelete}}{{end}} limit 1", {{.lowerStartCamelObject}}Rows, m.table)
 		return conn.QueryRow(v, query, {{.lowerStartCamelPrimaryKey}})
 	})
 	switch err {
@@ -16,7 +16,7 @@ func (m *default{{.upperStartCamelObject}}Model) FindOne({{.lowerStartCamelPrima
 		return nil, ErrNotFound
 	default:
 		return nil, err
-	}{{else}}query := fmt.Sprintf("select %s from %s where {{.originalPrimaryKey}} = ? limit 1", {{.lowerStartCamelObject}}Rows, m.table)
+	}{{else}}query := fmt.Sprintf("select %s from %s where {{.originalPrimaryKey}} = ?{{if .softDelete}} and {{.softDelete}}{{end}} limit 1", {{.lowerStartCamelObject}}Rows, m.table)
 	var resp {{.upperStartCamelObject}}
 	err := m.conn.QueryRow(&resp, query, {{.lowerStartCamelPrimaryKey}})
 	switch err {
@@ -32,10 +32,10 @@ func (m *default{{.upperStartCamelObject}}Model) FindOne({{.lowerStartCamelPrima
 
 // FindOneByField defines find row by field.
 var FindOneByField = `
-func (m *default{{.upperStartCamelObject}}Model) FindOneBy{{.upperField}}({{.in}}) (*{{.upperStartCamelObject}}, error) {
+func (m *default{{.upperStartCamelObject}}Model) FindOneBy{{.upperField}}({{if .withCtx}}ctx context.Context, {{end}}{{.in}}) (*{{.upperStartCamelObject}}, error) {
 	{{if .withCache}}{{.cacheKey}}
 	var resp {{.upperStartCamelObject}}
-	err := m.QueryRowIndex(&resp, {{.cacheKeyVariable}}, m.formatPrimary, func(conn sqlx.SqlConn, v interface{}) (i interface{}, e error) {
+	err := m.{{if .withCtx}}queryRowIndexSession(&resp, {{.cacheKeyVariable}}, m.formatPrimary, func(conn sqlx.Session{{else}}QueryRowIndex(&resp, {{.cacheKeyVariable}}, m.formatPrimary, func(conn sqlx.SqlConn{{end}}, v interface{}) (i interface{}, e error) {
 		query := fmt.Sprintf("select %s from %s where {{.originalField}} limit 1", {{.lowerStartCamelObject}}Rows, m.table)
 		if err := conn.QueryRow(&resp, query, {{.lowerStartCamelField}}); err != nil {
 			return nil, err
@@ -71,13 +71,100 @@ func (m *default{{.upperStartCamelObject}}Model) formatPrimary(primary interface
 }
 
 func (m *default{{.upperStartCamelObject}}Model) queryPrimary(conn sqlx.SqlConn, v, primary interface{}) error {
-	query := fmt.Sprintf("select %s from %s where {{.originalPrimaryField}} = ? limit 1", {{.lowerStartCamelObject}}Rows, m.table )
+	query := fmt.Sprintf("select %s from %s where {{.originalPrimaryField}} = ?{{if .softDelete}} and {{.softDelete}}{{end}} limit 1", {{.lowerStartCamelObject}}Rows, m.table )
 	return conn.QueryRow(v, query, primary)
 }
 `
 
 // FindOneMethod defines find row method.
-var FindOneMethod = `FindOne({{.lowerStartCamelPrimaryKey}} {{.dataType}}) (*{{.upperStartCamelObject}}, error)`
+var FindOneMethod = `FindOne({{if .withCtx}}ctx context.Context, {{end}}{{.lowerStartCamelPrimaryKey}} {{.dataType}}) (*{{.upperStartCamelObject}}, error)`
 
 // FindOneByFieldMethod defines find row by field method.
-var FindOneByFieldMethod = `FindOneBy{{.upperField}}({{.in}}) (*{{.upperStartCamelObject}}, error) `
+var FindOneByFieldMethod = `FindOneBy{{.upperField}}({{if .withCtx}}ctx context.Context, {{end}}{{.in}}) (*{{.upperStartCamelObject}}, error) `
+
+// FindAllByField defines find rows by the fields of a normal index with limit/offset and keyset pagination.
+var FindAllByField = `
+func (m *default{{.upperStartCamelObject}}Model) FindAllBy{{.upperField}}({{if .withCtx}}ctx context.Context, {{end}}{{.in}}, offset, limit int64) ([]*{{.upperStartCamelObject}}, error) {
+	query := fmt.Sprintf("select %s from %s where {{.originalField}} order by {{.originalPrimaryKey}} limit ? offset ?", {{.lowerStartCamelObject}}Rows, m.table)
+	var resp []*{{.upperStartCamelObject}}
+	err := m.{{if and .withCache (not .withCtx)}}QueryRowsNoCache{{else}}conn.QueryRows{{end}}(&resp, query, {{.lowerStartCamelField}}, limit, offset)
+	return resp, err
+}
+
+func (m *default{{.upperStartCamelObject}}Model) FindAllBy{{.upperField}}After({{if .withCtx}}ctx context.Context, {{end}}{{.in}}, {{.lastPrimaryKey}} {{.dataType}}, limit int64) ([]*{{.upperStartCamelObject}}, error) {
+	query := fmt.Sprintf("select %s from %s where {{.originalField}} and {{.originalPrimaryKey}} > ? order by {{.originalPrimaryKey}} limit ?", {{.lowerStartCamelObject}}Rows, m.table)
+	var resp []*{{.upperStartCamelObject}}
+	err := m.{{if and .withCache (not .withCtx)}}QueryRowsNoCache{{else}}conn.QueryRows{{end}}(&resp, query, {{.lowerStartCamelField}}, {{.lastPrimaryKey}}, limit)
+	return resp, err
+}
+`
+
+// FindByIds defines find rows by the primary keys, the cached rows are taken from the cache
+// and the others are queried in one statement and cached.
+var FindByIds = `
+func (m *default{{.upperStartCamelObject}}Model) FindByIds({{if .withCtx}}ctx context.Context, {{end}}ids []{{.dataType}}) ([]*{{.upperStartCamelObject}}, error) {
+	rows := make(map[{{.dataType}}]*{{.upperStartCamelObject}}, len(ids))
+	var missing []interface{}
+	for _, id := range ids {
+		{{if .withCache}}var item {{.upperStartCamelObject}}
+		if {{if .withCtx}}!m.tx && {{end}}m.GetCache(fmt.Sprintf("%s%v", {{.primaryKeyLeft}}, id), &item) == nil {
+			rows[id] = &item
+			continue
+		}
+
+		{{end}}missing = append(missing, id)
+	}
+
+	if len(missing) > 0 {
+		placeholders := make([]string, len(missing))
+		for i := range placeholders {
+			placeholders[i] = "?"
+		}
+
+		query := fmt.Sprintf("select %s from %s where {{.originalPrimaryKey}} in (%s){{if .softDelete}} and {{.softDelete}}{{end}}", {{.lowerStartCamelObject}}Rows, m.table, strings.Join(placeholders, ","))
+		var resp []*{{.upperStartCamelObject}}
+		if err := m.{{if and .withCache (not .withCtx)}}QueryRowsNoCache{{else}}conn.QueryRows{{end}}(&resp, query, missing...); err != nil {
+			return nil, err
+		}
+
+		for _, item := range resp {
+			rows[item.{{.upperStartCamelPrimaryKey}}] = item
+			{{if .withCache}}// the rows are still returned if failed to cache them
+			{{if .withCtx}}if !m.tx {
+				// the uncommitted rows of the transaction are not cached
+				_ = m.SetCache(fmt.Sprintf("%s%v", {{.primaryKeyLeft}}, item.{{.upperStartCamelPrimaryKey}}), item)
+			}
+		{{else}}_ = m.SetCache(fmt.Sprintf("%s%v", {{.primaryKeyLeft}}, item.{{.upperStartCamelPrimaryKey}}), item)
+		{{end}}{{end}}}
+	}
+
+	list := make([]*{{.upperStartCamelObject}}, 0, len(rows))
+	for _, id := range ids {
+		if item, ok := rows[id]; ok {
+			list = append(list, item)
+		}
+	}
+
+	return list, nil
+}
+`
+
+// Count defines count rows.
+var Count = `
+func (m *default{{.upperStartCamelObject}}Model) Count({{if .withCtx}}ctx context.Context{{end}}) (int64, error) {
+	query := fmt.Sprintf("select count(*) from %s{{if .softDelete}} where {{.softDelete}}{{end}}", m.table)
+	var count int64
+	err := m.{{if and .withCache (not .withCtx)}}QueryRowNoCache{{else}}conn.QueryRow{{end}}(&count, query)
+	return count, err
+}
+`
+
+// FindAllByFieldMethod defines find rows by field method.
+var FindAllByFieldMethod = `FindAllBy{{.upperField}}({{if .withCtx}}ctx context.Context, {{end}}{{.in}}, offset, limit int64) ([]*{{.upperStartCamelObject}}, error)
+FindAllBy{{.upperField}}After({{if .withCtx}}ctx context.Context, {{end}}{{.in}}, {{.lastPrimaryKey}} {{.dataType}}, limit int64) ([]*{{.upperStartCamelObject}}, error)`
+
+// FindByIdsMethod defines find rows by primary keys method.
+var FindByIdsMethod = `FindByIds({{if .withCtx}}ctx context.Context, {{end}}ids []{{.dataType}}) ([]*{{.upperStartCamelObject}}, error)`
+
+// CountMethod defines count rows method.
+var CountMethod = `Count({{if .withCtx}}ctx context.Context{{end}}) (int64, error)`
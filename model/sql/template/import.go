@@ -3,11 +3,14 @@ package template
 var (
 	// Imports defines a import template for model in cache case
 	Imports = `import (
-	"database/sql"
+	{{if .ctx}}"context"
+	{{end}}"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"strings"
 	{{if .time}}"time"{{end}}
 
+	{{if .pq}}"github.com/lib/pq"{{end}}
 	"github.com/tal-tech/go-zero/core/stores/cache"
 	"github.com/tal-tech/go-zero/core/stores/sqlc"
 	"github.com/tal-tech/go-zero/core/stores/sqlx"
@@ -17,11 +20,14 @@ var (
 `
 	// ImportsNoCache defines a import template for model in normal case
 	ImportsNoCache = `import (
-	"database/sql"
+	{{if .ctx}}"context"
+	{{end}}"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"strings"
 	{{if .time}}"time"{{end}}
 
+	{{if .pq}}"github.com/lib/pq"{{end}}
 	"github.com/tal-tech/go-zero/core/stores/sqlc"
 	"github.com/tal-tech/go-zero/core/stores/sqlx"
 	"github.com/tal-tech/go-zero/core/stringx"
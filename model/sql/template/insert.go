@@ -2,13 +2,13 @@ package template
 
 // Insert defines a template for insert code in model
 var Insert = `
-func (m *default{{.upperStartCamelObject}}Model) Insert(data {{.upperStartCamelObject}}) (sql.Result,error) {
+func (m *default{{.upperStartCamelObject}}Model) Insert({{if .withCtx}}ctx context.Context, {{end}}data {{.upperStartCamelObject}}) (sql.Result,error) {
 	{{if .withCache}}{{if .containsIndexCache}}{{.keys}}
-    ret, err := m.Exec(func(conn sqlx.SqlConn) (result sql.Result, err error) {
+    ret, err := m.{{if .withCtx}}execCtx(ctx, func(conn sqlx.Session){{else}}Exec(func(conn sqlx.SqlConn){{end}} (result sql.Result, err error) {
 		query := fmt.Sprintf("insert into %s (%s) values ({{.expression}})", m.table, {{.lowerStartCamelObject}}RowsExpectAutoSet)
 		return conn.Exec(query, {{.expressionValues}})
 	}, {{.keyValues}}){{else}}query := fmt.Sprintf("insert into %s (%s) values ({{.expression}})", m.table, {{.lowerStartCamelObject}}RowsExpectAutoSet)
-    ret,err:=m.ExecNoCache(query, {{.expressionValues}})
+    ret,err:=m.{{if .withCtx}}conn.Exec{{else}}ExecNoCache{{end}}(query, {{.expressionValues}})
 	{{end}}{{else}}query := fmt.Sprintf("insert into %s (%s) values ({{.expression}})", m.table, {{.lowerStartCamelObject}}RowsExpectAutoSet)
     ret,err:=m.conn.Exec(query, {{.expressionValues}}){{end}}
 	return ret,err
@@ -16,4 +16,30 @@ func (m *default{{.upperStartCamelObject}}Model) Insert(data {{.upperStartCamelO
 `
 
 // InsertMethod defines a interface method template for insert code in model
-var InsertMethod = `Insert(data {{.upperStartCamelObject}}) (sql.Result,error)`
+var InsertMethod = `Insert({{if .withCtx}}ctx context.Context, {{end}}data {{.upperStartCamelObject}}) (sql.Result,error)`
+
+// BatchInsert defines a template for inserting rows in one statement in model
+var BatchInsert = `
+func (m *default{{.upperStartCamelObject}}Model) BatchInsert({{if .withCtx}}ctx context.Context, {{end}}data []{{.upperStartCamelObject}}) (sql.Result, error) {
+	if len(data) == 0 {
+		return driver.RowsAffected(0), nil
+	}
+
+	values := make([]string, 0, len(data))
+	args := make([]interface{}, 0, len(data)*{{.fieldCount}})
+	{{if .withCache}}{{if .containsIndexCache}}keys := make([]string, 0, len(data)*{{.keyCount}})
+	{{end}}{{end}}for _, item := range data {
+		values = append(values, "({{.expression}})")
+		args = append(args, {{.expressionValues}})
+		{{if .withCache}}{{if .containsIndexCache}}keys = append(keys, {{.keyValues}})
+	{{end}}{{end}}}
+
+	query := fmt.Sprintf("insert into %s (%s) values %s", m.table, {{.lowerStartCamelObject}}RowsExpectAutoSet, strings.Join(values, ", "))
+	{{if .withCache}}{{if .containsIndexCache}}return m.{{if .withCtx}}execCtx(ctx, func(conn sqlx.Session){{else}}Exec(func(conn sqlx.SqlConn){{end}} (sql.Result, error) {
+		return conn.Exec(query, args...)
+	}, keys...){{else}}return m.{{if .withCtx}}conn.Exec{{else}}ExecNoCache{{end}}(query, args...){{end}}{{else}}return m.conn.Exec(query, args...){{end}}
+}
+`
+
+// BatchInsertMethod defines a interface method template for batch insert code in model
+var BatchInsertMethod = `BatchInsert({{if .withCtx}}ctx context.Context, {{end}}data []{{.upperStartCamelObject}}) (sql.Result, error)`
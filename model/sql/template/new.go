@@ -1,10 +1,13 @@
 package template
 
 // New defines an template for creating model instance
-var New = `
+var New = `{{if .postgreSql}}
+// New{{.upperStartCamelObject}}Model creates a model for postgresql, conn must be created by
+// postgres.New of github.com/zeromicro/goctl/model/sql/postgres to accept the ? placeholders{{end}}
 func New{{.upperStartCamelObject}}Model(conn sqlx.SqlConn{{if .withCache}}, c cache.CacheConf{{end}}) {{.upperStartCamelObject}}Model {
 	return &default{{.upperStartCamelObject}}Model{
-		{{if .withCache}}CachedConn: sqlc.NewConn(conn, c){{else}}conn:conn{{end}},
+		{{if .withCache}}CachedConn: sqlc.NewConn(conn, c){{if .withCtx}},
+		conn:       conn{{end}}{{else}}conn:conn{{end}},
 		table:      "{{.table}}",
 	}
 }
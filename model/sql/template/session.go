@@ -0,0 +1,144 @@
+package template
+
+// Session defines the methods to run the statements in the session of a transaction, the caches are
+// skipped in the transaction and the changed ones are deleted after the transaction is committed.
+// The ctx isn't passed to the database because sqlx has no methods with context, it only carries
+// the transaction of TransactCtx.
+var Session = `
+// WithSession returns the model running the statements in session,{{if .withCache}} the changes must be made with
+// the ctx of TransactCtx to delete the caches after the transaction is committed,{{end}} the ctx of the methods
+// isn't passed to the database.
+func (m *default{{.upperStartCamelObject}}Model) WithSession(session sqlx.Session) {{.upperStartCamelObject}}Model {
+	return &default{{.upperStartCamelObject}}Model{
+		{{if .withCache}}CachedConn: m.CachedConn,
+		conn:       session,
+		tx:         true,
+		table:      m.table,{{else}}conn:  session,
+		table: m.table,{{end}}
+	}
+}
+{{if .withCache}}
+// execCtx runs exec and deletes the caches of keys, the deletion is delayed until the transaction
+// of TransactCtx is committed if the model is bound to a session
+func (m *default{{.upperStartCamelObject}}Model) execCtx(ctx context.Context, exec func(conn sqlx.Session) (sql.Result, error), keys ...string) (sql.Result, error) {
+	if !m.tx {
+		return m.Exec(func(conn sqlx.SqlConn) (sql.Result, error) {
+			return exec(conn)
+		}, keys...)
+	}
+
+	// the caches would be deleted before the transaction is committed and refilled with the old rows
+	if ctx.Value(pendingCachesKey{}) == nil {
+		return nil, ErrNotInTransactCtx
+	}
+
+	ret, err := exec(m.conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, delCacheOnCommit(ctx, m.CachedConn, keys...)
+}
+
+// queryRowSession queries the row in the session without the cache, or with the cache if not bound to a session
+func (m *default{{.upperStartCamelObject}}Model) queryRowSession(v interface{}, key string, query func(conn sqlx.Session, v interface{}) error) error {
+	if m.tx {
+		return query(m.conn, v)
+	}
+
+	return m.QueryRow(v, key, func(conn sqlx.SqlConn, v interface{}) error {
+		return query(conn, v)
+	})
+}
+{{if .containsIndexCache}}
+// queryRowIndexSession queries the row by index in the session without the cache, or with the cache if not bound to a session
+func (m *default{{.upperStartCamelObject}}Model) queryRowIndexSession(v interface{}, key string, keyer func(primary interface{}) string,
+	indexQuery func(conn sqlx.Session, v interface{}) (interface{}, error), primaryQuery sqlc.PrimaryQueryFn) error {
+	if m.tx {
+		_, err := indexQuery(m.conn, v)
+		return err
+	}
+
+	return m.QueryRowIndex(v, key, keyer, func(conn sqlx.SqlConn, v interface{}) (interface{}, error) {
+		return indexQuery(conn, v)
+	}, primaryQuery)
+}
+{{end}}{{end}}`
+
+// SessionMethod defines the interface method to bind the model to a session
+var SessionMethod = `WithSession(session sqlx.Session) {{.upperStartCamelObject}}Model`
+
+// Transact defines the template of TransactCtx to run the models in a transaction,
+// it's generated once in the model directory.
+var Transact = `package {{.pkg}}
+
+import (
+	"context"
+	"sync"
+
+	"github.com/tal-tech/go-zero/core/errorx"
+	"github.com/tal-tech/go-zero/core/stores/sqlc"
+	"github.com/tal-tech/go-zero/core/stores/sqlx"
+)
+
+type (
+	pendingCachesKey struct{}
+
+	// pendingCaches holds the deletions of the caches changed in a transaction
+	pendingCaches struct {
+		lock sync.Mutex
+		dels []func() error
+	}
+)
+
+// TransactCtx runs fn in a transaction of conn, the models bound to session by WithSession
+// delete the caches of the changed rows after the transaction is committed.
+func TransactCtx(ctx context.Context, conn sqlx.SqlConn, fn func(ctx context.Context, session sqlx.Session) error) error {
+	pending := new(pendingCaches)
+	ctx = context.WithValue(ctx, pendingCachesKey{}, pending)
+	err := conn.Transact(func(session sqlx.Session) error {
+		return fn(ctx, session)
+	})
+	if err != nil {
+		return err
+	}
+
+	return pending.flush()
+}
+
+// delCacheOnCommit deletes the caches of keys after the transaction of ctx is committed,
+// or deletes them right away if ctx is not from TransactCtx, the models bound to a session
+// return ErrNotInTransactCtx instead of calling it without TransactCtx.
+func delCacheOnCommit(ctx context.Context, conn sqlc.CachedConn, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	pending, ok := ctx.Value(pendingCachesKey{}).(*pendingCaches)
+	if !ok {
+		return conn.DelCache(keys...)
+	}
+
+	pending.lock.Lock()
+	defer pending.lock.Unlock()
+	pending.dels = append(pending.dels, func() error {
+		return conn.DelCache(keys...)
+	})
+
+	return nil
+}
+
+func (p *pendingCaches) flush() error {
+	p.lock.Lock()
+	dels := p.dels
+	p.dels = nil
+	p.lock.Unlock()
+
+	var batch errorx.BatchError
+	for _, del := range dels {
+		batch.Add(del())
+	}
+
+	return batch.Err()
+}
+`
@@ -8,7 +8,10 @@ type (
 	}
 
 	default{{.upperStartCamelObject}}Model struct {
-		{{if .withCache}}sqlc.CachedConn{{else}}conn sqlx.SqlConn{{end}}
+		{{if .withCache}}sqlc.CachedConn{{if .withCtx}}
+		// conn is the connection or the session of the transaction, tx is true for the session
+		conn sqlx.Session
+		tx   bool{{end}}{{else}}conn {{if .withCtx}}sqlx.Session{{else}}sqlx.SqlConn{{end}}{{end}}
 		table string
 	}
 
@@ -1,17 +1,52 @@
 package template
 
-// Update defines a template for generating update codes
+// Update defines a template for generating update codes, the row is updated only if the version
+// is not changed if versionColumn is not empty, otherwise ErrVersionConflict is returned, or
+// ErrNotFound is returned if the row doesn't exist
 var Update = `
-func (m *default{{.upperStartCamelObject}}Model) Update(data {{.upperStartCamelObject}}) error {
+func (m *default{{.upperStartCamelObject}}Model) Update({{if .withCtx}}ctx context.Context, {{end}}data {{.upperStartCamelObject}}) error {
 	{{if .withCache}}{{.keys}}
-    _, err := m.Exec(func(conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("update %s set %s where {{.originalPrimaryKey}} = ?", m.table, {{.lowerStartCamelObject}}RowsWithPlaceHolder)
-		return conn.Exec(query, {{.expressionValues}})
-	}, {{.keyValues}}){{else}}query := fmt.Sprintf("update %s set %s where {{.originalPrimaryKey}} = ?", m.table, {{.lowerStartCamelObject}}RowsWithPlaceHolder)
-    _,err:=m.conn.Exec(query, {{.expressionValues}}){{end}}
-	return err
+    _, err := m.{{if .withCtx}}execCtx(ctx, func(conn sqlx.Session){{else}}Exec(func(conn sqlx.SqlConn){{end}} (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s{{.versionSet}} where {{.originalPrimaryKey}} = ?{{.condition}}", m.table, {{.lowerStartCamelObject}}RowsWithPlaceHolder)
+		{{if .versionColumn}}result, err = conn.Exec(query, {{.expressionValues}})
+		if err != nil {
+			return nil, err
+		}
+
+		return result, m.checkVersion(conn, result, data.{{.upperStartCamelPrimaryKey}}){{else}}return conn.Exec(query, {{.expressionValues}}){{end}}
+	}, {{.keyValues}})
+	return err{{else}}query := fmt.Sprintf("update %s set %s{{.versionSet}} where {{.originalPrimaryKey}} = ?{{.condition}}", m.table, {{.lowerStartCamelObject}}RowsWithPlaceHolder)
+    {{if .versionColumn}}ret{{else}}_{{end}},err:=m.conn.Exec(query, {{.expressionValues}})
+	{{if .versionColumn}}if err != nil {
+		return err
+	}
+
+	return m.checkVersion(m.conn, ret, data.{{.upperStartCamelPrimaryKey}}){{else}}return err{{end}}{{end}}
+}
+{{if .versionColumn}}
+// checkVersion returns ErrNotFound if no row is updated because the row doesn't exist,
+// otherwise ErrVersionConflict because the version is changed
+func (m *default{{.upperStartCamelObject}}Model) checkVersion(conn sqlx.Session, result sql.Result, {{.lowerStartCamelPrimaryKey}} {{.dataType}}) error {
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows > 0 {
+		return nil
+	}
+
+	var count int64
+	query := fmt.Sprintf("select count(*) from %s where {{.originalPrimaryKey}} = ?{{.softDelete}}", m.table)
+	if err := conn.QueryRow(&count, query, {{.lowerStartCamelPrimaryKey}}); err != nil {
+		return err
+	}
+	if count == 0 {
+		return ErrNotFound
+	}
+
+	return ErrVersionConflict
 }
-`
+{{end}}`
 
 // UpdateMethod defines an interface method template for generating update codes
-var UpdateMethod = `Update(data {{.upperStartCamelObject}}) error`
+var UpdateMethod = `Update({{if .withCtx}}ctx context.Context, {{end}}data {{.upperStartCamelObject}}) error`
@@ -0,0 +1,176 @@
+package model
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tal-tech/go-zero/core/stores/sqlc"
+	"github.com/tal-tech/go-zero/core/stores/sqlx"
+	"github.com/tal-tech/go-zero/core/stringx"
+	"github.com/zeromicro/goctl/model/sql/builderx"
+)
+
+var (
+	accountFieldNames          = builderx.RawFieldNames(&Account{}, true)
+	accountRows                = strings.Join(accountFieldNames, ",")
+	accountRowsExpectAutoSet   = strings.Join(stringx.Remove(accountFieldNames, "\"id\"", "\"create_time\"", "\"update_time\""), ",")
+	accountRowsWithPlaceHolder = strings.Join(stringx.Remove(accountFieldNames, "\"id\"", "\"create_time\"", "\"update_time\""), "=?,") + "=?"
+)
+
+type (
+	AccountModel interface {
+		Insert(data Account) (sql.Result, error)
+		BatchInsert(data []Account) (sql.Result, error)
+		FindOne(id int64) (*Account, error)
+		FindOneByEmail(email string) (*Account, error)
+		FindByIds(ids []int64) ([]*Account, error)
+		FindAllByAge(age int64, offset, limit int64) ([]*Account, error)
+		FindAllByAgeAfter(age int64, lastId int64, limit int64) ([]*Account, error)
+		Count() (int64, error)
+		Update(data Account) error
+		Delete(id int64) error
+	}
+
+	defaultAccountModel struct {
+		conn  sqlx.SqlConn
+		table string
+	}
+
+	Account struct {
+		Id         int64     `db:"id"`
+		Name       string    `db:"name"` // 用户名称
+		Email      string    `db:"email"`
+		Age        int64     `db:"age"`
+		CreateTime time.Time `db:"create_time"`
+		UpdateTime time.Time `db:"update_time"`
+	}
+)
+
+// NewAccountModel creates a model for postgresql, conn must be created by
+// postgres.New of github.com/zeromicro/goctl/model/sql/postgres to accept the ? placeholders
+func NewAccountModel(conn sqlx.SqlConn) AccountModel {
+	return &defaultAccountModel{
+		conn:  conn,
+		table: "\"public\".\"account\"",
+	}
+}
+
+func (m *defaultAccountModel) Insert(data Account) (sql.Result, error) {
+	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?)", m.table, accountRowsExpectAutoSet)
+	ret, err := m.conn.Exec(query, data.Name, data.Email, data.Age)
+	return ret, err
+}
+
+func (m *defaultAccountModel) BatchInsert(data []Account) (sql.Result, error) {
+	if len(data) == 0 {
+		return driver.RowsAffected(0), nil
+	}
+
+	values := make([]string, 0, len(data))
+	args := make([]interface{}, 0, len(data)*3)
+	for _, item := range data {
+		values = append(values, "(?, ?, ?)")
+		args = append(args, item.Name, item.Email, item.Age)
+	}
+
+	query := fmt.Sprintf("insert into %s (%s) values %s", m.table, accountRowsExpectAutoSet, strings.Join(values, ", "))
+	return m.conn.Exec(query, args...)
+}
+
+func (m *defaultAccountModel) FindOne(id int64) (*Account, error) {
+	query := fmt.Sprintf("select %s from %s where \"id\" = ? limit 1", accountRows, m.table)
+	var resp Account
+	err := m.conn.QueryRow(&resp, query, id)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultAccountModel) FindOneByEmail(email string) (*Account, error) {
+	var resp Account
+	query := fmt.Sprintf("select %s from %s where \"email\" = ? limit 1", accountRows, m.table)
+	err := m.conn.QueryRow(&resp, query, email)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultAccountModel) FindByIds(ids []int64) ([]*Account, error) {
+	rows := make(map[int64]*Account, len(ids))
+	var missing []interface{}
+	for _, id := range ids {
+		missing = append(missing, id)
+	}
+
+	if len(missing) > 0 {
+		placeholders := make([]string, len(missing))
+		for i := range placeholders {
+			placeholders[i] = "?"
+		}
+
+		query := fmt.Sprintf("select %s from %s where \"id\" in (%s)", accountRows, m.table, strings.Join(placeholders, ","))
+		var resp []*Account
+		if err := m.conn.QueryRows(&resp, query, missing...); err != nil {
+			return nil, err
+		}
+
+		for _, item := range resp {
+			rows[item.Id] = item
+		}
+	}
+
+	list := make([]*Account, 0, len(rows))
+	for _, id := range ids {
+		if item, ok := rows[id]; ok {
+			list = append(list, item)
+		}
+	}
+
+	return list, nil
+}
+
+func (m *defaultAccountModel) FindAllByAge(age int64, offset, limit int64) ([]*Account, error) {
+	query := fmt.Sprintf("select %s from %s where \"age\" = ? order by \"id\" limit ? offset ?", accountRows, m.table)
+	var resp []*Account
+	err := m.conn.QueryRows(&resp, query, age, limit, offset)
+	return resp, err
+}
+
+func (m *defaultAccountModel) FindAllByAgeAfter(age int64, lastId int64, limit int64) ([]*Account, error) {
+	query := fmt.Sprintf("select %s from %s where \"age\" = ? and \"id\" > ? order by \"id\" limit ?", accountRows, m.table)
+	var resp []*Account
+	err := m.conn.QueryRows(&resp, query, age, lastId, limit)
+	return resp, err
+}
+
+func (m *defaultAccountModel) Count() (int64, error) {
+	query := fmt.Sprintf("select count(*) from %s", m.table)
+	var count int64
+	err := m.conn.QueryRow(&count, query)
+	return count, err
+}
+
+func (m *defaultAccountModel) Update(data Account) error {
+	query := fmt.Sprintf("update %s set %s where \"id\" = ?", m.table, accountRowsWithPlaceHolder)
+	_, err := m.conn.Exec(query, data.Name, data.Email, data.Age, data.Id)
+	return err
+}
+
+func (m *defaultAccountModel) Delete(id int64) error {
+	query := fmt.Sprintf("delete from %s where \"id\" = ?", m.table)
+	_, err := m.conn.Exec(query, id)
+	return err
+}
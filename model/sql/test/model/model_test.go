@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -12,9 +13,16 @@ import (
 	"github.com/tal-tech/go-zero/core/stores/cache"
 	"github.com/tal-tech/go-zero/core/stores/redis"
 	"github.com/tal-tech/go-zero/core/stores/redis/redistest"
+	"github.com/tal-tech/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/goctl/model/sql/postgres"
 	mocksql "github.com/zeromicro/goctl/model/sql/test"
 )
 
+// mockPostgresDriver is the sqlmock driver wrapped like the driver of postgres.New
+const mockPostgresDriver = "goctl-postgres-mock"
+
+var registerOnce sync.Once
+
 func TestStudentModel(t *testing.T) {
 	var (
 		testTimeValue          = time.Now()
@@ -281,3 +289,151 @@ func mockUser(mockFn func(mock sqlmock.Sqlmock), fn func(m UserModel)) error {
 	fn(m)
 	return nil
 }
+
+func TestAccountModel(t *testing.T) {
+	const (
+		table   = `"public"."account"`
+		columns = `"id","name","email","age","create_time","update_time"`
+	)
+	data := Account{
+		Id:    1,
+		Name:  "gozero",
+		Email: "gozero@example.com",
+		Age:   18,
+	}
+	row := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "name", "email", "age", "create_time", "update_time"}).
+			AddRow(data.Id, data.Name, data.Email, data.Age, time.Now(), time.Now())
+	}
+
+	mockAccount(t, func(mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`insert into `+table+` ("name","email","age") values ($1, $2, $3)`).
+			WithArgs(data.Name, data.Email, data.Age).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}, func(m AccountModel) {
+		_, err := m.Insert(data)
+		assert.Nil(t, err)
+	})
+
+	mockAccount(t, func(mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`insert into `+table+` ("name","email","age") values ($1, $2, $3), ($4, $5, $6)`).
+			WithArgs(data.Name, data.Email, data.Age, data.Name, "gozero1@example.com", data.Age).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+	}, func(m AccountModel) {
+		another := data
+		another.Email = "gozero1@example.com"
+		_, err := m.BatchInsert([]Account{data, another})
+		assert.Nil(t, err)
+	})
+
+	mockAccount(t, func(mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(`select ` + columns + ` from ` + table + ` where "id" = $1 limit 1`).
+			WithArgs(data.Id).
+			WillReturnRows(row())
+		mock.ExpectQuery(`select ` + columns + ` from ` + table + ` where "email" = $1 limit 1`).
+			WithArgs(data.Email).
+			WillReturnRows(row())
+		mock.ExpectQuery(`select `+columns+` from `+table+` where "id" in ($1,$2)`).
+			WithArgs(data.Id, int64(2)).
+			WillReturnRows(row())
+		mock.ExpectQuery(`select `+columns+` from `+table+` where "age" = $1 order by "id" limit $2 offset $3`).
+			WithArgs(data.Age, int64(10), int64(0)).
+			WillReturnRows(row())
+	}, func(m AccountModel) {
+		account, err := m.FindOne(data.Id)
+		assert.Nil(t, err)
+		assert.Equal(t, data.Email, account.Email)
+
+		account, err = m.FindOneByEmail(data.Email)
+		assert.Nil(t, err)
+		assert.Equal(t, data.Id, account.Id)
+
+		accounts, err := m.FindByIds([]int64{data.Id, 2})
+		assert.Nil(t, err)
+		assert.Len(t, accounts, 1)
+
+		accounts, err = m.FindAllByAge(data.Age, 0, 10)
+		assert.Nil(t, err)
+		assert.Len(t, accounts, 1)
+	})
+
+	mockAccount(t, func(mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`update `+table+` set "name"=$1,"email"=$2,"age"=$3 where "id" = $4`).
+			WithArgs(data.Name, data.Email, data.Age, data.Id).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`delete from ` + table + ` where "id" = $1`).
+			WithArgs(data.Id).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}, func(m AccountModel) {
+		assert.Nil(t, m.Update(data))
+		assert.Nil(t, m.Delete(data.Id))
+	})
+}
+
+// mockAccount runs the postgresql model through sqlx and the driver of postgres.New,
+// the expected statements are matched exactly after the placeholders are rewritten
+func mockAccount(t *testing.T, mockFn func(mock sqlmock.Sqlmock), fn func(m AccountModel)) {
+	dsn := fmt.Sprintf("account_%d", time.Now().UnixNano())
+	db, mock, err := sqlmock.NewWithDSN(dsn, sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.Nil(t, err)
+	defer db.Close()
+
+	registerOnce.Do(func() {
+		sql.Register(mockPostgresDriver, postgres.Wrap(db.Driver()))
+	})
+
+	mockFn(mock)
+	fn(NewAccountModel(sqlx.NewSqlConn(mockPostgresDriver, dsn)))
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestProductModel(t *testing.T) {
+	const (
+		updateSql = "update `product` set `name`=?,`deleted_at`=?, `version` = `version` + 1 where `id` = ? and `version` = ? and `deleted_at` is null"
+		countSql  = "select count(*) from `product` where `id` = ? and `deleted_at` is null"
+	)
+	data := Product{
+		Id:      1,
+		Name:    "gozero",
+		Version: 2,
+	}
+
+	tests := []struct {
+		name     string
+		affected int64
+		count    int64
+		err      error
+	}{
+		{name: "updated", affected: 1},
+		{name: "not found", count: 0, err: ErrNotFound},
+		{name: "version conflict", count: 1, err: ErrVersionConflict},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockProduct(t, func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(updateSql).
+					WithArgs(data.Name, data.DeletedAt, data.Id, data.Version).
+					WillReturnResult(sqlmock.NewResult(0, test.affected))
+				if test.affected == 0 {
+					mock.ExpectQuery(countSql).
+						WithArgs(data.Id).
+						WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(test.count))
+				}
+			}, func(m ProductModel) {
+				assert.Equal(t, test.err, m.Update(data))
+			})
+		})
+	}
+}
+
+// mockProduct runs the model without cache through sqlx, the statements are matched exactly
+func mockProduct(t *testing.T, mockFn func(mock sqlmock.Sqlmock), fn func(m ProductModel)) {
+	dsn := fmt.Sprintf("product_%d", time.Now().UnixNano())
+	db, mock, err := sqlmock.NewWithDSN(dsn, sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.Nil(t, err)
+	defer db.Close()
+
+	mockFn(mock)
+	fn(NewProductModel(sqlx.NewSqlConn("sqlmock", dsn)))
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,168 @@
+package model
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tal-tech/go-zero/core/stores/sqlc"
+	"github.com/tal-tech/go-zero/core/stores/sqlx"
+	"github.com/tal-tech/go-zero/core/stringx"
+	"github.com/zeromicro/goctl/model/sql/builderx"
+)
+
+var (
+	productFieldNames          = builderx.RawFieldNames(&Product{})
+	productRows                = strings.Join(productFieldNames, ",")
+	productRowsExpectAutoSet   = strings.Join(stringx.Remove(productFieldNames, "`id`", "`create_time`", "`update_time`"), ",")
+	productRowsWithPlaceHolder = strings.Join(stringx.Remove(productFieldNames, "`id`", "`create_time`", "`update_time`", "`version`"), "=?,") + "=?"
+)
+
+type (
+	ProductModel interface {
+		Insert(data Product) (sql.Result, error)
+		BatchInsert(data []Product) (sql.Result, error)
+		FindOne(id int64) (*Product, error)
+		FindByIds(ids []int64) ([]*Product, error)
+		Count() (int64, error)
+		Update(data Product) error
+		Delete(id int64) error
+	}
+
+	defaultProductModel struct {
+		conn  sqlx.SqlConn
+		table string
+	}
+
+	Product struct {
+		Id        int64        `db:"id"`
+		Name      string       `db:"name"`
+		Version   int64        `db:"version"`
+		DeletedAt sql.NullTime `db:"deleted_at"`
+	}
+)
+
+func NewProductModel(conn sqlx.SqlConn) ProductModel {
+	return &defaultProductModel{
+		conn:  conn,
+		table: "`product`",
+	}
+}
+
+func (m *defaultProductModel) Insert(data Product) (sql.Result, error) {
+	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?)", m.table, productRowsExpectAutoSet)
+	ret, err := m.conn.Exec(query, data.Name, data.Version, data.DeletedAt)
+	return ret, err
+}
+
+func (m *defaultProductModel) BatchInsert(data []Product) (sql.Result, error) {
+	if len(data) == 0 {
+		return driver.RowsAffected(0), nil
+	}
+
+	values := make([]string, 0, len(data))
+	args := make([]interface{}, 0, len(data)*3)
+	for _, item := range data {
+		values = append(values, "(?, ?, ?)")
+		args = append(args, item.Name, item.Version, item.DeletedAt)
+	}
+
+	query := fmt.Sprintf("insert into %s (%s) values %s", m.table, productRowsExpectAutoSet, strings.Join(values, ", "))
+	return m.conn.Exec(query, args...)
+}
+
+func (m *defaultProductModel) FindOne(id int64) (*Product, error) {
+	query := fmt.Sprintf("select %s from %s where `id` = ? and `deleted_at` is null limit 1", productRows, m.table)
+	var resp Product
+	err := m.conn.QueryRow(&resp, query, id)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultProductModel) FindByIds(ids []int64) ([]*Product, error) {
+	rows := make(map[int64]*Product, len(ids))
+	var missing []interface{}
+	for _, id := range ids {
+		missing = append(missing, id)
+	}
+
+	if len(missing) > 0 {
+		placeholders := make([]string, len(missing))
+		for i := range placeholders {
+			placeholders[i] = "?"
+		}
+
+		query := fmt.Sprintf("select %s from %s where `id` in (%s) and `deleted_at` is null", productRows, m.table, strings.Join(placeholders, ","))
+		var resp []*Product
+		if err := m.conn.QueryRows(&resp, query, missing...); err != nil {
+			return nil, err
+		}
+
+		for _, item := range resp {
+			rows[item.Id] = item
+		}
+	}
+
+	list := make([]*Product, 0, len(rows))
+	for _, id := range ids {
+		if item, ok := rows[id]; ok {
+			list = append(list, item)
+		}
+	}
+
+	return list, nil
+}
+
+func (m *defaultProductModel) Count() (int64, error) {
+	query := fmt.Sprintf("select count(*) from %s where `deleted_at` is null", m.table)
+	var count int64
+	err := m.conn.QueryRow(&count, query)
+	return count, err
+}
+
+func (m *defaultProductModel) Update(data Product) error {
+	query := fmt.Sprintf("update %s set %s, `version` = `version` + 1 where `id` = ? and `version` = ? and `deleted_at` is null", m.table, productRowsWithPlaceHolder)
+	ret, err := m.conn.Exec(query, data.Name, data.DeletedAt, data.Id, data.Version)
+	if err != nil {
+		return err
+	}
+
+	return m.checkVersion(m.conn, ret, data.Id)
+}
+
+// checkVersion returns ErrNotFound if no row is updated because the row doesn't exist,
+// otherwise ErrVersionConflict because the version is changed
+func (m *defaultProductModel) checkVersion(conn sqlx.Session, result sql.Result, id int64) error {
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows > 0 {
+		return nil
+	}
+
+	var count int64
+	query := fmt.Sprintf("select count(*) from %s where `id` = ? and `deleted_at` is null", m.table)
+	if err := conn.QueryRow(&count, query, id); err != nil {
+		return err
+	}
+	if count == 0 {
+		return ErrNotFound
+	}
+
+	return ErrVersionConflict
+}
+
+func (m *defaultProductModel) Delete(id int64) error {
+	query := fmt.Sprintf("update %s set `deleted_at` = ? where `id` = ? and `deleted_at` is null", m.table)
+	_, err := m.conn.Exec(query, time.Now(), id)
+	return err
+}
@@ -1,6 +1,13 @@
 package model
 
-import "github.com/tal-tech/go-zero/core/stores/sqlx"
+import (
+	"errors"
+
+	"github.com/tal-tech/go-zero/core/stores/sqlx"
+)
 
 // ErrNotFound types an alias for sqlx.ErrNotFound
 var ErrNotFound = sqlx.ErrNotFound
+
+// ErrVersionConflict is returned by Update if the row is changed by others since it's read
+var ErrVersionConflict = errors.New("version conflict")
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 
 	"github.com/urfave/cli"
+	conf "github.com/zeromicro/goctl/config"
 	"github.com/zeromicro/goctl/rpc/generator"
 )
 
@@ -18,6 +19,8 @@ func RPC(c *cli.Context) error {
 	style := c.String("style")
 	protoImportPath := c.StringSlice("proto_path")
 	goOptions := c.StringSlice("go_opt")
+	native := c.Bool("native")
+	merge := c.Bool("merge")
 	if len(src) == 0 {
 		return errors.New("missing -src")
 	}
@@ -25,7 +28,7 @@ func RPC(c *cli.Context) error {
 		return errors.New("missing -dir")
 	}
 
-	g, err := generator.NewDefaultRPCGenerator(style)
+	g, err := newRPCGenerator(style, native, merge)
 	if err != nil {
 		return err
 	}
@@ -42,6 +45,7 @@ func RPCNew(c *cli.Context) error {
 		return fmt.Errorf("unexpected ext: %s", ext)
 	}
 	style := c.String("style")
+	native := c.Bool("native")
 
 	protoName := rpcname + ".proto"
 	filename := filepath.Join(".", rpcname, protoName)
@@ -55,7 +59,7 @@ func RPCNew(c *cli.Context) error {
 		return err
 	}
 
-	g, err := generator.NewDefaultRPCGenerator(style)
+	g, err := newRPCGenerator(style, native, false)
 	if err != nil {
 		return err
 	}
@@ -72,3 +76,17 @@ func RPCTemplate(c *cli.Context) error {
 
 	return generator.ProtoTmpl(protoFile)
 }
+
+func newRPCGenerator(style string, native, merge bool) (*generator.RPCGenerator, error) {
+	cfg, err := conf.NewConfig(style)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Merge = merge
+
+	if native {
+		return generator.NewRPCGenerator(generator.NewNativeGenerator(), cfg), nil
+	}
+
+	return generator.NewRPCGenerator(generator.NewDefaultGenerator(), cfg), nil
+}
@@ -0,0 +1,255 @@
+package compiler
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/emicklei/proto"
+	"google.golang.org/protobuf/compiler/protogen"
+	pb "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	// imports the well-known types, which are found in protoregistry.GlobalFiles
+	// if they are not in the proto paths, just like the include directory of protoc.
+	_ "google.golang.org/protobuf/types/known/anypb"
+	_ "google.golang.org/protobuf/types/known/apipb"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/emptypb"
+	_ "google.golang.org/protobuf/types/known/fieldmaskpb"
+	_ "google.golang.org/protobuf/types/known/sourcecontextpb"
+	_ "google.golang.org/protobuf/types/known/structpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	_ "google.golang.org/protobuf/types/known/typepb"
+	_ "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type (
+	// Set describes a proto file and all the files it imports
+	Set struct {
+		// Main is the name of the proto file to generate
+		Main string
+		// Files are the descriptors in topological order, the imports are in front of the files importing them
+		Files []*descriptorpb.FileDescriptorProto
+	}
+
+	// File describes a generated go file
+	File struct {
+		Name    string
+		Content string
+	}
+
+	loader struct {
+		protoPaths []string
+		files      map[string]*descriptorpb.FileDescriptorProto
+		loading    map[string]bool
+		ordered    []*descriptorpb.FileDescriptorProto
+		symbols    map[string]descriptorpb.FieldDescriptorProto_Type
+	}
+)
+
+// Parse parses the proto file src and the files it imports into descriptors without protoc,
+// the imports are searched in protoPaths and then the directory of src, like the --proto_path of protoc
+func Parse(src string, protoPaths []string) (*Set, error) {
+	abs, err := filepath.Abs(src)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, name := filepath.Split(abs)
+	l := &loader{
+		protoPaths: append(append([]string{}, protoPaths...), dir),
+		files:      make(map[string]*descriptorpb.FileDescriptorProto),
+		loading:    make(map[string]bool),
+		symbols:    make(map[string]descriptorpb.FieldDescriptorProto_Type),
+	}
+
+	err = l.load(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Set{
+		Main:  name,
+		Files: l.ordered,
+	}, nil
+}
+
+// Generate generates the go code of the main file in set in process, the content is the same as the one
+// generated by protoc --go_out=plugins=grpc, options are the parameters of protoc-gen-go, such as paths=source_relative
+func Generate(set *Set, options ...string) ([]*File, error) {
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{set.Main},
+		Parameter:      pb.String(strings.Join(options, ",")),
+		ProtoFile:      set.Files,
+	}
+
+	gen, err := protogen.Options{}.New(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range gen.Files {
+		if !f.Generate {
+			continue
+		}
+
+		g := generateGoFile(gen, f)
+		generateGrpcContent(f, g)
+	}
+
+	resp := gen.Response()
+	if resp.Error != nil {
+		return nil, errors.New(resp.GetError())
+	}
+
+	var files []*File
+	for _, item := range resp.File {
+		files = append(files, &File{
+			Name:    item.GetName(),
+			Content: item.GetContent(),
+		})
+	}
+
+	return files, nil
+}
+
+func (l *loader) load(name string) error {
+	if _, ok := l.files[name]; ok {
+		return nil
+	}
+
+	if l.loading[name] {
+		return fmt.Errorf("%s: import cycle", name)
+	}
+
+	l.loading[name] = true
+	defer delete(l.loading, name)
+
+	filename, ok := l.find(name)
+	if !ok {
+		return l.loadRegistered(name)
+	}
+
+	r, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	parser := proto.NewParser(r)
+	parser.Filename(name)
+	p, err := parser.Parse()
+	if err != nil {
+		return err
+	}
+
+	for _, element := range p.Elements {
+		if v, ok := element.(*proto.Import); ok {
+			err = l.load(v.Filename)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	b := newFileBuilder(name)
+	fd, err := b.build(p)
+	if err != nil {
+		return err
+	}
+
+	l.addSymbols(fd.GetPackage(), fd.MessageType, fd.EnumType)
+	for _, ref := range b.references {
+		typeName, tp, ok := l.resolve(ref.scope, ref.name)
+		if !ok {
+			return fmt.Errorf("%s:%d:%d: %q is not defined", name, ref.position.Line, ref.position.Column, ref.name)
+		}
+
+		ref.resolve(typeName, tp)
+	}
+
+	l.add(name, fd)
+	return nil
+}
+
+// loadRegistered loads the well-known types which are linked into goctl
+func (l *loader) loadRegistered(name string) error {
+	desc, err := protoregistry.GlobalFiles.FindFileByPath(name)
+	if err != nil {
+		return fmt.Errorf("%s: file not found in the proto paths %v", name, l.protoPaths)
+	}
+
+	fd := protodesc.ToFileDescriptorProto(desc)
+	for _, dep := range fd.Dependency {
+		err = l.load(dep)
+		if err != nil {
+			return err
+		}
+	}
+
+	l.addSymbols(fd.GetPackage(), fd.MessageType, fd.EnumType)
+	l.add(name, fd)
+	return nil
+}
+
+func (l *loader) add(name string, fd *descriptorpb.FileDescriptorProto) {
+	l.files[name] = fd
+	l.ordered = append(l.ordered, fd)
+}
+
+func (l *loader) find(name string) (string, bool) {
+	for _, dir := range l.protoPaths {
+		filename := filepath.Join(dir, filepath.FromSlash(name))
+		info, err := os.Stat(filename)
+		if err == nil && !info.IsDir() {
+			return filename, true
+		}
+	}
+
+	return "", false
+}
+
+func (l *loader) addSymbols(scope string, messages []*descriptorpb.DescriptorProto, enums []*descriptorpb.EnumDescriptorProto) {
+	for _, enum := range enums {
+		l.symbols[joinName(scope, enum.GetName())] = descriptorpb.FieldDescriptorProto_TYPE_ENUM
+	}
+
+	for _, message := range messages {
+		fullName := joinName(scope, message.GetName())
+		l.symbols[fullName] = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+		l.addSymbols(fullName, message.NestedType, message.EnumType)
+	}
+}
+
+// resolve finds the type name from the innermost scope to the outermost scope,
+// a name starts with a dot is a fully-qualified name
+func (l *loader) resolve(scope, name string) (string, descriptorpb.FieldDescriptorProto_Type, bool) {
+	if strings.HasPrefix(name, fullNameSeparator) {
+		tp, ok := l.symbols[name[1:]]
+		return name, tp, ok
+	}
+
+	for {
+		fullName := joinName(scope, name)
+		if tp, ok := l.symbols[fullName]; ok {
+			return fullNameSeparator + fullName, tp, true
+		}
+
+		if len(scope) == 0 {
+			return "", 0, false
+		}
+
+		index := strings.LastIndex(scope, fullNameSeparator)
+		if index < 0 {
+			scope = ""
+		} else {
+			scope = scope[:index]
+		}
+	}
+}
@@ -0,0 +1,550 @@
+package compiler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"text/scanner"
+
+	"github.com/emicklei/proto"
+	pb "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	// the max field number, see https://developers.google.com/protocol-buffers/docs/proto3#assigning_field_numbers
+	maxFieldNumber = 536870911
+	maxEnumNumber  = 2147483647
+
+	// the field numbers of the descriptors, which are used as the path of the source code info
+	fileMessageTypeTag    = 4
+	fileEnumTypeTag       = 5
+	fileServiceTag        = 6
+	messageFieldTag       = 2
+	messageNestedTypeTag  = 3
+	messageEnumTypeTag    = 4
+	enumValueTag          = 2
+	serviceMethodTag      = 2
+	syntaxProto3          = "proto3"
+	optionDeprecated      = "deprecated"
+	optionPacked          = "packed"
+	optionJSONName        = "json_name"
+	optionDefault         = "default"
+	optionAllowAlias      = "allow_alias"
+	optionGoPackage       = "go_package"
+	mapEntrySuffix        = "Entry"
+	syntheticOneofPrefix  = "_"
+	fullNameSeparator     = "."
+	mapKeyFieldName       = "key"
+	mapValueFieldName     = "value"
+	mapKeyFieldNumber     = 1
+	mapValueFieldNumber   = 2
+	unsupportedGroupError = "group is not supported"
+)
+
+var scalarTypes = map[string]descriptorpb.FieldDescriptorProto_Type{
+	"double":   descriptorpb.FieldDescriptorProto_TYPE_DOUBLE,
+	"float":    descriptorpb.FieldDescriptorProto_TYPE_FLOAT,
+	"int64":    descriptorpb.FieldDescriptorProto_TYPE_INT64,
+	"uint64":   descriptorpb.FieldDescriptorProto_TYPE_UINT64,
+	"int32":    descriptorpb.FieldDescriptorProto_TYPE_INT32,
+	"fixed64":  descriptorpb.FieldDescriptorProto_TYPE_FIXED64,
+	"fixed32":  descriptorpb.FieldDescriptorProto_TYPE_FIXED32,
+	"bool":     descriptorpb.FieldDescriptorProto_TYPE_BOOL,
+	"string":   descriptorpb.FieldDescriptorProto_TYPE_STRING,
+	"bytes":    descriptorpb.FieldDescriptorProto_TYPE_BYTES,
+	"uint32":   descriptorpb.FieldDescriptorProto_TYPE_UINT32,
+	"sfixed32": descriptorpb.FieldDescriptorProto_TYPE_SFIXED32,
+	"sfixed64": descriptorpb.FieldDescriptorProto_TYPE_SFIXED64,
+	"sint32":   descriptorpb.FieldDescriptorProto_TYPE_SINT32,
+	"sint64":   descriptorpb.FieldDescriptorProto_TYPE_SINT64,
+}
+
+type (
+	// reference is a type name which can only be resolved after all the symbols are known
+	reference struct {
+		scope    string
+		name     string
+		position scanner.Position
+		resolve  func(typeName string, tp descriptorpb.FieldDescriptorProto_Type)
+	}
+
+	// fileBuilder converts a proto file parsed by github.com/emicklei/proto into a FileDescriptorProto
+	fileBuilder struct {
+		name       string
+		proto3     bool
+		pkg        string
+		file       *descriptorpb.FileDescriptorProto
+		references []reference
+		locations  []*descriptorpb.SourceCodeInfo_Location
+		err        error
+	}
+)
+
+func newFileBuilder(name string) *fileBuilder {
+	return &fileBuilder{
+		name: name,
+		file: &descriptorpb.FileDescriptorProto{
+			Name: pb.String(name),
+		},
+	}
+}
+
+// build converts the elements of p into the descriptor, the type names of fields and methods
+// are collected as references and must be resolved by the caller
+func (b *fileBuilder) build(p *proto.Proto) (*descriptorpb.FileDescriptorProto, error) {
+	for _, element := range p.Elements {
+		switch v := element.(type) {
+		case *proto.Syntax:
+			b.proto3 = v.Value == syntaxProto3
+			b.file.Syntax = pb.String(v.Value)
+		case *proto.Package:
+			b.pkg = v.Name
+			b.file.Package = pb.String(v.Name)
+		}
+	}
+
+	for _, element := range p.Elements {
+		switch v := element.(type) {
+		case *proto.Import:
+			b.addImport(v)
+		case *proto.Option:
+			b.fileOption(v)
+		case *proto.Message:
+			if v.IsExtend {
+				b.file.Extension = append(b.file.Extension, b.extend(v, b.pkg)...)
+				continue
+			}
+
+			path := []int32{fileMessageTypeTag, int32(len(b.file.MessageType))}
+			b.file.MessageType = append(b.file.MessageType, b.message(v, b.pkg, path))
+		case *proto.Enum:
+			path := []int32{fileEnumTypeTag, int32(len(b.file.EnumType))}
+			b.file.EnumType = append(b.file.EnumType, b.enum(v, path))
+		case *proto.Service:
+			path := []int32{fileServiceTag, int32(len(b.file.Service))}
+			b.file.Service = append(b.file.Service, b.service(v, path))
+		}
+	}
+
+	if len(b.locations) > 0 {
+		b.file.SourceCodeInfo = &descriptorpb.SourceCodeInfo{Location: b.locations}
+	}
+
+	return b.file, b.err
+}
+
+func (b *fileBuilder) addImport(v *proto.Import) {
+	index := int32(len(b.file.Dependency))
+	b.file.Dependency = append(b.file.Dependency, v.Filename)
+	switch v.Kind {
+	case "public":
+		b.file.PublicDependency = append(b.file.PublicDependency, index)
+	case "weak":
+		b.file.WeakDependency = append(b.file.WeakDependency, index)
+	}
+}
+
+func (b *fileBuilder) fileOption(v *proto.Option) {
+	if b.file.Options == nil {
+		b.file.Options = &descriptorpb.FileOptions{}
+	}
+
+	switch v.Name {
+	case optionGoPackage:
+		b.file.Options.GoPackage = pb.String(v.Constant.Source)
+	case optionDeprecated:
+		b.file.Options.Deprecated = pb.Bool(isTrue(v))
+	}
+}
+
+func (b *fileBuilder) message(m *proto.Message, scope string, path []int32) *descriptorpb.DescriptorProto {
+	msg := &descriptorpb.DescriptorProto{
+		Name: pb.String(m.Name),
+	}
+	fullName := joinName(scope, m.Name)
+	b.addLocation(path, m.Comment, nil)
+
+	var optionalFields []*descriptorpb.FieldDescriptorProto
+	for _, element := range m.Elements {
+		switch v := element.(type) {
+		case *proto.NormalField:
+			label := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+			switch {
+			case v.Repeated:
+				label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+			case v.Required:
+				label = descriptorpb.FieldDescriptorProto_LABEL_REQUIRED
+			}
+
+			fieldPath := appendPath(path, messageFieldTag, len(msg.Field))
+			field := b.field(v.Field, label, fullName, fieldPath)
+			if b.proto3 && v.Optional {
+				optionalFields = append(optionalFields, field)
+			}
+			msg.Field = append(msg.Field, field)
+		case *proto.MapField:
+			entry := b.mapEntry(v, fullName)
+			msg.NestedType = append(msg.NestedType, entry)
+			fieldPath := appendPath(path, messageFieldTag, len(msg.Field))
+			b.addLocation(fieldPath, v.Comment, v.InlineComment)
+			// the type of the map field is the generated entry rather than the declared value type
+			field := newField(v.Field, descriptorpb.FieldDescriptorProto_LABEL_REPEATED)
+			field.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+			field.TypeName = pb.String(fullNameSeparator + joinName(fullName, entry.GetName()))
+			msg.Field = append(msg.Field, field)
+		case *proto.Oneof:
+			index := int32(len(msg.OneofDecl))
+			msg.OneofDecl = append(msg.OneofDecl, &descriptorpb.OneofDescriptorProto{
+				Name: pb.String(v.Name),
+			})
+			for _, item := range v.Elements {
+				oneOfField, ok := item.(*proto.OneOfField)
+				if !ok {
+					continue
+				}
+
+				fieldPath := appendPath(path, messageFieldTag, len(msg.Field))
+				field := b.field(oneOfField.Field, descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL, fullName, fieldPath)
+				field.OneofIndex = pb.Int32(index)
+				msg.Field = append(msg.Field, field)
+			}
+		case *proto.Message:
+			if v.IsExtend {
+				msg.Extension = append(msg.Extension, b.extend(v, fullName)...)
+				continue
+			}
+
+			nestedPath := appendPath(path, messageNestedTypeTag, len(msg.NestedType))
+			msg.NestedType = append(msg.NestedType, b.message(v, fullName, nestedPath))
+		case *proto.Enum:
+			enumPath := appendPath(path, messageEnumTypeTag, len(msg.EnumType))
+			msg.EnumType = append(msg.EnumType, b.enum(v, enumPath))
+		case *proto.Option:
+			if v.Name == optionDeprecated {
+				msg.Options = &descriptorpb.MessageOptions{Deprecated: pb.Bool(isTrue(v))}
+			}
+		case *proto.Reserved:
+			for _, r := range v.Ranges {
+				msg.ReservedRange = append(msg.ReservedRange, &descriptorpb.DescriptorProto_ReservedRange{
+					Start: pb.Int32(int32(r.From)),
+					End:   pb.Int32(int32(rangeEnd(r, maxFieldNumber)) + 1),
+				})
+			}
+			msg.ReservedName = append(msg.ReservedName, v.FieldNames...)
+		case *proto.Extensions:
+			for _, r := range v.Ranges {
+				msg.ExtensionRange = append(msg.ExtensionRange, &descriptorpb.DescriptorProto_ExtensionRange{
+					Start: pb.Int32(int32(r.From)),
+					End:   pb.Int32(int32(rangeEnd(r, maxFieldNumber)) + 1),
+				})
+			}
+		case *proto.Group:
+			b.fail(v.Position, unsupportedGroupError)
+		}
+	}
+
+	// the synthetic oneofs of proto3 optional fields are placed after all the real oneofs
+	for _, field := range optionalFields {
+		field.Proto3Optional = pb.Bool(true)
+		field.OneofIndex = pb.Int32(int32(len(msg.OneofDecl)))
+		msg.OneofDecl = append(msg.OneofDecl, &descriptorpb.OneofDescriptorProto{
+			Name: pb.String(syntheticOneofPrefix + field.GetName()),
+		})
+	}
+
+	return msg
+}
+
+func (b *fileBuilder) field(f *proto.Field, label descriptorpb.FieldDescriptorProto_Label, scope string, path []int32) *descriptorpb.FieldDescriptorProto {
+	field := newField(f, label)
+	if path != nil {
+		b.addLocation(path, f.Comment, f.InlineComment)
+	}
+
+	if tp, ok := scalarTypes[f.Type]; ok {
+		field.Type = tp.Enum()
+	} else {
+		b.addReference(scope, f.Type, f.Position, func(typeName string, tp descriptorpb.FieldDescriptorProto_Type) {
+			field.TypeName = pb.String(typeName)
+			field.Type = tp.Enum()
+		})
+	}
+
+	return field
+}
+
+// newField creates the field descriptor with the options but without the type
+func newField(f *proto.Field, label descriptorpb.FieldDescriptorProto_Label) *descriptorpb.FieldDescriptorProto {
+	field := &descriptorpb.FieldDescriptorProto{
+		Name:     pb.String(f.Name),
+		Number:   pb.Int32(int32(f.Sequence)),
+		Label:    label.Enum(),
+		JsonName: pb.String(jsonName(f.Name)),
+	}
+
+	for _, option := range f.Options {
+		switch option.Name {
+		case optionJSONName:
+			field.JsonName = pb.String(option.Constant.Source)
+		case optionDefault:
+			field.DefaultValue = pb.String(option.Constant.Source)
+		case optionPacked:
+			if field.Options == nil {
+				field.Options = &descriptorpb.FieldOptions{}
+			}
+			field.Options.Packed = pb.Bool(isTrue(option))
+		case optionDeprecated:
+			if field.Options == nil {
+				field.Options = &descriptorpb.FieldOptions{}
+			}
+			field.Options.Deprecated = pb.Bool(isTrue(option))
+		}
+	}
+
+	return field
+}
+
+// mapEntry generates the nested entry message of a map field, just like protoc does
+func (b *fileBuilder) mapEntry(f *proto.MapField, scope string) *descriptorpb.DescriptorProto {
+	entryName := mapEntryName(f.Name)
+	key := b.field(&proto.Field{
+		Name:     mapKeyFieldName,
+		Type:     f.KeyType,
+		Sequence: mapKeyFieldNumber,
+		Position: f.Position,
+	}, descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL, scope, nil)
+	value := b.field(&proto.Field{
+		Name:     mapValueFieldName,
+		Type:     f.Type,
+		Sequence: mapValueFieldNumber,
+		Position: f.Position,
+	}, descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL, scope, nil)
+
+	return &descriptorpb.DescriptorProto{
+		Name:    pb.String(entryName),
+		Field:   []*descriptorpb.FieldDescriptorProto{key, value},
+		Options: &descriptorpb.MessageOptions{MapEntry: pb.Bool(true)},
+	}
+}
+
+func (b *fileBuilder) extend(m *proto.Message, scope string) []*descriptorpb.FieldDescriptorProto {
+	var list []*descriptorpb.FieldDescriptorProto
+	for _, element := range m.Elements {
+		v, ok := element.(*proto.NormalField)
+		if !ok {
+			if group, ok := element.(*proto.Group); ok {
+				b.fail(group.Position, unsupportedGroupError)
+			}
+			continue
+		}
+
+		label := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+		if v.Repeated {
+			label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+		}
+
+		field := b.field(v.Field, label, scope, nil)
+		b.addReference(scope, m.Name, m.Position, func(typeName string, _ descriptorpb.FieldDescriptorProto_Type) {
+			field.Extendee = pb.String(typeName)
+		})
+		list = append(list, field)
+	}
+
+	return list
+}
+
+func (b *fileBuilder) enum(e *proto.Enum, path []int32) *descriptorpb.EnumDescriptorProto {
+	enum := &descriptorpb.EnumDescriptorProto{
+		Name: pb.String(e.Name),
+	}
+	b.addLocation(path, e.Comment, nil)
+
+	for _, element := range e.Elements {
+		switch v := element.(type) {
+		case *proto.EnumField:
+			b.addLocation(appendPath(path, enumValueTag, len(enum.Value)), v.Comment, v.InlineComment)
+			value := &descriptorpb.EnumValueDescriptorProto{
+				Name:   pb.String(v.Name),
+				Number: pb.Int32(int32(v.Integer)),
+			}
+			if v.ValueOption != nil && v.ValueOption.Name == optionDeprecated {
+				value.Options = &descriptorpb.EnumValueOptions{Deprecated: pb.Bool(isTrue(v.ValueOption))}
+			}
+			enum.Value = append(enum.Value, value)
+		case *proto.Option:
+			if enum.Options == nil {
+				enum.Options = &descriptorpb.EnumOptions{}
+			}
+
+			switch v.Name {
+			case optionAllowAlias:
+				enum.Options.AllowAlias = pb.Bool(isTrue(v))
+			case optionDeprecated:
+				enum.Options.Deprecated = pb.Bool(isTrue(v))
+			}
+		case *proto.Reserved:
+			for _, r := range v.Ranges {
+				enum.ReservedRange = append(enum.ReservedRange, &descriptorpb.EnumDescriptorProto_EnumReservedRange{
+					Start: pb.Int32(int32(r.From)),
+					End:   pb.Int32(int32(rangeEnd(r, maxEnumNumber))),
+				})
+			}
+			enum.ReservedName = append(enum.ReservedName, v.FieldNames...)
+		}
+	}
+
+	return enum
+}
+
+func (b *fileBuilder) service(s *proto.Service, path []int32) *descriptorpb.ServiceDescriptorProto {
+	service := &descriptorpb.ServiceDescriptorProto{
+		Name: pb.String(s.Name),
+	}
+	b.addLocation(path, s.Comment, nil)
+
+	for _, element := range s.Elements {
+		switch v := element.(type) {
+		case *proto.RPC:
+			b.addLocation(appendPath(path, serviceMethodTag, len(service.Method)), v.Comment, v.InlineComment)
+			method := &descriptorpb.MethodDescriptorProto{
+				Name: pb.String(v.Name),
+			}
+			if v.StreamsRequest {
+				method.ClientStreaming = pb.Bool(true)
+			}
+			if v.StreamsReturns {
+				method.ServerStreaming = pb.Bool(true)
+			}
+
+			b.addReference(b.pkg, v.RequestType, v.Position, func(typeName string, _ descriptorpb.FieldDescriptorProto_Type) {
+				method.InputType = pb.String(typeName)
+			})
+			b.addReference(b.pkg, v.ReturnsType, v.Position, func(typeName string, _ descriptorpb.FieldDescriptorProto_Type) {
+				method.OutputType = pb.String(typeName)
+			})
+			for _, option := range v.Options {
+				if option.Name == optionDeprecated {
+					method.Options = &descriptorpb.MethodOptions{Deprecated: pb.Bool(isTrue(option))}
+				}
+			}
+			service.Method = append(service.Method, method)
+		case *proto.Option:
+			if v.Name == optionDeprecated {
+				service.Options = &descriptorpb.ServiceOptions{Deprecated: pb.Bool(isTrue(v))}
+			}
+		}
+	}
+
+	return service
+}
+
+func (b *fileBuilder) addReference(scope, name string, position scanner.Position, resolve func(string, descriptorpb.FieldDescriptorProto_Type)) {
+	b.references = append(b.references, reference{
+		scope:    scope,
+		name:     name,
+		position: position,
+		resolve:  resolve,
+	})
+}
+
+// addLocation records the comments of an element, which will be written into the generated code
+func (b *fileBuilder) addLocation(path []int32, leading, trailing *proto.Comment) {
+	if leading == nil && trailing == nil {
+		return
+	}
+
+	location := &descriptorpb.SourceCodeInfo_Location{
+		Path: path,
+	}
+	if leading != nil {
+		location.Span = []int32{int32(leading.Position.Line - 1), int32(leading.Position.Column - 1), int32(leading.Position.Column - 1)}
+		location.LeadingComments = pb.String(commentText(leading))
+	}
+	if trailing != nil {
+		if location.Span == nil {
+			location.Span = []int32{int32(trailing.Position.Line - 1), int32(trailing.Position.Column - 1), int32(trailing.Position.Column - 1)}
+		}
+		location.TrailingComments = pb.String(commentText(trailing))
+	}
+	b.locations = append(b.locations, location)
+}
+
+func (b *fileBuilder) fail(position scanner.Position, msg string) {
+	if b.err != nil {
+		return
+	}
+
+	b.err = fmt.Errorf("%s:%d:%d: %s", b.name, position.Line, position.Column, msg)
+}
+
+func commentText(c *proto.Comment) string {
+	return strings.Join(c.Lines, "\n") + "\n"
+}
+
+func isTrue(option *proto.Option) bool {
+	v, _ := strconv.ParseBool(option.Constant.Source)
+	return v
+}
+
+func rangeEnd(r proto.Range, max int) int {
+	if r.Max {
+		return max
+	}
+
+	return r.To
+}
+
+func appendPath(path []int32, tag, index int) []int32 {
+	ret := make([]int32, 0, len(path)+2)
+	ret = append(ret, path...)
+	return append(ret, int32(tag), int32(index))
+}
+
+func joinName(scope, name string) string {
+	if len(scope) == 0 {
+		return name
+	}
+
+	return scope + fullNameSeparator + name
+}
+
+// jsonName converts the field name into lowerCamelCase, just like protoc does
+func jsonName(name string) string {
+	var builder strings.Builder
+	var upperNext bool
+	for _, c := range name {
+		if c == '_' {
+			upperNext = true
+			continue
+		}
+
+		if upperNext && 'a' <= c && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		upperNext = false
+		builder.WriteRune(c)
+	}
+
+	return builder.String()
+}
+
+// mapEntryName converts the map field name into the name of the entry message, e.g. foo_bar to FooBarEntry
+func mapEntryName(name string) string {
+	var builder strings.Builder
+	upperNext := true
+	for _, c := range name {
+		if c == '_' {
+			upperNext = true
+			continue
+		}
+
+		if upperNext && 'a' <= c && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		upperNext = false
+		builder.WriteRune(c)
+	}
+
+	return builder.String() + mapEntrySuffix
+}
@@ -0,0 +1,20 @@
+package compiler
+
+// internal_gengo is the implementation of protoc-gen-go, it is importable but not covered by the
+// compatibility promise of google.golang.org/protobuf, so any upgrade of the module may break the
+// in-process generation. The module is pinned to gengoVersion in go.mod, TestGengoVersion fails once
+// it is upgraded, and TestGenerateGolden should be checked against the output of protoc-gen-go before
+// the golden file and gengoVersion are updated.
+
+import (
+	"google.golang.org/protobuf/cmd/protoc-gen-go/internal_gengo"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// gengoVersion is the version of google.golang.org/protobuf which the generation is verified with
+const gengoVersion = "v1.27.1"
+
+// generateGoFile generates the messages and enums of file like protoc-gen-go
+func generateGoFile(gen *protogen.Plugin, file *protogen.File) *protogen.GeneratedFile {
+	return internal_gengo.GenerateFile(gen, file)
+}
@@ -25,6 +25,16 @@ func NewDefaultRPCGenerator(style string) (*RPCGenerator, error) {
 	return NewRPCGenerator(NewDefaultGenerator(), cfg), nil
 }
 
+// NewNativeRPCGenerator wraps NativeGenerator with configure,
+// which generates the pb.go file without protoc and protoc-gen-go
+func NewNativeRPCGenerator(style string) (*RPCGenerator, error) {
+	cfg, err := conf.NewConfig(style)
+	if err != nil {
+		return nil, err
+	}
+	return NewRPCGenerator(NewNativeGenerator(), cfg), nil
+}
+
 // NewRPCGenerator creates an instance for RPCGenerator
 func NewRPCGenerator(g Generator, cfg *conf.Config) *RPCGenerator {
 	return &RPCGenerator{
@@ -14,6 +14,9 @@ import (
 )
 
 const (
+	streamClient = "Client"
+	streamServer = "Server"
+
 	callTemplateText = `{{.head}}
 
 //go:generate mockgen -destination ./{{.name}}_mock.go -package {{.filePackage}} -source $GOFILE
@@ -50,13 +53,13 @@ func New{{.serviceName}}(cli zrpc.Client) {{.serviceName}} {
 `
 
 	callInterfaceFunctionTemplate = `{{if .hasComment}}{{.comment}}
-{{end}}{{.method}}(ctx context.Context,in *{{.pbRequest}}) (*{{.pbResponse}},error)`
+{{end}}{{.method}}(ctx context.Context{{if .hasReq}},in *{{.pbRequest}}{{end}}) ({{if .notStream}}*{{.pbResponse}}{{else}}{{.streamBody}}{{end}},error)`
 
 	callFunctionTemplate = `
 {{if .hasComment}}{{.comment}}{{end}}
-func (m *default{{.serviceName}}) {{.method}}(ctx context.Context,in *{{.pbRequest}}) (*{{.pbResponse}}, error) {
+func (m *default{{.serviceName}}) {{.method}}(ctx context.Context{{if .hasReq}},in *{{.pbRequest}}{{end}}) ({{if .notStream}}*{{.pbResponse}}{{else}}{{.streamBody}}{{end}}, error) {
 	client := {{.package}}.New{{.rpcServiceName}}Client(m.cli.Conn())
-	return client.{{.method}}(ctx, in)
+	return client.{{.method}}(ctx{{if .hasReq}}, in{{end}})
 }
 `
 )
@@ -64,8 +67,22 @@ func (m *default{{.serviceName}}) {{.method}}(ctx context.Context,in *{{.pbReque
 // GenCall generates the rpc client code, which is the entry point for the rpc service call.
 // It is a layer of encapsulation for the rpc client and shields the details in the pb.
 func (g *DefaultGenerator) GenCall(ctx DirContext, proto parser.Proto, cfg *conf.Config) error {
-	dir := ctx.GetCall()
-	service := proto.Service
+	for _, service := range proto.Service {
+		err := g.genCall(ctx, proto, service, cfg)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *DefaultGenerator) genCall(ctx DirContext, proto parser.Proto, service parser.Service, cfg *conf.Config) error {
+	dir, err := getCallDir(ctx, proto, service)
+	if err != nil {
+		return err
+	}
+
 	head := util.GetHead(proto.Name)
 
 	callFilename, err := format.FileNamingFormat(cfg.NamingFormat, service.Name)
@@ -79,7 +96,7 @@ func (g *DefaultGenerator) GenCall(ctx DirContext, proto parser.Proto, cfg *conf
 		return err
 	}
 
-	iFunctions, err := g.getInterfaceFuncs(service)
+	iFunctions, err := g.getInterfaceFuncs(proto.PbPackage, service)
 	if err != nil {
 		return err
 	}
@@ -125,6 +142,9 @@ func (g *DefaultGenerator) genFunction(goPackage string, service parser.Service)
 			"pbResponse":     parser.CamelCase(rpc.ReturnsType),
 			"hasComment":     len(comment) > 0,
 			"comment":        comment,
+			"hasReq":         !rpc.StreamsRequest,
+			"notStream":      rpc.IsUnary(),
+			"streamBody":     streamBody(goPackage, service, rpc, streamClient),
 		})
 		if err != nil {
 			return nil, err
@@ -135,7 +155,7 @@ func (g *DefaultGenerator) genFunction(goPackage string, service parser.Service)
 	return functions, nil
 }
 
-func (g *DefaultGenerator) getInterfaceFuncs(service parser.Service) ([]string, error) {
+func (g *DefaultGenerator) getInterfaceFuncs(goPackage string, service parser.Service) ([]string, error) {
 	functions := make([]string, 0)
 
 	for _, rpc := range service.RPC {
@@ -152,6 +172,9 @@ func (g *DefaultGenerator) getInterfaceFuncs(service parser.Service) ([]string,
 				"method":     parser.CamelCase(rpc.Name),
 				"pbRequest":  parser.CamelCase(rpc.RequestType),
 				"pbResponse": parser.CamelCase(rpc.ReturnsType),
+				"hasReq":     !rpc.StreamsRequest,
+				"notStream":  rpc.IsUnary(),
+				"streamBody": streamBody(goPackage, service, rpc, streamClient),
 			})
 		if err != nil {
 			return nil, err
@@ -162,3 +185,9 @@ func (g *DefaultGenerator) getInterfaceFuncs(service parser.Service) ([]string,
 
 	return functions, nil
 }
+
+// streamBody returns the stream type generated by protoc-gen-go for the rpc,
+// e.g. pb.Greet_SayHelloClient for the client side
+func streamBody(goPackage string, service parser.Service, rpc *parser.RPC, side string) string {
+	return fmt.Sprintf("%s.%s_%s%s", goPackage, parser.CamelCase(service.Name), parser.CamelCase(rpc.Name), side)
+}
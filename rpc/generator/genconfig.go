@@ -1,7 +1,6 @@
 package generator
 
 import (
-	"io/ioutil"
 	"os"
 	"path/filepath"
 
@@ -33,6 +32,7 @@ func (g *DefaultGenerator) GenConfig(ctx DirContext, _ parser.Proto, cfg *conf.C
 
 	fileName := filepath.Join(dir.Filename, configFilename+".go")
 	if util.FileExists(fileName) {
+		util.SkipFile(fileName)
 		return nil
 	}
 
@@ -41,5 +41,5 @@ func (g *DefaultGenerator) GenConfig(ctx DirContext, _ parser.Proto, cfg *conf.C
 		return err
 	}
 
-	return ioutil.WriteFile(fileName, []byte(text), os.ModePerm)
+	return util.WriteFile(fileName, []byte(text), os.ModePerm)
 }
@@ -14,7 +14,7 @@ import (
 )
 
 const (
-	logicTemplate = `package logic
+	logicTemplate = `package {{.packageName}}
 
 import (
 	"context"
@@ -40,25 +40,40 @@ func New{{.logicName}}(ctx context.Context,svcCtx *svc.ServiceContext) *{{.logic
 {{.functions}}
 `
 	logicFunctionTemplate = `{{if .hasComment}}{{.comment}}{{end}}
-func (l *{{.logicName}}) {{.method}} (in {{.request}}) ({{.response}}, error) {
+func (l *{{.logicName}}) {{.method}} ({{if .hasReq}}in {{.request}}{{end}}{{if .stream}}{{if .hasReq}}, {{end}}stream {{.streamBody}}{{end}}) {{if .hasReply}}({{.response}}, error){{else}}error{{end}} {
 	// todo: add your logic here and delete this line
 	
-	return &{{.responseType}}{}, nil
+	return {{if .hasReply}}&{{.responseType}}{}, {{end}}nil
 }
 `
 )
 
 // GenLogic generates the logic file of the rpc service, which corresponds to the RPC definition items in proto.
 func (g *DefaultGenerator) GenLogic(ctx DirContext, proto parser.Proto, cfg *conf.Config) error {
-	dir := ctx.GetLogic()
-	for _, rpc := range proto.Service.RPC {
+	for _, service := range proto.Service {
+		err := g.genLogic(ctx, proto, service, cfg)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *DefaultGenerator) genLogic(ctx DirContext, proto parser.Proto, service parser.Service, cfg *conf.Config) error {
+	dir, err := getLogicDir(ctx, proto, service)
+	if err != nil {
+		return err
+	}
+
+	for _, rpc := range service.RPC {
 		logicFilename, err := format.FileNamingFormat(cfg.NamingFormat, rpc.Name+"_logic")
 		if err != nil {
 			return err
 		}
 
 		filename := filepath.Join(dir.Filename, logicFilename+".go")
-		functions, err := g.genLogicFunction(proto.PbPackage, rpc)
+		functions, err := g.genLogicFunction(proto.PbPackage, service, rpc)
 		if err != nil {
 			return err
 		}
@@ -70,10 +85,11 @@ func (g *DefaultGenerator) GenLogic(ctx DirContext, proto parser.Proto, cfg *con
 		if err != nil {
 			return err
 		}
-		err = util.With("logic").GoFmt(true).Parse(text).SaveTo(map[string]interface{}{
-			"logicName": fmt.Sprintf("%sLogic", stringx.From(rpc.Name).ToCamel()),
-			"functions": functions,
-			"imports":   strings.Join(imports.KeysStr(), util.NL),
+		err = util.With("logic").GoFmt(true).Merge(cfg.Merge).Parse(text).SaveTo(map[string]interface{}{
+			"packageName": dir.Base,
+			"logicName":   fmt.Sprintf("%sLogic", stringx.From(rpc.Name).ToCamel()),
+			"functions":   functions,
+			"imports":     strings.Join(imports.KeysStr(), util.NL),
 		}, filename, false)
 		if err != nil {
 			return err
@@ -82,7 +98,7 @@ func (g *DefaultGenerator) GenLogic(ctx DirContext, proto parser.Proto, cfg *con
 	return nil
 }
 
-func (g *DefaultGenerator) genLogicFunction(goPackage string, rpc *parser.RPC) (string, error) {
+func (g *DefaultGenerator) genLogicFunction(goPackage string, service parser.Service, rpc *parser.RPC) (string, error) {
 	var functions = make([]string, 0)
 	text, err := util.LoadTemplate(category, logicFuncTemplateFileFile, logicFunctionTemplate)
 	if err != nil {
@@ -99,6 +115,10 @@ func (g *DefaultGenerator) genLogicFunction(goPackage string, rpc *parser.RPC) (
 		"responseType": fmt.Sprintf("%s.%s", goPackage, parser.CamelCase(rpc.ReturnsType)),
 		"hasComment":   len(comment) > 0,
 		"comment":      comment,
+		"hasReq":       !rpc.StreamsRequest,
+		"stream":       !rpc.IsUnary(),
+		"hasReply":     rpc.IsUnary(),
+		"streamBody":   streamBody(goPackage, service, rpc, streamServer),
 	})
 	if err != nil {
 		return "", err
@@ -33,11 +33,10 @@ func main() {
 	var c config.Config
 	conf.MustLoad(*configFile, &c)
 	ctx := svc.NewServiceContext(c)
-	srv := server.New{{.serviceNew}}Server(ctx)
 
 	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
-		{{.pkg}}.Register{{.service}}Server(grpcServer, srv)
-	})
+{{range .services}}		{{$.pkg}}.Register{{.Service}}Server(grpcServer, {{.ServerPkg}}.New{{.ServiceNew}}Server(ctx))
+{{end}}	})
 	defer s.Stop()
 
 	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
@@ -45,6 +44,13 @@ func main() {
 }
 `
 
+// mainServiceTemplateData defines the template data of a service registered in main
+type mainServiceTemplateData struct {
+	Service    string
+	ServiceNew string
+	ServerPkg  string
+}
+
 // GenMain generates the main file of the rpc service, which is an rpc service program call entry
 func (g *DefaultGenerator) GenMain(ctx DirContext, proto parser.Proto, cfg *conf.Config) error {
 	mainFilename, err := format.FileNamingFormat(cfg.NamingFormat, ctx.GetServiceName().Source())
@@ -56,19 +62,33 @@ func (g *DefaultGenerator) GenMain(ctx DirContext, proto parser.Proto, cfg *conf
 	imports := make([]string, 0)
 	pbImport := fmt.Sprintf(`"%v"`, ctx.GetPb().Package)
 	svcImport := fmt.Sprintf(`"%v"`, ctx.GetSvc().Package)
-	remoteImport := fmt.Sprintf(`"%v"`, ctx.GetServer().Package)
 	configImport := fmt.Sprintf(`"%v"`, ctx.GetConfig().Package)
-	imports = append(imports, configImport, pbImport, remoteImport, svcImport)
+	imports = append(imports, configImport, pbImport)
+
+	var services []mainServiceTemplateData
+	for _, service := range proto.Service {
+		serverDir, err := getServerDir(ctx, proto, service)
+		if err != nil {
+			return err
+		}
+
+		imports = append(imports, fmt.Sprintf(`"%v"`, serverDir.Package))
+		services = append(services, mainServiceTemplateData{
+			Service:    parser.CamelCase(service.Name),
+			ServiceNew: stringx.From(service.Name).ToCamel(),
+			ServerPkg:  serverDir.Base,
+		})
+	}
+	imports = append(imports, svcImport)
 	text, err := util.LoadTemplate(category, mainTemplateFile, mainTemplate)
 	if err != nil {
 		return err
 	}
 
-	return util.With("main").GoFmt(true).Parse(text).SaveTo(map[string]interface{}{
+	return util.With("main").GoFmt(true).Merge(cfg.Merge).Parse(text).SaveTo(map[string]interface{}{
 		"serviceName": strings.ToLower(ctx.GetServiceName().ToCamel()),
 		"imports":     strings.Join(imports, util.NL),
 		"pkg":         proto.PbPackage,
-		"serviceNew":  stringx.From(proto.Service.Name).ToCamel(),
-		"service":     parser.CamelCase(proto.Service.Name),
+		"services":    services,
 	}, fileName, false)
 }
@@ -7,6 +7,8 @@ import (
 	conf "github.com/zeromicro/goctl/config"
 	"github.com/zeromicro/goctl/rpc/execx"
 	"github.com/zeromicro/goctl/rpc/parser"
+	"github.com/zeromicro/goctl/util"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
@@ -34,11 +36,22 @@ func (g *DefaultGenerator) GenPb(ctx DirContext, protoImportPath []string, proto
 		cw.WriteString(currentPath)
 	}
 	cw.WriteString(" " + proto.Name)
+	outDir := dir.Filename
 	if strings.Contains(proto.GoPackage, "/") {
-		cw.WriteString(" --go_out=plugins=grpc:" + ctx.GetMain().Filename)
-	} else {
-		cw.WriteString(" --go_out=plugins=grpc:" + dir.Filename)
+		outDir = ctx.GetMain().Filename
 	}
+	goOut := outDir
+	if util.IsDryRun() {
+		// protoc writes into a temporary directory, the files are reported against outDir later
+		tmpDir, err := ioutil.TempDir("", "goctl-pb")
+		if err != nil {
+			return err
+		}
+
+		defer os.RemoveAll(tmpDir)
+		goOut = tmpDir
+	}
+	cw.WriteString(" --go_out=plugins=grpc:" + goOut)
 
 	// Compatible with version 1.4.0，github.com/golang/protobuf/protoc-gen-go@v1.4.0
 	// --go_out usage please see https://developers.google.com/protocol-buffers/docs/reference/go-generated#package
@@ -74,5 +87,30 @@ func (g *DefaultGenerator) GenPb(ctx DirContext, protoImportPath []string, proto
 	command := cw.String()
 	g.log.Debug(command)
 	_, err = execx.Run(command, "")
-	return err
+	if err != nil || goOut == outDir {
+		return err
+	}
+
+	return reportPb(goOut, outDir)
+}
+
+// reportPb reports the files generated by protoc in tmpDir as they are written into outDir
+func reportPb(tmpDir, outDir string) error {
+	return filepath.Walk(tmpDir, func(fpath string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		rel, err := filepath.Rel(tmpDir, fpath)
+		if err != nil {
+			return err
+		}
+
+		data, err := ioutil.ReadFile(fpath)
+		if err != nil {
+			return err
+		}
+
+		return util.WriteFile(filepath.Join(outDir, rel), data, info.Mode())
+	})
 }
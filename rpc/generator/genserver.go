@@ -16,10 +16,10 @@ import (
 const (
 	serverTemplate = `{{.head}}
 
-package server
+package {{.packageName}}
 
 import (
-	"context"
+	{{if .notStream}}"context"{{end}}
 
 	{{.imports}}
 )
@@ -38,17 +38,37 @@ func New{{.server}}Server(svcCtx *svc.ServiceContext) *{{.server}}Server {
 `
 	functionTemplate = `
 {{if .hasComment}}{{.comment}}{{end}}
-func (s *{{.server}}Server) {{.method}} (ctx context.Context, in {{.request}}) ({{.response}}, error) {
-	l := logic.New{{.logicName}}(ctx,s.svcCtx)
-	return l.{{.method}}(in)
+func (s *{{.server}}Server) {{.method}} ({{if .notStream}}ctx context.Context, {{end}}{{if .hasReq}}in {{.request}}{{end}}{{if .stream}}{{if .hasReq}}, {{end}}stream {{.streamBody}}{{end}}) {{if .notStream}}({{.response}}, error){{else}}error{{end}} {
+	l := {{.logicPkg}}.New{{.logicName}}({{if .notStream}}ctx{{else}}stream.Context(){{end}},s.svcCtx)
+	return l.{{.method}}({{if .hasReq}}in{{end}}{{if .stream}}{{if .hasReq}}, {{end}}stream{{end}})
 }
 `
 )
 
 // GenServer generates rpc server file, which is an implementation of rpc server
 func (g *DefaultGenerator) GenServer(ctx DirContext, proto parser.Proto, cfg *conf.Config) error {
-	dir := ctx.GetServer()
-	logicImport := fmt.Sprintf(`"%v"`, ctx.GetLogic().Package)
+	for _, service := range proto.Service {
+		err := g.genServer(ctx, proto, service, cfg)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *DefaultGenerator) genServer(ctx DirContext, proto parser.Proto, service parser.Service, cfg *conf.Config) error {
+	dir, err := getServerDir(ctx, proto, service)
+	if err != nil {
+		return err
+	}
+
+	logicDir, err := getLogicDir(ctx, proto, service)
+	if err != nil {
+		return err
+	}
+
+	logicImport := fmt.Sprintf(`"%v"`, logicDir.Package)
 	svcImport := fmt.Sprintf(`"%v"`, ctx.GetSvc().Package)
 	pbImport := fmt.Sprintf(`"%v"`, ctx.GetPb().Package)
 
@@ -56,14 +76,13 @@ func (g *DefaultGenerator) GenServer(ctx DirContext, proto parser.Proto, cfg *co
 	imports.AddStr(logicImport, svcImport, pbImport)
 
 	head := util.GetHead(proto.Name)
-	service := proto.Service
 	serverFilename, err := format.FileNamingFormat(cfg.NamingFormat, service.Name+"_server")
 	if err != nil {
 		return err
 	}
 
 	serverFile := filepath.Join(dir.Filename, serverFilename+".go")
-	funcList, err := g.genFunctions(proto.PbPackage, service)
+	funcList, err := g.genFunctions(proto.PbPackage, logicDir.Base, service)
 	if err != nil {
 		return err
 	}
@@ -73,16 +92,26 @@ func (g *DefaultGenerator) GenServer(ctx DirContext, proto parser.Proto, cfg *co
 		return err
 	}
 
+	var notStream bool
+	for _, rpc := range service.RPC {
+		if rpc.IsUnary() {
+			notStream = true
+			break
+		}
+	}
+
 	err = util.With("server").GoFmt(true).Parse(text).SaveTo(map[string]interface{}{
-		"head":    head,
-		"server":  stringx.From(service.Name).ToCamel(),
-		"imports": strings.Join(imports.KeysStr(), util.NL),
-		"funcs":   strings.Join(funcList, util.NL),
+		"head":        head,
+		"packageName": dir.Base,
+		"server":      stringx.From(service.Name).ToCamel(),
+		"imports":     strings.Join(imports.KeysStr(), util.NL),
+		"funcs":       strings.Join(funcList, util.NL),
+		"notStream":   notStream,
 	}, serverFile, true)
 	return err
 }
 
-func (g *DefaultGenerator) genFunctions(goPackage string, service parser.Service) ([]string, error) {
+func (g *DefaultGenerator) genFunctions(goPackage, logicPkg string, service parser.Service) ([]string, error) {
 	var functionList []string
 	for _, rpc := range service.RPC {
 		text, err := util.LoadTemplate(category, serverFuncTemplateFile, functionTemplate)
@@ -94,11 +123,16 @@ func (g *DefaultGenerator) genFunctions(goPackage string, service parser.Service
 		buffer, err := util.With("func").Parse(text).Execute(map[string]interface{}{
 			"server":     stringx.From(service.Name).ToCamel(),
 			"logicName":  fmt.Sprintf("%sLogic", stringx.From(rpc.Name).ToCamel()),
+			"logicPkg":   logicPkg,
 			"method":     parser.CamelCase(rpc.Name),
 			"request":    fmt.Sprintf("*%s.%s", goPackage, parser.CamelCase(rpc.RequestType)),
 			"response":   fmt.Sprintf("*%s.%s", goPackage, parser.CamelCase(rpc.ReturnsType)),
 			"hasComment": len(comment) > 0,
 			"comment":    comment,
+			"hasReq":     !rpc.StreamsRequest,
+			"stream":     !rpc.IsUnary(),
+			"notStream":  rpc.IsUnary(),
+			"streamBody": streamBody(goPackage, service, rpc, streamServer),
 		})
 		if err != nil {
 			return nil, err
@@ -0,0 +1,45 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zeromicro/goctl/rpc/parser"
+)
+
+func TestGenStreamFunctions(t *testing.T) {
+	_ = Clean()
+	proto, err := parser.NewDefaultProtoParser().Parse("../parser/test_stream.proto")
+	assert.Nil(t, err)
+
+	g := &DefaultGenerator{}
+	service := proto.Service[0]
+
+	iFunctions, err := g.getInterfaceFuncs(proto.PbPackage, service)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		"Unary(ctx context.Context,in *StreamReq) (*StreamReply,error)",
+		"ClientStream(ctx context.Context) (stream.StreamService_ClientStreamClient,error)",
+		"ServerStream(ctx context.Context,in *StreamReq) (stream.StreamService_ServerStreamClient,error)",
+		"BidiStream(ctx context.Context) (stream.StreamService_BidiStreamClient,error)",
+	}, iFunctions)
+
+	functions, err := g.genFunction(proto.PbPackage, service)
+	assert.Nil(t, err)
+	assert.Contains(t, functions[1], "return client.ClientStream(ctx)")
+	assert.Contains(t, functions[2], "return client.ServerStream(ctx, in)")
+
+	serverFunctions, err := g.genFunctions(proto.PbPackage, "logic", service)
+	assert.Nil(t, err)
+	assert.Contains(t, serverFunctions[0], "Unary (ctx context.Context, in *stream.StreamReq) (*stream.StreamReply, error)")
+	assert.Contains(t, serverFunctions[1], "ClientStream (stream stream.StreamService_ClientStreamServer) error")
+	assert.Contains(t, serverFunctions[1], "logic.NewClientStreamLogic(stream.Context(),s.svcCtx)")
+	assert.Contains(t, serverFunctions[2], "ServerStream (in *stream.StreamReq, stream stream.StreamService_ServerStreamServer) error")
+	assert.Contains(t, serverFunctions[2], "return l.ServerStream(in, stream)")
+	assert.Contains(t, serverFunctions[3], "return l.BidiStream(stream)")
+
+	logic, err := g.genLogicFunction(proto.PbPackage, service, service.RPC[3])
+	assert.Nil(t, err)
+	assert.Contains(t, logic, "BidiStream (stream stream.StreamService_BidiStreamServer) error")
+	assert.Contains(t, logic, "return nil")
+}
@@ -40,7 +40,7 @@ func (g *DefaultGenerator) GenSvc(ctx DirContext, _ parser.Proto, cfg *conf.Conf
 		return err
 	}
 
-	return util.With("svc").GoFmt(true).Parse(text).SaveTo(map[string]interface{}{
+	return util.With("svc").GoFmt(true).Merge(cfg.Merge).Parse(text).SaveTo(map[string]interface{}{
 		"imports": fmt.Sprintf(`"%v"`, ctx.GetConfig().Package),
 	}, fileName, false)
 }
@@ -1,6 +1,7 @@
 package generator
 
 import (
+	"path"
 	"path/filepath"
 	"strings"
 
@@ -59,10 +60,6 @@ func mkdir(ctx *ctx.ProjectContext, proto parser.Proto) (DirContext, error) {
 	serverDir := filepath.Join(internalDir, "server")
 	svcDir := filepath.Join(internalDir, "svc")
 	pbDir := filepath.Join(ctx.WorkDir, proto.GoPackage)
-	callDir := filepath.Join(ctx.WorkDir, strings.ToLower(stringx.From(proto.Service.Name).ToCamel()))
-	if strings.ToLower(proto.Service.Name) == strings.ToLower(proto.GoPackage) {
-		callDir = filepath.Join(ctx.WorkDir, strings.ToLower(stringx.From(proto.Service.Name+"_client").ToCamel()))
-	}
 
 	inner[wd] = Dir{
 		Filename: ctx.WorkDir,
@@ -104,10 +101,9 @@ func mkdir(ctx *ctx.ProjectContext, proto parser.Proto) (DirContext, error) {
 		Package:  filepath.ToSlash(filepath.Join(ctx.Path, strings.TrimPrefix(pbDir, ctx.Dir))),
 		Base:     filepath.Base(pbDir),
 	}
-	inner[call] = Dir{
-		Filename: callDir,
-		Package:  filepath.ToSlash(filepath.Join(ctx.Path, strings.TrimPrefix(callDir, ctx.Dir))),
-		Base:     filepath.Base(callDir),
+	// the call directories of multiple services are created by getCallDir
+	if len(proto.Service) == 1 {
+		inner[call] = newCallDir(inner[wd], proto, proto.Service[0])
 	}
 	for _, v := range inner {
 		err := util.MkdirIfNotExist(v.Filename)
@@ -162,6 +158,63 @@ func (d *defaultDirContext) GetServiceName() stringx.String {
 	return d.serviceName
 }
 
+// getCallDir returns the directory of the call package for service,
+// each service has its own call package
+func getCallDir(ctx DirContext, proto parser.Proto, service parser.Service) (Dir, error) {
+	if len(proto.Service) == 1 {
+		return ctx.GetCall(), nil
+	}
+
+	dir := newCallDir(ctx.GetMain(), proto, service)
+	return dir, util.MkdirIfNotExist(dir.Filename)
+}
+
+// getLogicDir returns the directory of the logic package for service,
+// the logic of each service is placed in its own sub directory if there are multiple services
+func getLogicDir(ctx DirContext, proto parser.Proto, service parser.Service) (Dir, error) {
+	return getServiceDir(ctx.GetLogic(), proto, service, logic)
+}
+
+// getServerDir returns the directory of the server package for service,
+// the server of each service is placed in its own sub directory if there are multiple services
+func getServerDir(ctx DirContext, proto parser.Proto, service parser.Service) (Dir, error) {
+	return getServiceDir(ctx.GetServer(), proto, service, server)
+}
+
+func getServiceDir(dir Dir, proto parser.Proto, service parser.Service, suffix string) (Dir, error) {
+	if len(proto.Service) == 1 {
+		return dir, nil
+	}
+
+	name := strings.ToLower(stringx.From(service.Name).ToCamel())
+	serviceDir := Dir{
+		Filename: filepath.Join(dir.Filename, name),
+		Package:  path.Join(dir.Package, name),
+		Base:     name + suffix,
+	}
+	return serviceDir, util.MkdirIfNotExist(serviceDir.Filename)
+}
+
+func newCallDir(wd Dir, proto parser.Proto, service parser.Service) Dir {
+	name := CallPackageName(proto, service)
+	return Dir{
+		Filename: filepath.Join(wd.Filename, name),
+		Package:  path.Join(wd.Package, name),
+		Base:     name,
+	}
+}
+
+// CallPackageName returns the name of the rpc client package of service, which is also the
+// directory name under the rpc service, it's suffixed with client if it's the same as the pb package
+func CallPackageName(proto parser.Proto, service parser.Service) string {
+	name := strings.ToLower(stringx.From(service.Name).ToCamel())
+	if strings.ToLower(service.Name) == strings.ToLower(proto.GoPackage) {
+		name = strings.ToLower(stringx.From(service.Name + "_client").ToCamel())
+	}
+
+	return name
+}
+
 // Valid returns true if the directory is valid
 func (d *Dir) Valid() bool {
 	return len(d.Filename) > 0 && len(d.Package) > 0
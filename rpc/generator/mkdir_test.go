@@ -0,0 +1,57 @@
+package generator
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zeromicro/goctl/rpc/execx"
+	"github.com/zeromicro/goctl/rpc/parser"
+	"github.com/zeromicro/goctl/util/ctx"
+)
+
+func TestGenMultipleService(t *testing.T) {
+	_ = Clean()
+	proto, err := parser.NewDefaultProtoParser().Parse("../parser/test_multiple_service.proto")
+	assert.Nil(t, err)
+
+	workDir := filepath.Join(t.TempDir(), "multiple")
+	_, err = execx.Run("mkdir -p "+workDir+" && go mod init multiple", filepath.Dir(workDir))
+	assert.Nil(t, err)
+
+	projectCtx, err := ctx.Prepare(workDir)
+	assert.Nil(t, err)
+
+	dirCtx, err := mkdir(projectCtx, proto)
+	assert.Nil(t, err)
+	callDir := dirCtx.GetCall()
+	assert.False(t, callDir.Valid())
+
+	g := &DefaultGenerator{}
+	assert.Nil(t, g.GenLogic(dirCtx, proto, cfg))
+	assert.Nil(t, g.GenServer(dirCtx, proto, cfg))
+	assert.Nil(t, g.GenMain(dirCtx, proto, cfg))
+	assert.Nil(t, g.GenCall(dirCtx, proto, cfg))
+
+	for _, file := range []string{
+		"internal/logic/admin/banlogic.go",
+		"internal/logic/public/pinglogic.go",
+		"internal/server/admin/adminserver.go",
+		"internal/server/public/publicserver.go",
+		"admin/admin.go",
+		"public/public.go",
+	} {
+		assert.FileExists(t, filepath.Join(workDir, file))
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(workDir, "internal/server/admin/adminserver.go"))
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), "package adminserver")
+	assert.Contains(t, string(data), "adminlogic.NewBanLogic(ctx, s.svcCtx)")
+
+	data, err = ioutil.ReadFile(filepath.Join(workDir, "testmultipleservice.go"))
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), "multiple.RegisterAdminServer(grpcServer, adminserver.NewAdminServer(ctx))")
+	assert.Contains(t, string(data), "multiple.RegisterPublicServer(grpcServer, publicserver.NewPublicServer(ctx))")
+}
@@ -0,0 +1,88 @@
+package generator
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	conf "github.com/zeromicro/goctl/config"
+	"github.com/zeromicro/goctl/rpc/compiler"
+	"github.com/zeromicro/goctl/rpc/parser"
+	"github.com/zeromicro/goctl/util"
+	"github.com/zeromicro/goctl/util/console"
+)
+
+// NativeGenerator generates the pb.go file in process instead of running protoc,
+// the other files are generated the same as DefaultGenerator
+type NativeGenerator struct {
+	*DefaultGenerator
+}
+
+// just test interface implement
+var _ Generator = &NativeGenerator{}
+
+// NewNativeGenerator returns an instance of NativeGenerator
+func NewNativeGenerator() Generator {
+	return &NativeGenerator{
+		DefaultGenerator: &DefaultGenerator{
+			log: console.NewColorConsole(),
+		},
+	}
+}
+
+// Prepare only checks the go environment, protoc and protoc-gen-go are not required, but go is still
+// required to find the module of the project by go list and init it by go mod init
+func (g *NativeGenerator) Prepare() error {
+	_, err := exec.LookPath("go")
+	return err
+}
+
+// GenPb generates the pb.go file with the messages and grpc services of the proto file,
+// which is the same as protoc --go_out=plugins=grpc. The imported files without a valid go_package
+// are mapped to the directories relative to the project, goOptions can be used to override them
+func (g *NativeGenerator) GenPb(ctx DirContext, protoImportPath []string, proto parser.Proto, _ *conf.Config, goOptions ...string) error {
+	dir := ctx.GetPb()
+	set, err := compiler.Parse(proto.Src, protoImportPath)
+	if err != nil {
+		return err
+	}
+
+	options := []string{fmt.Sprintf("M%s=%s", set.Main, dir.Package)}
+	for _, file := range set.Files {
+		if file.GetName() == set.Main || isGoImportPath(file.GetOptions().GetGoPackage()) {
+			continue
+		}
+
+		importPath := path.Join(ctx.GetMain().Package, path.Dir(file.GetName()))
+		options = append(options, fmt.Sprintf("M%s=%s", file.GetName(), importPath))
+	}
+	options = append(options, goOptions...)
+
+	files, err := compiler.Generate(set, options...)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		filename := filepath.Join(dir.Filename, filepath.Base(file.Name))
+		g.log.Debug(filename)
+		err = util.WriteFile(filename, []byte(file.Content), os.ModePerm)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isGoImportPath returns true if the go_package is an import path rather than a package name
+func isGoImportPath(goPackage string) bool {
+	if index := strings.Index(goPackage, ";"); index >= 0 {
+		goPackage = goPackage[:index]
+	}
+
+	return strings.ContainsAny(goPackage, "./")
+}
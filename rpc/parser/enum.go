@@ -0,0 +1,8 @@
+package parser
+
+import "github.com/emicklei/proto"
+
+// Enum embeds proto.Enum
+type Enum struct {
+	*proto.Enum
+}
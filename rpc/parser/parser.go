@@ -54,6 +54,9 @@ func (p *DefaultProtoParser) Parse(src string) (Proto, error) {
 		proto.WithMessage(func(message *proto.Message) {
 			ret.Message = append(ret.Message, Message{Message: message})
 		}),
+		proto.WithEnum(func(enum *proto.Enum) {
+			ret.Enum = append(ret.Enum, Enum{Enum: enum})
+		}),
 		proto.WithPackage(func(p *proto.Package) {
 			ret.Package = Package{Package: p}
 		}),
@@ -80,18 +83,15 @@ func (p *DefaultProtoParser) Parse(src string) (Proto, error) {
 		return ret, errors.New("rpc service not found")
 	}
 
-	if len(serviceList) > 1 {
-		return ret, errors.New("only one service expected")
-	}
-	service := serviceList[0]
 	name := filepath.Base(abs)
-
-	for _, rpc := range service.RPC {
-		if strings.Contains(rpc.RequestType, ".") {
-			return ret, fmt.Errorf("line %v:%v, request type must defined in %s", rpc.Position.Line, rpc.Position.Column, name)
-		}
-		if strings.Contains(rpc.ReturnsType, ".") {
-			return ret, fmt.Errorf("line %v:%v, returns type must defined in %s", rpc.Position.Line, rpc.Position.Column, name)
+	for _, service := range serviceList {
+		for _, rpc := range service.RPC {
+			if strings.Contains(rpc.RequestType, ".") {
+				return ret, fmt.Errorf("line %v:%v, request type must defined in %s", rpc.Position.Line, rpc.Position.Column, name)
+			}
+			if strings.Contains(rpc.ReturnsType, ".") {
+				return ret, fmt.Errorf("line %v:%v, returns type must defined in %s", rpc.Position.Line, rpc.Position.Column, name)
+			}
 		}
 	}
 	if len(ret.GoPackage) == 0 {
@@ -100,7 +100,7 @@ func (p *DefaultProtoParser) Parse(src string) (Proto, error) {
 	ret.PbPackage = GoSanitized(filepath.Base(ret.GoPackage))
 	ret.Src = abs
 	ret.Name = name
-	ret.Service = service
+	ret.Service = serviceList
 
 	return ret, nil
 }
@@ -29,7 +29,7 @@ func TestDefaultProtoParse(t *testing.T) {
 	}())
 
 	assert.Equal(t, true, func() bool {
-		s := data.Service
+		s := data.Service[0]
 		if s.Name != "TestService" {
 			return false
 		}
@@ -76,3 +76,30 @@ func TestDefaultProtoParse_Option2(t *testing.T) {
 	assert.Equal(t, "stream", data.GoPackage)
 	assert.Equal(t, "stream", data.PbPackage)
 }
+
+func TestDefaultProtoParse_Stream(t *testing.T) {
+	p := NewDefaultProtoParser()
+	data, err := p.Parse("./test_stream.proto")
+	assert.Nil(t, err)
+
+	rpcs := data.Service[0].RPC
+	assert.Len(t, rpcs, 4)
+	assert.True(t, rpcs[0].IsUnary())
+	assert.True(t, rpcs[1].IsClientStream())
+	assert.True(t, rpcs[2].IsServerStream())
+	assert.True(t, rpcs[3].IsBidiStream())
+	for _, rpc := range rpcs[1:] {
+		assert.False(t, rpc.IsUnary())
+	}
+}
+
+func TestDefaultProtoParse_MultipleService(t *testing.T) {
+	p := NewDefaultProtoParser()
+	data, err := p.Parse("./test_multiple_service.proto")
+	assert.Nil(t, err)
+	assert.Len(t, data.Service, 2)
+	assert.Equal(t, "Admin", data.Service[0].Name)
+	assert.Equal(t, "Ban", data.Service[0].RPC[0].Name)
+	assert.Equal(t, "Public", data.Service[1].Name)
+	assert.Equal(t, "Ping", data.Service[1].RPC[0].Name)
+}
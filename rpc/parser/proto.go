@@ -9,5 +9,6 @@ type Proto struct {
 	GoPackage string
 	Import    []Import
 	Message   []Message
-	Service   Service
+	Enum      []Enum
+	Service   []Service
 }
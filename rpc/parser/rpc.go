@@ -6,3 +6,23 @@ import "github.com/emicklei/proto"
 type RPC struct {
 	*proto.RPC
 }
+
+// IsUnary returns true if neither the request nor the response is a stream
+func (r *RPC) IsUnary() bool {
+	return !r.StreamsRequest && !r.StreamsReturns
+}
+
+// IsClientStream returns true if only the request is a stream
+func (r *RPC) IsClientStream() bool {
+	return r.StreamsRequest && !r.StreamsReturns
+}
+
+// IsServerStream returns true if only the response is a stream
+func (r *RPC) IsServerStream() bool {
+	return !r.StreamsRequest && r.StreamsReturns
+}
+
+// IsBidiStream returns true if both the request and the response are streams
+func (r *RPC) IsBidiStream() bool {
+	return r.StreamsRequest && r.StreamsReturns
+}
@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 
 	"github.com/zeromicro/goctl/rpc/execx"
+	"github.com/zeromicro/goctl/util"
 )
 
 var errModuleCheck = errors.New("the work directory must be found in the go mod or the $GOPATH")
@@ -27,6 +28,10 @@ type ProjectContext struct {
 // workDir parameter is the directory of the source of generating code,
 // where can be found the project path and the project module,
 func Prepare(workDir string) (*ProjectContext, error) {
+	if util.IsDryRun() {
+		return prepareDryRun(workDir), nil
+	}
+
 	ctx, err := background(workDir)
 	if err == nil {
 		return ctx, nil
@@ -40,6 +45,29 @@ func Prepare(workDir string) (*ProjectContext, error) {
 	return background(workDir)
 }
 
+// prepareDryRun finds the project from the nearest existing directory of workDir, which may not be made
+// in the dry run mode, and go mod init is not executed if the project is not found
+func prepareDryRun(workDir string) *ProjectContext {
+	dir := workDir
+	for !util.FileExists(dir) && filepath.Dir(dir) != dir {
+		dir = filepath.Dir(dir)
+	}
+
+	ctx, err := background(dir)
+	if err != nil {
+		name := filepath.Base(workDir)
+		return &ProjectContext{
+			WorkDir: workDir,
+			Name:    name,
+			Path:    name,
+			Dir:     workDir,
+		}
+	}
+
+	ctx.WorkDir = workDir
+	return ctx
+}
+
 func background(workDir string) (*ProjectContext, error) {
 	isGoMod, err := IsGoMod(workDir)
 	if err != nil {
@@ -37,9 +37,10 @@ func RemoveIfExist(filename string) error {
 	return os.Remove(filename)
 }
 
-// RemoveOrQuit deletes the specified file if read a permit command from stdin
+// RemoveOrQuit deletes the specified file if read a permit command from stdin,
+// nothing is deleted in the dry run mode
 func RemoveOrQuit(filename string) error {
-	if !FileExists(filename) {
+	if !FileExists(filename) || IsDryRun() {
 		return nil
 	}
 
@@ -50,8 +51,12 @@ func RemoveOrQuit(filename string) error {
 	return os.Remove(filename)
 }
 
-// FileExists returns true if the specified file is exists
+// FileExists returns true if the specified file is exists, or written in the dry run mode
 func FileExists(file string) bool {
+	if _, ok := pending[filepath.Clean(file)]; ok {
+		return true
+	}
+
 	_, err := os.Stat(file)
 	return err == nil
 }
@@ -0,0 +1,349 @@
+package merge
+
+import (
+	"bytes"
+	"go/ast"
+	goformat "go/format"
+	"go/parser"
+	"go/token"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type (
+	edit struct {
+		pos  int
+		end  int
+		text string
+	}
+
+	// file is a parsed go file with its source
+	file struct {
+		src  []byte
+		fset *token.FileSet
+		ast  *ast.File
+	}
+)
+
+// Source merges the generated go codes into the origin codes, the declarations, struct fields and imports
+// missing in origin are added, while the existing declarations are owned by the user and kept as they are,
+// including the signatures of the functions
+func Source(origin, generated []byte) ([]byte, error) {
+	of, err := parseFile(origin)
+	if err != nil {
+		return nil, err
+	}
+
+	gf, err := parseFile(generated)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := applyEdits(origin, mergeDecls(of, gf))
+	of, err = parseFile(merged)
+	if err != nil {
+		return nil, err
+	}
+
+	merged = applyEdits(merged, mergeImports(of, gf))
+	return goformat.Source(merged)
+}
+
+func parseFile(src []byte) (*file, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	return &file{src: src, fset: fset, ast: f}, nil
+}
+
+func mergeDecls(of, gf *file) []edit {
+	funcs := make(map[string]bool)
+	types := make(map[string]*ast.TypeSpec)
+	values := make(map[string]bool)
+	for _, decl := range of.ast.Decls {
+		switch v := decl.(type) {
+		case *ast.FuncDecl:
+			funcs[funcKey(v)] = true
+		case *ast.GenDecl:
+			for _, spec := range v.Specs {
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					types[s.Name.Name] = s
+				case *ast.ValueSpec:
+					for _, name := range s.Names {
+						values[name.Name] = true
+					}
+				}
+			}
+		}
+	}
+
+	var edits []edit
+	var appends []string
+	for _, decl := range gf.ast.Decls {
+		switch v := decl.(type) {
+		case *ast.FuncDecl:
+			if !funcs[funcKey(v)] {
+				appends = append(appends, gf.text(v))
+			}
+		case *ast.GenDecl:
+			for _, spec := range v.Specs {
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					origin, ok := types[s.Name.Name]
+					if !ok {
+						appends = append(appends, gf.specText(v, s))
+						continue
+					}
+
+					edits = append(edits, mergeFields(of, gf, origin, s)...)
+				case *ast.ValueSpec:
+					var missing bool
+					for _, name := range s.Names {
+						if !values[name.Name] {
+							missing = true
+						}
+					}
+					if missing {
+						appends = append(appends, gf.specText(v, s))
+					}
+				}
+			}
+		}
+	}
+
+	if len(appends) > 0 {
+		end := len(of.src)
+		edits = append(edits, edit{
+			pos:  end,
+			end:  end,
+			text: "\n" + strings.Join(appends, "\n\n") + "\n",
+		})
+	}
+
+	return edits
+}
+
+// mergeFields adds the fields of generated which are missing in origin if both of them are structs
+func mergeFields(of, gf *file, origin, generated *ast.TypeSpec) []edit {
+	originStruct, ok := origin.Type.(*ast.StructType)
+	if !ok {
+		return nil
+	}
+
+	generatedStruct, ok := generated.Type.(*ast.StructType)
+	if !ok {
+		return nil
+	}
+
+	names := make(map[string]bool)
+	for _, field := range originStruct.Fields.List {
+		for _, name := range fieldNames(of, field) {
+			names[name] = true
+		}
+	}
+
+	var builder strings.Builder
+	for _, field := range generatedStruct.Fields.List {
+		var missing bool
+		for _, name := range fieldNames(gf, field) {
+			if !names[name] {
+				missing = true
+			}
+		}
+		if !missing {
+			continue
+		}
+
+		pos, end := field.Pos(), field.End()
+		if field.Doc != nil {
+			pos = field.Doc.Pos()
+		}
+		if field.Comment != nil {
+			end = field.Comment.End()
+		}
+		builder.WriteString("\t" + string(gf.slice(pos, end)) + "\n")
+	}
+
+	if builder.Len() == 0 {
+		return nil
+	}
+
+	closing := of.offset(originStruct.Fields.Closing)
+	text := builder.String()
+	if !bytes.HasSuffix(bytes.TrimRight(of.src[:closing], " \t"), []byte("\n")) {
+		text = "\n" + text
+	}
+
+	return []edit{{pos: closing, end: closing, text: text}}
+}
+
+// mergeImports adds the imports of generated which are missing in origin and used in it
+func mergeImports(of, gf *file) []edit {
+	imported := make(map[string]bool)
+	for _, spec := range of.ast.Imports {
+		imported[importPath(spec)] = true
+	}
+
+	used := make(map[string]bool)
+	ast.Inspect(of.ast, func(node ast.Node) bool {
+		if selector, ok := node.(*ast.SelectorExpr); ok {
+			if ident, ok := selector.X.(*ast.Ident); ok && ident.Obj == nil {
+				used[ident.Name] = true
+			}
+		}
+		return true
+	})
+
+	var specs []string
+	for _, spec := range gf.ast.Imports {
+		if imported[importPath(spec)] || !used[importName(spec)] {
+			continue
+		}
+
+		specs = append(specs, string(gf.slice(spec.Pos(), spec.End())))
+	}
+	if len(specs) == 0 {
+		return nil
+	}
+
+	for _, decl := range of.ast.Decls {
+		v, ok := decl.(*ast.GenDecl)
+		if !ok || v.Tok != token.IMPORT {
+			continue
+		}
+
+		if v.Lparen.IsValid() {
+			pos := of.offset(v.Rparen)
+			return []edit{{pos: pos, end: pos, text: "\t" + strings.Join(specs, "\n\t") + "\n"}}
+		}
+
+		pos := of.offset(v.End())
+		return []edit{{pos: pos, end: pos, text: "\nimport " + strings.Join(specs, "\nimport ")}}
+	}
+
+	pos := of.offset(of.ast.Name.End())
+	return []edit{{pos: pos, end: pos, text: "\n\nimport (\n\t" + strings.Join(specs, "\n\t") + "\n)"}}
+}
+
+// applyEdits applies the edits from the end of src, so that the offsets of the others are still valid
+func applyEdits(src []byte, edits []edit) []byte {
+	sort.SliceStable(edits, func(i, j int) bool {
+		return edits[i].pos > edits[j].pos
+	})
+
+	result := append([]byte(nil), src...)
+	for _, item := range edits {
+		var buffer bytes.Buffer
+		buffer.Write(result[:item.pos])
+		buffer.WriteString(item.text)
+		buffer.Write(result[item.end:])
+		result = buffer.Bytes()
+	}
+
+	return result
+}
+
+func (f *file) offset(pos token.Pos) int {
+	return f.fset.Position(pos).Offset
+}
+
+func (f *file) slice(pos, end token.Pos) []byte {
+	return f.src[f.offset(pos):f.offset(end)]
+}
+
+// text returns the source of the declaration with its doc
+func (f *file) text(decl ast.Decl) string {
+	pos := decl.Pos()
+	switch v := decl.(type) {
+	case *ast.FuncDecl:
+		if v.Doc != nil {
+			pos = v.Doc.Pos()
+		}
+	case *ast.GenDecl:
+		if v.Doc != nil {
+			pos = v.Doc.Pos()
+		}
+	}
+
+	return string(f.slice(pos, decl.End()))
+}
+
+// specText returns the source of a type or value spec as a declaration
+func (f *file) specText(decl *ast.GenDecl, spec ast.Spec) string {
+	if len(decl.Specs) == 1 {
+		return f.text(decl)
+	}
+
+	var doc *ast.CommentGroup
+	switch v := spec.(type) {
+	case *ast.TypeSpec:
+		doc = v.Doc
+	case *ast.ValueSpec:
+		doc = v.Doc
+	}
+
+	var text string
+	if doc != nil {
+		text = string(f.slice(doc.Pos(), doc.End())) + "\n"
+	}
+
+	return text + decl.Tok.String() + " " + string(f.slice(spec.Pos(), spec.End()))
+}
+
+func funcKey(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return fn.Name.Name
+	}
+
+	return receiverName(fn.Recv.List[0].Type) + "." + fn.Name.Name
+}
+
+func receiverName(expr ast.Expr) string {
+	switch v := expr.(type) {
+	case *ast.StarExpr:
+		return receiverName(v.X)
+	case *ast.Ident:
+		return v.Name
+	case *ast.IndexExpr:
+		return receiverName(v.X)
+	}
+
+	return ""
+}
+
+// fieldNames returns the names of the field, the embedded field is named after its type
+func fieldNames(f *file, field *ast.Field) []string {
+	if len(field.Names) == 0 {
+		return []string{string(f.slice(field.Type.Pos(), field.Type.End()))}
+	}
+
+	var names []string
+	for _, name := range field.Names {
+		names = append(names, name.Name)
+	}
+
+	return names
+}
+
+func importPath(spec *ast.ImportSpec) string {
+	value, err := strconv.Unquote(spec.Path.Value)
+	if err != nil {
+		return spec.Path.Value
+	}
+
+	return value
+}
+
+func importName(spec *ast.ImportSpec) string {
+	if spec.Name != nil {
+		return spec.Name.Name
+	}
+
+	return path.Base(importPath(spec))
+}
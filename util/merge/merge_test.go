@@ -0,0 +1,195 @@
+package merge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	origin = `package logic
+
+import (
+	"context"
+
+	"example.com/demo/internal/svc"
+)
+
+// Config is edited by user
+type Config struct {
+	Name string // the name
+	Custom int
+}
+
+type GetUserLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func (l *GetUserLogic) GetUser(id int64) error {
+	// user codes
+	return nil
+}
+
+func helper() {}
+`
+
+	generated = `package logic
+
+import (
+	"context"
+
+	"example.com/demo/internal/svc"
+	"example.com/demo/internal/types"
+
+	"github.com/tal-tech/go-zero/core/logx"
+)
+
+type Config struct {
+	Name string
+	// Auth is added
+	Auth struct {
+		AccessSecret string
+	}
+}
+
+type GetUserLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func (l *GetUserLogic) GetUser(req types.GetUserReq) (*types.User, error) {
+	return &types.User{}, nil
+}
+
+// ListUsers is added
+func (l *GetUserLogic) ListUsers() error {
+	return nil
+}
+`
+
+	expected = `package logic
+
+import (
+	"context"
+
+	"example.com/demo/internal/svc"
+	"github.com/tal-tech/go-zero/core/logx"
+)
+
+// Config is edited by user
+type Config struct {
+	Name   string // the name
+	Custom int
+	// Auth is added
+	Auth struct {
+		AccessSecret string
+	}
+}
+
+type GetUserLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func (l *GetUserLogic) GetUser(id int64) error {
+	// user codes
+	return nil
+}
+
+func helper() {}
+
+// ListUsers is added
+func (l *GetUserLogic) ListUsers() error {
+	return nil
+}
+`
+)
+
+func TestSource(t *testing.T) {
+	result, err := Source([]byte(origin), []byte(generated))
+	assert.Nil(t, err)
+	assert.Equal(t, expected, string(result))
+
+	// merging again changes nothing
+	result, err = Source(result, []byte(generated))
+	assert.Nil(t, err)
+	assert.Equal(t, expected, string(result))
+}
+
+func TestSourceKeepsSignature(t *testing.T) {
+	origin := `package logic
+
+import "context"
+
+// GetUser is changed by user
+func GetUser(ctx context.Context, id int64) (string, error) {
+	return "", nil
+}
+`
+	result, err := Source([]byte(origin), []byte(`package logic
+
+import "example.com/demo/internal/types"
+
+func GetUser(req types.GetUserReq) error {
+	return nil
+}
+
+func ListUsers() error {
+	return nil
+}
+`))
+	assert.Nil(t, err)
+	assert.Equal(t, origin+`
+func ListUsers() error {
+	return nil
+}
+`, string(result))
+}
+
+func TestSourceImports(t *testing.T) {
+	result, err := Source([]byte("package foo\n"), []byte(`package foo
+
+import "fmt"
+
+import "strings"
+
+func Foo() { fmt.Println() }
+`))
+	assert.Nil(t, err)
+	assert.Equal(t, `package foo
+
+import (
+	"fmt"
+)
+
+func Foo() { fmt.Println() }
+`, string(result))
+
+	result, err = Source([]byte("package foo\n\nimport \"os\"\n\nvar _ = os.Args\n"), []byte(`package foo
+
+import "fmt"
+
+func Foo() { fmt.Println() }
+`))
+	assert.Nil(t, err)
+	assert.Equal(t, `package foo
+
+import "os"
+import "fmt"
+
+var _ = os.Args
+
+func Foo() { fmt.Println() }
+`, string(result))
+}
+
+func TestSourceError(t *testing.T) {
+	_, err := Source([]byte("package"), []byte("package foo"))
+	assert.NotNil(t, err)
+
+	_, err = Source([]byte("package foo"), []byte("func"))
+	assert.NotNil(t, err)
+}
@@ -20,9 +20,9 @@ func JoinPackages(pkgs ...string) string {
 	return strings.Join(pkgs, pkgSep)
 }
 
-// MkdirIfNotExist makes directories if the input path is not exists
+// MkdirIfNotExist makes directories if the input path is not exists, nothing is made in the dry run mode
 func MkdirIfNotExist(dir string) error {
-	if len(dir) == 0 {
+	if len(dir) == 0 || IsDryRun() {
 		return nil
 	}
 
@@ -2,9 +2,12 @@ package util
 
 import (
 	"bytes"
+	"fmt"
 	goformat "go/format"
-	"io/ioutil"
+	"path/filepath"
 	"text/template"
+
+	"github.com/zeromicro/goctl/util/merge"
 )
 
 const regularPerm = 0666
@@ -14,6 +17,7 @@ type DefaultTemplate struct {
 	name     string
 	text     string
 	goFmt    bool
+	merge    bool
 	savePath string
 }
 
@@ -36,10 +40,27 @@ func (t *DefaultTemplate) GoFmt(format bool) *DefaultTemplate {
 	return t
 }
 
-// SaveTo writes the codes to the target path
+// Merge sets the value to merge and marks the generated codes will be merged into the existing go file or not
+func (t *DefaultTemplate) Merge(merge bool) *DefaultTemplate {
+	t.merge = merge
+	return t
+}
+
+// SaveTo writes the codes to the target path, the existing file is skipped unless forceUpdate is true,
+// or merged with the codes if it's a go file and merge is true
 func (t *DefaultTemplate) SaveTo(data interface{}, path string, forceUpdate bool) error {
 	if FileExists(path) && !forceUpdate {
-		return nil
+		if !t.merge || filepath.Ext(path) != ".go" {
+			SkipFile(path)
+			return nil
+		}
+
+		output, err := t.Execute(data)
+		if err != nil {
+			return err
+		}
+
+		return MergeGoFile(path, output.Bytes())
 	}
 
 	output, err := t.Execute(data)
@@ -47,7 +68,7 @@ func (t *DefaultTemplate) SaveTo(data interface{}, path string, forceUpdate bool
 		return err
 	}
 
-	return ioutil.WriteFile(path, output.Bytes(), regularPerm)
+	return WriteFile(path, output.Bytes(), regularPerm)
 }
 
 // Execute returns the codes after the template executed
@@ -75,3 +96,22 @@ func (t *DefaultTemplate) Execute(data interface{}) (*bytes.Buffer, error) {
 	buf.Write(formatOutput)
 	return buf, nil
 }
+
+// MergeGoFile merges the generated codes into the existing go file, the file is not written if nothing changes
+func MergeGoFile(path string, generated []byte) error {
+	origin, err := readFile(path)
+	if err != nil {
+		return err
+	}
+
+	merged, err := merge.Source(origin, generated)
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+
+	if bytes.Equal(origin, merged) && !IsDryRun() {
+		return nil
+	}
+
+	return WriteFile(path, merged, regularPerm)
+}
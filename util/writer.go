@@ -0,0 +1,133 @@
+package util
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// WriteMode defines how the generated files are written
+type WriteMode int
+
+const (
+	// WriteToDisk writes the generated files to disk, which is the default mode
+	WriteToDisk WriteMode = iota
+	// DryRun lists the files which would be created, skipped or overwritten without writing them
+	DryRun
+	// Diff prints the unified diffs of the generated files against the existing ones without writing them
+	Diff
+)
+
+const devNull = "/dev/null"
+
+var (
+	writeMode = WriteToDisk
+	// pending keeps the files written in the dry run mode, so they are regarded as existing files later
+	pending = make(map[string][]byte)
+)
+
+// SetWriteMode sets the mode of writing the generated files
+func SetWriteMode(mode WriteMode) {
+	writeMode = mode
+	pending = make(map[string][]byte)
+}
+
+// IsDryRun returns true if the generated files are only reported rather than written
+func IsDryRun() bool {
+	return writeMode != WriteToDisk
+}
+
+// WriteFile writes data to the file like ioutil.WriteFile, which is reported instead in the dry run mode,
+// and the unified diff against the existing file is printed in the diff mode
+func WriteFile(filename string, data []byte, perm os.FileMode) error {
+	if !IsDryRun() {
+		return ioutil.WriteFile(filename, data, perm)
+	}
+
+	var origin []byte
+	action := "create"
+	if FileExists(filename) {
+		var err error
+		origin, err = readFile(filename)
+		if err != nil {
+			return err
+		}
+
+		action = "overwrite"
+		if bytes.Equal(origin, data) {
+			action = "unchanged"
+		}
+	}
+
+	pending[filepath.Clean(filename)] = data
+	report(action, filename)
+	if writeMode != Diff || action == "unchanged" {
+		return nil
+	}
+
+	from := filename
+	if action == "create" {
+		from = devNull
+	}
+
+	// SplitLines returns a blank line for the empty content
+	var lines []string
+	if len(origin) > 0 {
+		lines = difflib.SplitLines(string(origin))
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        lines,
+		B:        difflib.SplitLines(string(data)),
+		FromFile: from,
+		ToFile:   filename,
+		Context:  3,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(diff)
+	return nil
+}
+
+func readFile(filename string) ([]byte, error) {
+	if data, ok := pending[filepath.Clean(filename)]; ok {
+		return data, nil
+	}
+
+	return ioutil.ReadFile(filename)
+}
+
+// CreateFile writes data to the file like WriteFile, it returns an error if the file exists like CreateIfNotExist
+func CreateFile(filename string, data []byte) error {
+	if FileExists(filename) {
+		return fmt.Errorf("%s already exist", filename)
+	}
+
+	return WriteFile(filename, data, regularPerm)
+}
+
+// SkipFile reports the existing file which is skipped to generate in the dry run mode
+func SkipFile(filename string) {
+	if IsDryRun() {
+		report("skip", filename)
+	}
+}
+
+func report(action, filename string) {
+	text := fmt.Sprintf("%-9s", action)
+	switch action {
+	case "create":
+		fmt.Println(aurora.Green(text), filename)
+	case "overwrite":
+		fmt.Println(aurora.Yellow(text), filename)
+	default:
+		fmt.Println(aurora.Faint(text), filename)
+	}
+}
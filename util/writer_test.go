@@ -0,0 +1,60 @@
+package util
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goctl-writer")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "foo.go")
+	for _, mode := range []WriteMode{DryRun, Diff} {
+		SetWriteMode(mode)
+		assert.True(t, IsDryRun())
+		assert.Nil(t, MkdirIfNotExist(filepath.Join(dir, "bar")))
+		assert.False(t, FileExists(filepath.Join(dir, "bar")))
+		assert.Nil(t, WriteFile(filename, []byte("package foo\n"), regularPerm))
+		_, err = os.Stat(filename)
+		assert.True(t, os.IsNotExist(err))
+	}
+
+	SetWriteMode(WriteToDisk)
+	assert.False(t, IsDryRun())
+	assert.Nil(t, WriteFile(filename, []byte("package foo\n"), regularPerm))
+	data, err := ioutil.ReadFile(filename)
+	assert.Nil(t, err)
+	assert.Equal(t, "package foo\n", string(data))
+
+	SetWriteMode(Diff)
+	defer SetWriteMode(WriteToDisk)
+	assert.Nil(t, WriteFile(filename, []byte("package bar\n"), regularPerm))
+	data, err = ioutil.ReadFile(filename)
+	assert.Nil(t, err)
+	assert.Equal(t, "package foo\n", string(data))
+}
+
+func TestWriteFilePending(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goctl-writer")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	SetWriteMode(DryRun)
+	defer SetWriteMode(WriteToDisk)
+
+	filename := filepath.Join(dir, "foo.go")
+	assert.Nil(t, WriteFile(filename, []byte("package foo\n"), regularPerm))
+	assert.True(t, FileExists(filename))
+	assert.NotNil(t, CreateFile(filename, []byte("package foo\n")))
+	assert.Nil(t, MergeGoFile(filename, []byte("package foo\n\nfunc Foo() {}\n")))
+	assert.Nil(t, RemoveOrQuit(filename))
+
+	SetWriteMode(WriteToDisk)
+	assert.False(t, FileExists(filename))
+}